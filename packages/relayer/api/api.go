@@ -11,10 +11,13 @@ import (
 	"github.com/cenkalti/backoff"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/http"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
 	"github.com/urfave/cli/v2"
 )
 
@@ -36,12 +39,12 @@ func (api *API) InitFromCli(ctx context.Context, c *cli.Context) error {
 }
 
 func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
-	db, err := cfg.OpenDBFunc()
+	database, err := cfg.OpenDBFunc()
 	if err != nil {
 		return err
 	}
 
-	eventRepository, err := repo.NewEventRepository(db)
+	eventRepository, err := repo.NewEventRepository(database)
 	if err != nil {
 		return err
 	}
@@ -61,6 +64,23 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
+	var selfClaimer relayer.SelfClaimer
+
+	if cfg.ProverConfig != nil {
+		// the prover reads the synced chain data from the same database as the API
+		cfg.ProverConfig.OpenDBFunc = func() (db.DB, error) {
+			return database, nil
+		}
+
+		p := new(processor.Processor)
+
+		if err := processor.InitProverFromConfig(ctx, p, cfg.ProverConfig); err != nil {
+			return err
+		}
+
+		selfClaimer = p
+	}
+
 	srv, err := http.NewServer(http.NewServerOpts{
 		EventRepo:               eventRepository,
 		Echo:                    echo.New(),
@@ -69,6 +89,9 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		DestEthClient:           destEthClient,
 		TaikoL2:                 taikoL2,
 		ProcessingFeeMultiplier: cfg.ProcessingFeeMultiplier,
+		SelfClaimer:             selfClaimer,
+		EventStreamPollInterval: cfg.EventStreamPollInterval,
+		MessageProofRateLimit:   cfg.MessageProofRateLimit,
 	})
 	if err != nil {
 		return err
@@ -102,6 +125,14 @@ func (api *API) Start() error {
 		}
 	}()
 
+	api.wg.Add(1)
+
+	go func() {
+		defer api.wg.Done()
+
+		api.srv.StreamEvents(api.ctx)
+	}()
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(api.ctx, api.srcEthClient, &api.wg)
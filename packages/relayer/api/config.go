@@ -2,10 +2,12 @@ package api
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -28,11 +30,30 @@ type Config struct {
 	ProcessingFeeMultiplier float64
 	DestTaikoAddress        common.Address
 	HTTPPort                uint64
+	EventStreamPollInterval time.Duration
+	MessageProofRateLimit   float64
 	OpenDBFunc              func() (db.DB, error)
+	// ProverConfig is optional, the message proofs are only served if it's set.
+	ProverConfig *processor.Config
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	if err := flags.CheckRequired(c, flags.SrcRPCUrl, flags.DestRPCUrl, flags.DestTaikoAddress); err != nil {
+		return nil, err
+	}
+
+	var proverConfig *processor.Config
+
+	if c.IsSet(flags.DestBridgeAddress.Name) && c.IsSet(flags.SrcSignalServiceAddress.Name) {
+		cfg, err := processor.NewProverConfigFromCliContext(c)
+		if err != nil {
+			return nil, err
+		}
+
+		proverConfig = cfg
+	}
+
 	return &Config{
 		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
@@ -43,10 +64,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
 		CORSOrigins:             strings.Split(c.String(flags.CORSOrigins.Name), ","),
 		HTTPPort:                c.Uint64(flags.HTTPPort.Name),
+		EventStreamPollInterval: c.Duration(flags.EventStreamPollInterval.Name),
+		MessageProofRateLimit:   c.Float64(flags.MessageProofRateLimit.Name),
 		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
 		ProcessingFeeMultiplier: c.Float64(flags.ProcessingFeeMultiplier.Name),
 		DestTaikoAddress:        common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		ProverConfig:            proverConfig,
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
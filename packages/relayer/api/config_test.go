@@ -2,6 +2,7 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
@@ -45,9 +46,12 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(10), c.DatabaseMaxOpenConns)
 		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
 		assert.Equal(t, uint64(1000), c.HTTPPort)
+		assert.Equal(t, 2*time.Second, c.EventStreamPollInterval)
+		assert.Equal(t, float64(1), c.MessageProofRateLimit)
 		assert.Equal(t, "srcRpcUrl", c.SrcRPCUrl)
 		assert.Equal(t, "destRpcUrl", c.DestRPCUrl)
 		assert.Equal(t, destTaikoAddress, c.DestTaikoAddress.Hex())
+		assert.Nil(t, c.ProverConfig)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -74,3 +78,32 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.DestTaikoAddress.Name, destTaikoAddress,
 	}))
 }
+
+func TestNewConfigFromCliContext_ProverConfig(t *testing.T) {
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.NotNil(t, c.ProverConfig)
+		assert.Equal(t, "srcRpcUrl", c.ProverConfig.SrcRPCUrl)
+		assert.Equal(t, "destRpcUrl", c.ProverConfig.DestRPCUrl)
+		assert.Equal(t, destTaikoAddress, c.ProverConfig.DestBridgeAddress.Hex())
+		assert.Equal(t, destTaikoAddress, c.ProverConfig.SrcSignalServiceAddress.Hex())
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewConfigFromCliContext_ProverConfig",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestTaikoAddress.Name, destTaikoAddress,
+		"--" + flags.DestBridgeAddress.Name, destTaikoAddress,
+		"--" + flags.SrcSignalServiceAddress.Name, destTaikoAddress,
+	}))
+}
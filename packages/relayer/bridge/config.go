@@ -40,6 +40,16 @@ type Config struct {
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	if err := flags.CheckRequired(
+		c,
+		flags.SrcRPCUrl,
+		flags.DestRPCUrl,
+		flags.SrcBridgeAddress,
+		flags.DestBridgeAddress,
+	); err != nil {
+		return nil, err
+	}
+
 	bridgePrivateKey, err := crypto.ToECDSA(
 		common.Hex2Bytes(c.String(flags.BridgePrivateKey.Name)),
 	)
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -20,6 +22,20 @@ var (
 		Value:    "*",
 		EnvVars:  []string{"HTTP_CORS_ORIGINS"},
 	}
+	EventStreamPollInterval = &cli.DurationFlag{
+		Name:     "http.eventStreamPollInterval",
+		Usage:    "How often to poll the database for the events streamed to the clients",
+		Category: indexerCategory,
+		Value:    2 * time.Second,
+		EnvVars:  []string{"HTTP_EVENT_STREAM_POLL_INTERVAL"},
+	}
+	MessageProofRateLimit = &cli.Float64Flag{
+		Name:     "http.messageProofRateLimit",
+		Usage:    "Number of message proofs per second served to each client IP",
+		Category: indexerCategory,
+		Value:    1,
+		EnvVars:  []string{"HTTP_MESSAGE_PROOF_RATE_LIMIT"},
+	}
 	ProcessingFeeMultiplier = &cli.Float64Flag{
 		Name:     "processingFeeMultiplier",
 		Usage:    "Processing fee multiplier",
@@ -33,6 +49,15 @@ var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	// optional
 	HTTPPort,
 	CORSOrigins,
+	EventStreamPollInterval,
+	MessageProofRateLimit,
 	ProcessingFeeMultiplier,
 	DestTaikoAddress,
+	// optional, serves the message proofs for the users to claim their messages themselves
+	// if the destination bridge and source signal service addresses are set.
+	DestBridgeAddress,
+	HopSignalServiceAddresses,
+	HopTaikoAddresses,
+	HopRPCUrls,
+	CacheOption,
 })
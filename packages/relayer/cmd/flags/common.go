@@ -1,6 +1,9 @@
 package flags
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -44,15 +47,13 @@ var (
 	}
 	SrcRPCUrl = &cli.StringFlag{
 		Name:     "srcRpcUrl",
-		Usage:    "RPC URL for the source chain",
-		Required: true,
+		Usage:    "RPC URL for the source chain, required unless a route table is set",
 		Category: commonCategory,
 		EnvVars:  []string{"SRC_RPC_URL"},
 	}
 	DestRPCUrl = &cli.StringFlag{
 		Name:     "destRpcUrl",
-		Usage:    "RPC URL for the destination chain",
-		Required: true,
+		Usage:    "RPC URL for the destination chain, required unless a route table is set",
 		Category: commonCategory,
 		EnvVars:  []string{"DEST_RPC_URL"},
 	}
@@ -107,6 +108,13 @@ var (
 		Value:    12,
 		EnvVars:  []string{"BACKOFF_RETRY_INTERVAL"},
 	}
+	Routes = &cli.StringFlag{
+		Name: "routes",
+		Usage: "Path of the JSON route table of the source/destination chain pairs to serve in one process, " +
+			"used instead of the single route RPC URL and contract address flags",
+		Category: commonCategory,
+		EnvVars:  []string{"ROUTES"},
+	}
 	BackOffMaxRetrys = &cli.Uint64Flag{
 		Name:     "backoff.maxRetrys",
 		Usage:    "Max retry times when there is an error",
@@ -123,9 +131,9 @@ var CommonFlags = []cli.Flag{
 	DatabasePassword,
 	DatabaseHost,
 	DatabaseName,
+	// optional
 	SrcRPCUrl,
 	DestRPCUrl,
-	// optional
 	DatabaseMaxIdleConns,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
@@ -145,3 +153,25 @@ func MergeFlags(groups ...[]cli.Flag) []cli.Flag {
 
 	return merged
 }
+
+// CheckRequired returns an error if any of the given flags is not set, it's used instead of
+// `Required` for the flags which are not required in every setup, e.g. the single route
+// flags when a route table is set.
+func CheckRequired(c *cli.Context, required ...cli.Flag) error {
+	var missing []string
+
+	for _, flag := range required {
+		if name := flag.Names()[0]; !c.IsSet(name) {
+			missing = append(missing, name)
+		}
+	}
+
+	switch len(missing) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("required flag %q not set", missing[0])
+	default:
+		return fmt.Errorf("required flags %q not set", strings.Join(missing, ", "))
+	}
+}
@@ -9,15 +9,13 @@ import (
 var (
 	SrcBridgeAddress = &cli.StringFlag{
 		Name:     "srcBridgeAddress",
-		Usage:    "Bridge address on the source chain",
-		Required: true,
+		Usage:    "Bridge address on the source chain, required unless a route table is set",
 		Category: indexerCategory,
 		EnvVars:  []string{"SRC_BRIDGE_ADDRESS"},
 	}
 	DestBridgeAddress = &cli.StringFlag{
 		Name:     "destBridgeAddress",
-		Usage:    "Bridge address for the destination chain",
-		Required: true,
+		Usage:    "Bridge address for the destination chain, required unless a route table is set",
 		Category: commonCategory,
 		EnvVars:  []string{"DEST_BRIDGE_ADDRESS"},
 	}
@@ -126,9 +124,10 @@ var (
 )
 
 var IndexerFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
+	// optional
 	SrcBridgeAddress,
 	DestBridgeAddress,
-	// optional
+	Routes,
 	SrcTaikoAddress,
 	BlockBatchSize,
 	MaxNumGoroutines,
@@ -7,15 +7,25 @@ import (
 var (
 	ProcessorPrivateKey = &cli.StringFlag{
 		Name:     "processorPrivateKey",
-		Usage:    "Private key to process messages on the destination chain",
-		Required: true,
+		Usage:    "Private key to process messages on the destination chain, required unless a remote signer is set",
 		Category: processorCategory,
 		EnvVars:  []string{"PROCESSOR_PRIVATE_KEY"},
 	}
+	ProcessorRemoteSignerEndpoint = &cli.StringFlag{
+		Name:     "processorRemoteSigner.endpoint",
+		Usage:    "Web3Signer compatible remote signer endpoint, used instead of the processorPrivateKey if set",
+		Category: processorCategory,
+		EnvVars:  []string{"PROCESSOR_REMOTE_SIGNER_ENDPOINT"},
+	}
+	ProcessorRemoteSignerAddress = &cli.StringFlag{
+		Name:     "processorRemoteSigner.address",
+		Usage:    "Address of the remote signer account to process messages on the destination chain",
+		Category: processorCategory,
+		EnvVars:  []string{"PROCESSOR_REMOTE_SIGNER_ADDRESS"},
+	}
 	DestTaikoAddress = &cli.StringFlag{
 		Name:     "destTaikoAddress",
-		Usage:    "Taiko address for the destination chain",
-		Required: true,
+		Usage:    "Taiko address for the destination chain, required unless a route table is set",
 		Category: processorCategory,
 		EnvVars:  []string{"DEST_TAIKO_ADDRESS"},
 	}
@@ -23,21 +33,18 @@ var (
 		Name:     "destERC20VaultAddress",
 		Usage:    "ERC20Vault address for the destination chain, only required if you want to process NFTs",
 		Category: processorCategory,
-		Required: true,
 		EnvVars:  []string{"DEST_ERC20_VAULT_ADDRESS"},
 	}
 	DestERC1155VaultAddress = &cli.StringFlag{
 		Name:     "destERC1155Address",
-		Usage:    "ERC1155Vault address for the destination chain",
+		Usage:    "ERC1155Vault address for the destination chain, required unless a route table is set",
 		Category: processorCategory,
-		Required: true,
 		EnvVars:  []string{"DEST_ERC1155_VAULT_ADDRESS"},
 	}
 	DestERC721VaultAddress = &cli.StringFlag{
 		Name:     "destERC721Address",
-		Usage:    "ERC721Vault address for the destination chain",
+		Usage:    "ERC721Vault address for the destination chain, required unless a route table is set",
 		Category: processorCategory,
-		Required: true,
 		EnvVars:  []string{"DEST_ERC721_VAULT_ADDRESS"},
 	}
 )
@@ -153,12 +160,15 @@ var (
 )
 
 var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
+	// optional
 	DestERC721VaultAddress,
 	DestERC1155VaultAddress,
 	DestERC20VaultAddress,
 	DestTaikoAddress,
+	Routes,
 	ProcessorPrivateKey,
-	// optional
+	ProcessorRemoteSignerEndpoint,
+	ProcessorRemoteSignerAddress,
 	HeaderSyncInterval,
 	Confirmations,
 	ConfirmationTimeout,
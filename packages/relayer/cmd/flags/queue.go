@@ -3,37 +3,41 @@ package flags
 import "github.com/urfave/cli/v2"
 
 var (
+	QueueType = &cli.StringFlag{
+		Name:     "queue.type",
+		Usage:    "Queue type, rabbitmq or mysql, the mysql queue is backed by the relayer database",
+		Value:    "rabbitmq",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_TYPE"},
+	}
 	QueueUsername = &cli.StringFlag{
 		Name:     "queue.username",
-		Usage:    "Queue connection username",
-		Required: true,
+		Usage:    "Queue connection username, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_USER"},
 	}
 	QueuePassword = &cli.StringFlag{
 		Name:     "queue.password",
-		Usage:    "Queue connection password",
-		Required: true,
+		Usage:    "Queue connection password, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PASSWORD"},
 	}
 	QueueHost = &cli.StringFlag{
 		Name:     "queue.host",
-		Usage:    "Queue connection host",
-		Required: true,
+		Usage:    "Queue connection host, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_HOST"},
 	}
 	QueuePort = &cli.Uint64Flag{
 		Name:     "queue.port",
-		Usage:    "Queue connection port",
-		Required: true,
+		Usage:    "Queue connection port, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PORT"},
 	}
 )
 
 var QueueFlags = []cli.Flag{
+	QueueType,
 	QueueUsername,
 	QueuePassword,
 	QueueHost,
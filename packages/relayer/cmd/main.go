@@ -51,14 +51,14 @@ func main() {
 			Flags:       flags.IndexerFlags,
 			Usage:       "Starts the indexer software",
 			Description: "Taiko relayer indexer software",
-			Action:      utils.SubcommandAction(new(indexer.Indexer)),
+			Action:      utils.SubcommandAction(new(indexer.MultiIndexer)),
 		},
 		{
 			Name:        "processor",
 			Flags:       flags.ProcessorFlags,
 			Usage:       "Starts the processor software",
 			Description: "Taiko relayer processor software",
-			Action:      utils.SubcommandAction(new(processor.Processor)),
+			Action:      utils.SubcommandAction(new(processor.MultiProcessor)),
 		},
 		{
 			Name:        "watchdog",
@@ -25,6 +25,14 @@ var (
 		"ERR_INVALID_CONFIRMATIONS_TIMEOUT_IN_SECONDS",
 		"ConfirmationsTimeoutInSeconds amount is invalid, must be numerical and > 0",
 	)
-	ErrInvalidMode  = errors.Validation.NewWithKeyAndDetail("ERR_INVALID_MODE", "Mode not supported")
-	ErrUnprofitable = errors.Validation.NewWithKeyAndDetail("ERR_UNPROFITABLE", "Transaction is unprofitable to process")
+	ErrInvalidMode     = errors.Validation.NewWithKeyAndDetail("ERR_INVALID_MODE", "Mode not supported")
+	ErrUnprofitable    = errors.Validation.NewWithKeyAndDetail("ERR_UNPROFITABLE", "Transaction is unprofitable to process")
+	ErrHeaderNotSynced = errors.Validation.NewWithKeyAndDetail(
+		"ERR_HEADER_NOT_SYNCED",
+		"Source chain header is not synced to the destination chain yet",
+	)
+	ErrMessageNotClaimable = errors.Validation.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_CLAIMABLE",
+		"Message is not claimable, it has already been processed or failed",
+	)
 )
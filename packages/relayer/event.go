@@ -81,7 +81,12 @@ type Event struct {
 	GasLimit                *uint64        `json:"gasLimit"`
 	IsProfitable            *bool          `json:"isProfitable"`
 	EstimatedOnchainFee     *uint64        `json:"estimatedOnchainFee"`
+	SimulatedGasUsed        *uint64        `json:"simulatedGasUsed"`
+	L1DataFee               *uint64        `json:"l1DataFee"`
+	SimulatedOnchainFee     *uint64        `json:"simulatedOnchainFee"`
 	IsProfitableEvaluatedAt *time.Time     `json:"isProfitableEvaluatedAt"`
+	UpdatedAt               time.Time      `json:"updatedAt"`
+	DestOwnerJSON           string         `json:"-" gorm:"->"`
 }
 
 // SaveEventOpts
@@ -109,12 +114,19 @@ type SaveEventOpts struct {
 }
 
 type UpdateFeesAndProfitabilityOpts struct {
-	Fee                     uint64
-	DestChainBaseFee        uint64
-	GasTipCap               uint64
-	GasLimit                uint64
-	IsProfitable            bool
-	EstimatedOnchainFee     uint64
+	Fee              uint64
+	DestChainBaseFee uint64
+	GasTipCap        uint64
+	GasLimit         uint64
+	IsProfitable     bool
+	// EstimatedOnchainFee is the cost of the declared gas limit of the message
+	EstimatedOnchainFee uint64
+	// SimulatedGasUsed is the gas the simulated processMessage transaction used
+	SimulatedGasUsed uint64
+	// L1DataFee is the cost of posting the transaction data to L1, for L2 destination chains
+	L1DataFee uint64
+	// SimulatedOnchainFee is the cost of the simulated gas plus the L1 data fee
+	SimulatedOnchainFee     uint64
 	IsProfitableEvaluatedAt time.Time
 }
 
@@ -159,6 +171,7 @@ type EventRepository interface {
 		syncedChainId uint64,
 	) (uint64, error)
 	DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error
+	FindAllMessageEventsUpdatedSince(ctx context.Context, since time.Time) ([]*Event, error)
 	FindLatestBlockID(
 		ctx context.Context,
 		event string,
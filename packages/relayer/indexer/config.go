@@ -1,13 +1,15 @@
 package indexer
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/route"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -59,7 +61,19 @@ type Config struct {
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	return &Config{
+	if !c.IsSet(flags.Routes.Name) {
+		if err := flags.CheckRequired(
+			c,
+			flags.SrcRPCUrl,
+			flags.DestRPCUrl,
+			flags.SrcBridgeAddress,
+			flags.DestBridgeAddress,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := &Config{
 		SrcBridgeAddress:                 common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
 		SrcTaikoAddress:                  common.HexToAddress(c.String(flags.SrcTaikoAddress.Name)),
 		SrcSignalServiceAddress:          common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
@@ -119,20 +133,57 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username: c.String(flags.QueueUsername.Name),
-				Password: c.String(flags.QueuePassword.Name),
-				Host:     c.String(flags.QueueHost.Name),
-				Port:     c.String(flags.QueuePort.Name),
-			}
+	}
 
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
+	// The MySQL queue is opened through OpenDBFunc, which may be replaced after the config is created.
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		opts := queue.NewQueueOpts{
+			Username: c.String(flags.QueueUsername.Name),
+			Password: c.String(flags.QueuePassword.Name),
+			Host:     c.String(flags.QueueHost.Name),
+			Port:     c.String(flags.QueuePort.Name),
+		}
 
-			return q, nil
-		},
-	}, nil
+		return pkgFlags.OpenQueueFromCli(c, opts, cfg.OpenDBFunc)
+	}
+
+	return cfg, nil
+}
+
+// NewRouteConfigsFromCliContext creates a config instance for each route of the route table
+// set by the command line flags, or a single one from the single route flags if not set.
+func NewRouteConfigsFromCliContext(c *cli.Context) ([]*Config, error) {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.IsSet(flags.Routes.Name) {
+		return []*Config{cfg}, nil
+	}
+
+	routes, err := route.LoadRoutes(c.String(flags.Routes.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	cfgs := make([]*Config, 0, len(routes))
+
+	for i, r := range routes {
+		if r.SrcBridgeAddress == ZeroAddress {
+			return nil, fmt.Errorf("invalid route %d: %w", i, route.ErrNoSrcBridge)
+		}
+
+		routeCfg := *cfg
+		routeCfg.SrcRPCUrl = r.SrcRPCUrl
+		routeCfg.DestRPCUrl = r.DestRPCUrl
+		routeCfg.SrcBridgeAddress = r.SrcBridgeAddress
+		routeCfg.SrcSignalServiceAddress = r.SrcSignalServiceAddress
+		routeCfg.SrcTaikoAddress = r.SrcTaikoAddress
+		routeCfg.DestBridgeAddress = r.DestBridgeAddress
+
+		cfgs = append(cfgs, &routeCfg)
+	}
+
+	return cfgs, nil
 }
@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -111,3 +113,64 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.EventName.Name, eventName,
 	}))
 }
+
+func TestNewConfigFromCliContext_RequiredFlags(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run([]string{
+		"TestNewConfigFromCliContext_RequiredFlags",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+	}), `required flags "srcBridgeAddress, destBridgeAddress" not set`)
+}
+
+func TestNewRouteConfigsFromCliContext(t *testing.T) {
+	routes := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(routes, []byte(`[
+		{"srcRpcUrl": "l1RpcUrl", "destRpcUrl": "l2RpcUrl", "srcBridgeAddress": "`+srcBridgeAddr+`",
+			"destBridgeAddress": "`+destBridgeAddr+`", "srcTaikoAddress": "`+srcTaikoAddr+`"},
+		{"srcRpcUrl": "l2RpcUrl", "destRpcUrl": "l1RpcUrl", "srcBridgeAddress": "`+destBridgeAddr+`",
+			"destBridgeAddress": "`+srcBridgeAddr+`"}
+	]`), 0600))
+
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		cfgs, err := NewRouteConfigsFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(cfgs))
+		assert.Equal(t, "l1RpcUrl", cfgs[0].SrcRPCUrl)
+		assert.Equal(t, "l2RpcUrl", cfgs[0].DestRPCUrl)
+		assert.Equal(t, common.HexToAddress(srcBridgeAddr), cfgs[0].SrcBridgeAddress)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), cfgs[0].DestBridgeAddress)
+		assert.Equal(t, common.HexToAddress(srcTaikoAddr), cfgs[0].SrcTaikoAddress)
+		assert.Equal(t, "l2RpcUrl", cfgs[1].SrcRPCUrl)
+		assert.Equal(t, "l1RpcUrl", cfgs[1].DestRPCUrl)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), cfgs[1].SrcBridgeAddress)
+		assert.Equal(t, common.HexToAddress(srcBridgeAddr), cfgs[1].DestBridgeAddress)
+		assert.Equal(t, ZeroAddress, cfgs[1].SrcTaikoAddress)
+
+		// the shared configs are the same for all routes
+		for _, c := range cfgs {
+			assert.Equal(t, "dbuser", c.DatabaseUsername)
+			assert.Equal(t, uint64(100), c.BlockBatchSize)
+			assert.Equal(t, eventName, c.EventName)
+		}
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewRouteConfigsFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.BlockBatchSize.Name, blockBatchSize,
+		"--" + flags.EventName.Name, eventName,
+		"--" + flags.Routes.Name, routes,
+	}))
+}
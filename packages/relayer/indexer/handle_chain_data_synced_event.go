@@ -76,7 +76,7 @@ func (i *Indexer) handleChainDataSyncedEvent(
 		"SyncedChainID", event.ChainId,
 	)
 
-	relayer.ChainDataSyncedEventsIndexed.Inc()
+	relayer.ChainDataSyncedEventsIndexed.WithLabelValues(i.routeLabels()...).Inc()
 
 	return nil
 }
@@ -48,7 +48,7 @@ func (i *Indexer) handleMessageStatusChangedEvent(
 		return errors.Wrap(err, "i.eventRepo.Save")
 	}
 
-	relayer.MessageStatusChangedEventsIndexed.Inc()
+	relayer.MessageStatusChangedEventsIndexed.WithLabelValues(i.routeLabels()...).Inc()
 
 	return nil
 }
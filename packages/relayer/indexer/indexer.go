@@ -395,7 +395,7 @@ func (i *Indexer) filter(ctx context.Context) error {
 			if err := i.withRetry(func() error { return i.indexMessageSentEvents(ctx, filterOpts) }); err != nil {
 				// We will skip the error after retrying, as we want the indexer to continue.
 				slog.Error("i.indexMessageSentEvents", "error", err)
-				relayer.MessageSentEventsAfterRetryErrorCount.Inc()
+				relayer.MessageSentEventsAfterRetryErrorCount.WithLabelValues(i.routeLabels()...).Inc()
 			}
 
 			// we dont want to watch for message status changed events
@@ -405,19 +405,19 @@ func (i *Indexer) filter(ctx context.Context) error {
 			if i.watchMode != CrawlPastBlocks {
 				if err := i.withRetry(func() error { return i.indexMessageStatusChangedEvents(ctx, filterOpts) }); err != nil {
 					slog.Error("i.indexMessageStatusChangedEvents", "error", err)
-					relayer.MessageStatusChangedEventsAfterRetryErrorCount.Inc()
+					relayer.MessageStatusChangedEventsAfterRetryErrorCount.WithLabelValues(i.routeLabels()...).Inc()
 				}
 
 				// we also want to index chain data synced events.
 				if err := i.withRetry(func() error { return i.indexChainDataSyncedEvents(ctx, filterOpts) }); err != nil {
 					slog.Error("i.indexChainDataSyncedEvents", "error", err)
-					relayer.ChainDataSyncedEventsAfterRetryErrorCount.Inc()
+					relayer.ChainDataSyncedEventsAfterRetryErrorCount.WithLabelValues(i.routeLabels()...).Inc()
 				}
 			}
 		case relayer.EventNameMessageProcessed:
 			if err := i.withRetry(func() error { return i.indexMessageProcessedEvents(ctx, filterOpts) }); err != nil {
 				slog.Error("i.indexMessageProcessedEvents", "error", err)
-				relayer.MessageProcessedEventsAfterRetryErrorCount.Inc()
+				relayer.MessageProcessedEventsAfterRetryErrorCount.WithLabelValues(i.routeLabels()...).Inc()
 			}
 		}
 
@@ -456,7 +456,8 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 		group.Go(func() error {
 			err := i.handleMessageSentEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
-				relayer.ErrorEvents.Inc()
+				relayer.ErrorEvents.WithLabelValues(i.routeLabels()...).Inc()
+				relayer.MessageSentEventsIndexingErrors.WithLabelValues(i.routeLabels()...).Inc()
 				// log error but always return nil to keep other goroutines active
 				slog.Error("error handling event", "err", err.Error())
 
@@ -520,7 +521,7 @@ func (i *Indexer) indexMessageProcessedEvents(ctx context.Context,
 		group.Go(func() error {
 			err := i.handleMessageProcessedEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
-				relayer.MessageProcessedEventsIndexingErrors.Inc()
+				relayer.MessageProcessedEventsIndexingErrors.WithLabelValues(i.routeLabels()...).Inc()
 				// log error but always return nil to keep other goroutines active
 				slog.Error("error handling event", "err", err.Error())
 
@@ -559,7 +560,7 @@ func (i *Indexer) indexMessageStatusChangedEvents(ctx context.Context,
 		group.Go(func() error {
 			err := i.handleMessageStatusChangedEvent(ctx, i.srcChainId, event)
 			if err != nil {
-				relayer.MessageStatusChangedEventsIndexingErrors.Inc()
+				relayer.MessageStatusChangedEventsIndexingErrors.WithLabelValues(i.routeLabels()...).Inc()
 				// log error but always return nil to keep other goroutines active
 				slog.Error("error handling messageStatusChanged", "err", err.Error())
 
@@ -605,7 +606,7 @@ func (i *Indexer) indexChainDataSyncedEvents(ctx context.Context,
 		group.Go(func() error {
 			err := i.handleChainDataSyncedEvent(ctx, event, true)
 			if err != nil {
-				relayer.MessageStatusChangedEventsIndexingErrors.Inc()
+				relayer.ChainDataSyncedEventsIndexingErrors.WithLabelValues(i.routeLabels()...).Inc()
 
 				// log error but always return nil to keep other goroutines active
 				slog.Error("error handling chainDataSynced", "err", err.Error())
@@ -631,6 +632,11 @@ func (i *Indexer) queueName() string {
 	return fmt.Sprintf("%v-%v-%v-queue", i.srcChainId.String(), i.destChainId.String(), i.eventName)
 }
 
+// routeLabels returns the metric label values of the route the indexer serves.
+func (i *Indexer) routeLabels() []string {
+	return []string{i.srcChainId.String(), i.destChainId.String()}
+}
+
 // withRetry retries the given function with prover backoff policy.
 func (i *Indexer) withRetry(f func() error) error {
 	return backoff.Retry(
@@ -0,0 +1,67 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v2"
+)
+
+// MultiIndexer serves all the routes of the route table in one process, with an Indexer per
+// route. Each of them has its own clients, queue and backoff, so a failing route does not
+// hold the others back.
+type MultiIndexer struct {
+	indexers []*Indexer
+}
+
+// InitFromCli inits a new MultiIndexer from command line or environment variables.
+func (m *MultiIndexer) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfgs, err := NewRouteConfigsFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitMultiFromConfigs(ctx, m, cfgs)
+}
+
+// InitMultiFromConfigs inits a new MultiIndexer with an Indexer for each of the provided
+// Config structs.
+func InitMultiFromConfigs(ctx context.Context, m *MultiIndexer, cfgs []*Config) error {
+	for idx, cfg := range cfgs {
+		i := new(Indexer)
+
+		if err := InitFromConfig(ctx, i, cfg); err != nil {
+			return fmt.Errorf("route %d: %w", idx, err)
+		}
+
+		m.indexers = append(m.indexers, i)
+	}
+
+	return nil
+}
+
+// Name implements the SubcommandAction interface
+func (m *MultiIndexer) Name() string {
+	return "indexer"
+}
+
+// Start starts the indexers of all the routes.
+func (m *MultiIndexer) Start() error {
+	for _, i := range m.indexers {
+		slog.Info("starting route indexer", "srcChainId", i.srcChainId, "destChainId", i.destChainId)
+
+		if err := i.Start(); err != nil {
+			return fmt.Errorf("route %v => %v: %w", i.srcChainId, i.destChainId, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the indexers of all the routes.
+func (m *MultiIndexer) Close(ctx context.Context) {
+	for _, i := range m.indexers {
+		i.Close(ctx)
+	}
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS queue_messages (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    queue_name VARCHAR(255) NOT NULL,
+    message_id VARCHAR(255) NOT NULL,
+    body LONGBLOB NOT NULL,
+    headers JSON NULL,
+    available_at DATETIME(3) NOT NULL,
+    locked_until DATETIME(3) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX queue_messages_queue_name_available_at_index (queue_name, available_at)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE queue_messages;
+-- +goose StatementEnd
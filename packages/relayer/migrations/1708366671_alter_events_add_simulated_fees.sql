@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD COLUMN `simulated_gas_used` BIGINT UNSIGNED NULL,
+ADD COLUMN `l1_data_fee` BIGINT UNSIGNED NULL,
+ADD COLUMN `simulated_onchain_fee` BIGINT UNSIGNED NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP COLUMN `simulated_gas_used`,
+DROP COLUMN `l1_data_fee`,
+DROP COLUMN `simulated_onchain_fee`;
+-- +goose StatementEnd
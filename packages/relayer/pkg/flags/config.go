@@ -2,12 +2,18 @@ package flags
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	mysqlQueue "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/mysql"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 )
 
 // InitTxmgrConfigsFromCli initializes the transaction manager configs from the command line flags.
@@ -28,3 +34,31 @@ func InitTxmgrConfigsFromCli(l1Endpoint string, privateKey *ecdsa.PrivateKey, c
 		TxNotInMempoolTimeout:     c.Duration(flags.TxNotInMempoolTimeout.Name),
 	}
 }
+
+// OpenQueueFromCli opens the queue selected by the `queue.type` flag, the RabbitMQ queue
+// is opened with the given options, and the MySQL queue with the database opened by openDB.
+func OpenQueueFromCli(c *cli.Context, opts queue.NewQueueOpts, openDB func() (db.DB, error)) (queue.Queue, error) {
+	switch queueType := c.String(flags.QueueType.Name); queueType {
+	case queue.TypeRabbitMQ:
+		q, err := rabbitmq.NewQueue(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	case queue.TypeMySQL:
+		database, err := openDB()
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := mysqlQueue.NewQueue(database, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	default:
+		return nil, fmt.Errorf("invalid queue type: %v", queueType)
+	}
+}
@@ -11,4 +11,12 @@ var (
 		"ERR_NO_REWARDER",
 		"Rewarder is required",
 	)
+	ErrNoEventStreamFilter = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_EVENT_STREAM_FILTER",
+		"address or msgHash is required",
+	)
+	ErrMessageNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_FOUND",
+		"No message sent with this msgHash on the route served by this relayer",
+	)
 )
@@ -0,0 +1,205 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	defaultEventStreamPollInterval = 2 * time.Second
+	// eventStreamBufferSize is the number of events buffered for a subscriber, a subscriber
+	// falling further behind is disconnected, and has to catch up with `GET /events`.
+	eventStreamBufferSize = 64
+)
+
+type eventSubscriber struct {
+	address string
+	msgHash string
+	events  chan *relayer.Event
+}
+
+// matches checks the event is sent by, or to, the address, and has the msgHash,
+// of the subscriber, when they're set.
+func (s *eventSubscriber) matches(e *relayer.Event) bool {
+	if s.msgHash != "" && !strings.EqualFold(s.msgHash, e.MsgHash) {
+		return false
+	}
+
+	if s.address != "" &&
+		!strings.EqualFold(s.address, e.MessageOwner) &&
+		!strings.EqualFold(s.address, e.DestOwnerJSON) {
+		return false
+	}
+
+	return true
+}
+
+// eventVersion identifies a pushed event, an event is pushed again once it's updated.
+type eventVersion struct {
+	id     int
+	status relayer.EventStatus
+}
+
+// eventStream pushes the message events to its subscribers as they are saved or updated.
+// The indexers and processors run in other processes, so it polls the database for the
+// events updated since the last poll, once for all the subscribers.
+type eventStream struct {
+	eventRepo    relayer.EventRepository
+	pollInterval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]struct{}
+	closed      bool
+
+	// cursor is the update time of the last pushed events, and seen the versions of the
+	// events pushed with this update time, which only has a precision of a second.
+	cursor time.Time
+	seen   map[eventVersion]struct{}
+}
+
+func newEventStream(eventRepo relayer.EventRepository, pollInterval time.Duration) *eventStream {
+	if pollInterval == 0 {
+		pollInterval = defaultEventStreamPollInterval
+	}
+
+	return &eventStream{
+		eventRepo:    eventRepo,
+		pollInterval: pollInterval,
+		subscribers:  make(map[*eventSubscriber]struct{}),
+		cursor:       time.Now().UTC().Truncate(time.Second),
+		seen:         make(map[eventVersion]struct{}),
+	}
+}
+
+// subscribe subscribes to the events of the given address and msgHash, the events channel
+// of the subscriber is closed once it's unsubscribed, or the stream is closed.
+func (s *eventStream) subscribe(address string, msgHash string) *eventSubscriber {
+	sub := &eventSubscriber{
+		address: address,
+		msgHash: msgHash,
+		events:  make(chan *relayer.Event, eventStreamBufferSize),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		close(sub.events)
+
+		return sub
+	}
+
+	s.subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+func (s *eventStream) unsubscribe(sub *eventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(sub)
+}
+
+// remove removes the subscriber, the caller must hold the lock.
+func (s *eventStream) remove(sub *eventSubscriber) {
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(s.subscribers, sub)
+	close(sub.events)
+}
+
+// run polls for the updated events until the context is done, and then closes the stream.
+func (s *eventStream) run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+
+	defer ticker.Stop()
+	defer s.close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.poll(ctx); err != nil {
+				slog.Error("event stream poll", "error", err)
+			}
+		}
+	}
+}
+
+func (s *eventStream) poll(ctx context.Context) error {
+	s.mu.Lock()
+	hasSubscribers := len(s.subscribers) > 0
+	s.mu.Unlock()
+
+	// nobody to push the events to, skip them.
+	if !hasSubscribers {
+		s.cursor = time.Now().UTC().Truncate(time.Second)
+		s.seen = make(map[eventVersion]struct{})
+
+		return nil
+	}
+
+	events, err := s.eventRepo.FindAllMessageEventsUpdatedSince(ctx, s.cursor)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		version := eventVersion{id: e.ID, status: e.Status}
+
+		if e.UpdatedAt.After(s.cursor) {
+			s.cursor = e.UpdatedAt
+			s.seen = make(map[eventVersion]struct{})
+		} else if _, ok := s.seen[version]; ok {
+			continue
+		}
+
+		s.seen[version] = struct{}{}
+
+		s.publish(e)
+	}
+
+	return nil
+}
+
+func (s *eventStream) publish(e *relayer.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers {
+		if !sub.matches(e) {
+			continue
+		}
+
+		select {
+		case sub.events <- e:
+		default:
+			slog.Warn("event stream subscriber too slow, disconnecting",
+				"address", sub.address,
+				"msgHash", sub.msgHash,
+			)
+
+			s.remove(sub)
+		}
+	}
+}
+
+func (s *eventStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	for sub := range s.subscribers {
+		s.remove(sub)
+	}
+}
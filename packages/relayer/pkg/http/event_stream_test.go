@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+var (
+	streamOwner   = "0x0000000000000000000000000000000000000123"
+	streamMsgHash = "0x0100000000000000000000000000000000000000000000000000000000000000"
+)
+
+func saveStreamEvent(t *testing.T, eventRepo relayer.EventRepository, event string, owner string, msgHash string) {
+	_, err := eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:         event,
+		Event:        event,
+		Data:         "{}",
+		ChainID:      big.NewInt(167001),
+		DestChainID:  big.NewInt(167002),
+		Status:       relayer.EventStatusNew,
+		MessageOwner: owner,
+		MsgHash:      msgHash,
+	})
+	assert.Nil(t, err)
+}
+
+func Test_eventStream_poll(t *testing.T) {
+	eventRepo := mock.NewEventRepository()
+	stream := newEventStream(eventRepo, 0)
+
+	byAddress := stream.subscribe(streamOwner, "")
+	byMsgHash := stream.subscribe("", streamMsgHash)
+
+	saveStreamEvent(t, eventRepo, relayer.EventNameMessageSent, streamOwner, streamMsgHash)
+	saveStreamEvent(t, eventRepo, relayer.EventNameMessageSent, "0x456", "0x02")
+	saveStreamEvent(t, eventRepo, relayer.EventNameChainDataSynced, streamOwner, streamMsgHash)
+
+	assert.Nil(t, stream.poll(context.Background()))
+
+	// the events are only pushed once
+	assert.Nil(t, stream.poll(context.Background()))
+
+	assert.Len(t, byAddress.events, 1)
+	assert.Len(t, byMsgHash.events, 1)
+
+	e := <-byAddress.events
+	assert.Equal(t, relayer.EventNameMessageSent, e.Event)
+	assert.Equal(t, relayer.EventStatusNew, e.Status)
+
+	<-byMsgHash.events
+
+	// and pushed again once their status is updated
+	assert.Nil(t, eventRepo.UpdateStatus(context.Background(), e.ID, relayer.EventStatusDone))
+	assert.Nil(t, stream.poll(context.Background()))
+
+	e = <-byAddress.events
+	assert.Equal(t, relayer.EventStatusDone, e.Status)
+
+	e = <-byMsgHash.events
+	assert.Equal(t, relayer.EventStatusDone, e.Status)
+
+	stream.unsubscribe(byAddress)
+
+	_, ok := <-byAddress.events
+	assert.False(t, ok)
+
+	stream.close()
+
+	_, ok = <-byMsgHash.events
+	assert.False(t, ok)
+}
+
+func Test_eventStream_slowSubscriber(t *testing.T) {
+	eventRepo := mock.NewEventRepository()
+	stream := newEventStream(eventRepo, 0)
+
+	sub := stream.subscribe(streamOwner, "")
+
+	for i := 0; i <= eventStreamBufferSize; i++ {
+		saveStreamEvent(t, eventRepo, relayer.EventNameMessageSent, streamOwner, "")
+	}
+
+	assert.Nil(t, stream.poll(context.Background()))
+
+	for i := 0; i < eventStreamBufferSize; i++ {
+		<-sub.events
+	}
+
+	_, ok := <-sub.events
+	assert.False(t, ok)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+)
+
+// GetEventsStream
+//
+//	 streams the MessageSent, MessageStatusChanged and MessageProcessed events of an address
+//	 or msgHash as server-sent events, as they are saved or their status is updated
+//
+//			@Summary		Stream events
+//			@ID			   	get-events-stream
+//		    @Param			address	query		string		false	"address to subscribe to"
+//		    @Param			msgHash	query		string		false	"msgHash to subscribe to"
+//			@Produce		text/event-stream
+//			@Success		200	{object} relayer.Event
+//			@Router			/events/stream [get]
+func (srv *Server) GetEventsStream(c echo.Context) error {
+	address := html.EscapeString(c.QueryParam("address"))
+
+	msgHash := html.EscapeString(c.QueryParam("msgHash"))
+
+	if address == "" && msgHash == "" {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, ErrNoEventStreamFilter)
+	}
+
+	if address != "" {
+		address = common.HexToAddress(address).Hex()
+	}
+
+	if msgHash != "" {
+		msgHash = common.HexToHash(msgHash).Hex()
+	}
+
+	sub := srv.eventStream.subscribe(address, msgHash)
+	defer srv.eventStream.unsubscribe(sub)
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case e, ok := <-sub.events:
+			// unsubscribed by the stream, the client can reconnect
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "event: %v\ndata: %s\n\n", e.Event, data); err != nil {
+				return err
+			}
+
+			w.Flush()
+		}
+	}
+}
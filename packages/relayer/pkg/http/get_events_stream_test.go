@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_GetEventsStream(t *testing.T) {
+	srv := newTestServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	req := testutils.NewUnauthenticatedRequest(
+		echo.GET,
+		fmt.Sprintf("/events/stream?address=%v", streamOwner),
+		nil,
+	).WithContext(ctx)
+
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+
+	go func() {
+		srv.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		srv.eventStream.mu.Lock()
+		defer srv.eventStream.mu.Unlock()
+
+		return len(srv.eventStream.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	saveStreamEvent(t, srv.eventRepo, relayer.EventNameMessageSent, streamOwner, streamMsgHash)
+
+	assert.Nil(t, srv.eventStream.poll(context.Background()))
+
+	// wait for the event to be written, then disconnect the client
+	assert.Eventually(t, func() bool {
+		srv.eventStream.mu.Lock()
+		defer srv.eventStream.mu.Unlock()
+
+		for sub := range srv.eventStream.subscribers {
+			return len(sub.events) == 0
+		}
+
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusOK, []string{
+		`event: MessageSent\ndata: {"id":\d+,"name":"MessageSent"`,
+		`"messageOwner":"` + streamOwner + `"`,
+	})
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+}
+
+func Test_GetEventsStream_noFilter(t *testing.T) {
+	srv := newTestServer()
+
+	req := testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream", nil)
+
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusUnprocessableEntity, []string{`ERR_NO_EVENT_STREAM_FILTER`})
+}
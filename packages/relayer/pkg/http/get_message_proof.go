@@ -0,0 +1,79 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+type getMessageProofResponse struct {
+	MsgHash      string `json:"msgHash"`
+	DestChainID  int64  `json:"destChainID"`
+	To           string `json:"to"`
+	Data         string `json:"data"`
+	EstimatedGas uint64 `json:"estimatedGas"`
+}
+
+// GetMessageProof
+//
+//	 returns the processMessage transaction to self-claim a message on the destination chain
+//
+//			@Summary		Get message proof
+//			@ID			   	get-message-proof
+//		    @Param			msgHash	path		string		true	"msgHash of the message"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} getMessageProofResponse
+//			@Router			/messages/{msgHash}/proof [get]
+func (srv *Server) GetMessageProof(c echo.Context) error {
+	msgHash := common.HexToHash(c.Param("msgHash")).Hex()
+
+	event, err := srv.eventRepo.FirstByEventAndMsgHash(
+		c.Request().Context(),
+		relayer.EventNameMessageSent,
+		msgHash,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusInternalServerError, err)
+	}
+
+	// the relayer only generates the proofs of its own route
+	if event == nil ||
+		event.ChainID != srv.srcChainID.Int64() ||
+		event.DestChainID != srv.destChainID.Int64() {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	msg := &bridge.BridgeMessageSent{}
+
+	if err := json.Unmarshal(event.Data, msg); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusInternalServerError, err)
+	}
+
+	// a message which can not be claimed yet or anymore is not an invalid request
+	claim, err := srv.selfClaimer.SelfClaim(c.Request().Context(), msg)
+
+	switch {
+	case errors.Is(err, relayer.ErrMessageNotClaimable):
+		return webutils.LogAndRenderErrors(c, http.StatusConflict, relayer.ErrMessageNotClaimable)
+	case errors.Is(err, relayer.ErrHeaderNotSynced):
+		return webutils.LogAndRenderErrors(c, http.StatusServiceUnavailable, relayer.ErrHeaderNotSynced)
+	case err != nil:
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, getMessageProofResponse{
+		MsgHash:      msgHash,
+		DestChainID:  event.DestChainID,
+		To:           claim.To.Hex(),
+		Data:         hexutil.Encode(claim.Data),
+		EstimatedGas: claim.EstimatedGas,
+	})
+}
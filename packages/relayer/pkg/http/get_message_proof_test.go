@@ -0,0 +1,120 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_GetMessageProof(t *testing.T) {
+	srv := newTestServer()
+	srv.srcChainID = mock.MockChainID
+	srv.destChainID = mock.MockChainID
+	srv.selfClaimer = &mock.SelfClaimer{}
+	srv.messageProofRateLimit = 100
+	srv.configureRoutes()
+
+	for _, msgHash := range [][32]byte{mock.SuccessMsgHash, mock.FailSignal, mock.NotSyncedMsgHash} {
+		data, err := json.Marshal(&bridge.BridgeMessageSent{
+			MsgHash: msgHash,
+			Raw: types.Log{
+				Topics: []common.Hash{relayer.ZeroHash},
+				Data:   []byte{},
+			},
+		})
+		assert.Nil(t, err)
+
+		_, err = srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:        relayer.EventNameMessageSent,
+			Event:       relayer.EventNameMessageSent,
+			Data:        string(data),
+			ChainID:     mock.MockChainID,
+			DestChainID: mock.MockChainID,
+			MsgHash:     common.Hash(msgHash).Hex(),
+		})
+		assert.Nil(t, err)
+	}
+
+	tests := []struct {
+		name                  string
+		msgHash               string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			common.Hash(mock.SuccessMsgHash).Hex(),
+			http.StatusOK,
+			[]string{`{"msgHash":"0x0100000000000000000000000000000000000000000000000000000000000000",` +
+				`"destChainID":167001,"to":"0xC4279588B8dA563D264e286E2ee7CE8c244444d6","data":"0x01",` +
+				`"estimatedGas":1}`},
+		},
+		{
+			"notFound",
+			common.Hash{0x3}.Hex(),
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+		{
+			"notClaimable",
+			common.Hash(mock.FailSignal).Hex(),
+			http.StatusConflict,
+			[]string{`ERR_MESSAGE_NOT_CLAIMABLE`},
+		},
+		{
+			"headerNotSynced",
+			common.Hash(mock.NotSyncedMsgHash).Hex(),
+			http.StatusServiceUnavailable,
+			[]string{`ERR_HEADER_NOT_SYNCED`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				fmt.Sprintf("/messages/%v/proof", tt.msgHash),
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_GetMessageProofRateLimit(t *testing.T) {
+	srv := newTestServer()
+	srv.selfClaimer = &mock.SelfClaimer{}
+	srv.messageProofRateLimit = 1
+	srv.configureRoutes()
+
+	for _, wantStatus := range []int{http.StatusNotFound, http.StatusTooManyRequests} {
+		req := testutils.NewUnauthenticatedRequest(
+			echo.GET,
+			fmt.Sprintf("/messages/%v/proof", common.Hash{0x3}.Hex()),
+			nil,
+		)
+
+		rec := httptest.NewRecorder()
+
+		srv.ServeHTTP(rec, req)
+
+		assert.Equal(t, wantStatus, rec.Code)
+	}
+}
@@ -1,10 +1,24 @@
 package http
 
+import (
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
 func (srv *Server) configureRoutes() {
 	srv.echo.GET("/healthz", srv.Health)
 	srv.echo.GET("/", srv.Health)
 
 	srv.echo.GET("/events", srv.GetEventsByAddress)
+	srv.echo.GET("/events/stream", srv.GetEventsStream)
 	srv.echo.GET("/blockInfo", srv.GetBlockInfo)
 	srv.echo.GET("/recommendedProcessingFees", srv.GetRecommendedProcessingFees)
+
+	if srv.selfClaimer != nil {
+		srv.echo.GET(
+			"/messages/:msgHash/proof",
+			srv.GetMessageProof,
+			middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(rate.Limit(srv.messageProofRateLimit))),
+		)
+	}
 }
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -51,6 +52,9 @@ type Server struct {
 	destChainID             *big.Int
 	processingFeeMultiplier float64
 	taikoL2                 *taikol2.TaikoL2
+	selfClaimer             relayer.SelfClaimer
+	messageProofRateLimit   float64
+	eventStream             *eventStream
 }
 
 type NewServerOpts struct {
@@ -61,6 +65,13 @@ type NewServerOpts struct {
 	DestEthClient           ethClient
 	ProcessingFeeMultiplier float64
 	TaikoL2                 *taikol2.TaikoL2
+	// SelfClaimer is optional, the message proofs are only served if it's set.
+	SelfClaimer relayer.SelfClaimer
+	// MessageProofRateLimit is the number of message proofs per second served to each client IP,
+	// since each of them is generated on request.
+	MessageProofRateLimit float64
+	// EventStreamPollInterval is how often the database is polled for the events to stream.
+	EventStreamPollInterval time.Duration
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -109,6 +120,9 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 		destEthClient:           opts.DestEthClient,
 		processingFeeMultiplier: opts.ProcessingFeeMultiplier,
 		taikoL2:                 opts.TaikoL2,
+		selfClaimer:             opts.SelfClaimer,
+		messageProofRateLimit:   opts.MessageProofRateLimit,
+		eventStream:             newEventStream(opts.EventRepo, opts.EventStreamPollInterval),
 		srcChainID:              srcChainID,
 		destChainID:             destChainID,
 	}
@@ -129,6 +143,12 @@ func (srv *Server) Start(address string) error {
 	return srv.echo.Start(address)
 }
 
+// StreamEvents polls for the events to stream to the subscribers of `/events/stream`,
+// until the context is done.
+func (srv *Server) StreamEvents(ctx context.Context) {
+	srv.eventStream.run(ctx)
+}
+
 // Shutdown shuts down the HTTP server
 func (srv *Server) Shutdown(ctx context.Context) error {
 	// Close db connection.
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	echo "github.com/labstack/echo/v4"
@@ -17,9 +18,12 @@ import (
 func newTestServer() *Server {
 	_ = godotenv.Load("../.test.env")
 
+	eventRepo := mock.NewEventRepository()
+
 	srv := &Server{
-		echo:      echo.New(),
-		eventRepo: mock.NewEventRepository(),
+		echo:        echo.New(),
+		eventRepo:   eventRepo,
+		eventStream: newEventStream(eventRepo, time.Second),
 	}
 
 	srv.configureMiddleware([]string{"*"})
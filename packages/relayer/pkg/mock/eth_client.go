@@ -47,6 +47,10 @@ func (c *EthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	return big.NewInt(100), nil
 }
 
+func (c *EthClient) BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
 func (c *EthClient) ChainID(ctx context.Context) (*big.Int, error) {
 	return MockChainID, nil
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"math/rand"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/morkid/paginate"
@@ -35,9 +36,11 @@ func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts)
 		ChainID:      opts.ChainID.Int64(),
 		DestChainID:  opts.DestChainID.Int64(),
 		Name:         opts.Name,
+		Event:        opts.Event,
 		MessageOwner: opts.MessageOwner,
 		MsgHash:      opts.MsgHash,
 		EventType:    opts.EventType,
+		UpdatedAt:    time.Now().UTC(),
 	})
 
 	return nil, nil
@@ -62,6 +65,7 @@ func (r *EventRepository) UpdateStatus(ctx context.Context, id int, status relay
 	}
 
 	event.Status = status
+	event.UpdatedAt = time.Now().UTC()
 
 	r.events[index] = event
 
@@ -97,6 +101,9 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	event.GasLimit = &opts.GasLimit
 	event.IsProfitable = &opts.IsProfitable
 	event.EstimatedOnchainFee = &opts.EstimatedOnchainFee
+	event.SimulatedGasUsed = &opts.SimulatedGasUsed
+	event.L1DataFee = &opts.L1DataFee
+	event.SimulatedOnchainFee = &opts.SimulatedOnchainFee
 	currentTime := time.Now().UTC()
 	event.IsProfitableEvaluatedAt = &currentTime
 
@@ -170,6 +177,27 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return nil, nil
 }
 
+func (r *EventRepository) FindAllMessageEventsUpdatedSince(
+	ctx context.Context,
+	since time.Time,
+) ([]*relayer.Event, error) {
+	var events []*relayer.Event
+
+	for _, e := range r.events {
+		if e.Event == relayer.EventNameChainDataSynced || e.UpdatedAt.Before(since) {
+			continue
+		}
+
+		events = append(events, e)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].UpdatedAt.Before(events[j].UpdatedAt)
+	})
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -2,10 +2,12 @@ package mock
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 type TxManager struct {
@@ -15,6 +17,11 @@ func (t *TxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*typ
 	return &types.Receipt{}, nil
 }
 
+// SendAsync is used to create & send a transaction asynchronously.
+func (t *TxManager) SendAsync(ctx context.Context, candidate txmgr.TxCandidate, ch chan txmgr.SendResponse) {
+	ch <- txmgr.SendResponse{Receipt: &types.Receipt{}}
+}
+
 // From returns the sending address associated with the instance of the transaction manager.
 // It is static for a single instance of a TxManager.
 func (t *TxManager) From() common.Address {
@@ -34,3 +41,14 @@ func (t *TxManager) Close() {
 func (t *TxManager) IsClosed() bool {
 	return false
 }
+
+// API returns an rpc api interface which can be customized for each TxManager implementation
+func (t *TxManager) API() rpc.API {
+	return rpc.API{}
+}
+
+// SuggestGasPriceCaps suggests what the new tip, base fee, and blob base fee should be based on
+// the current L1 conditions.
+func (t *TxManager) SuggestGasPriceCaps(ctx context.Context) (*big.Int, *big.Int, *big.Int, error) {
+	return big.NewInt(1), big.NewInt(1), nil, nil
+}
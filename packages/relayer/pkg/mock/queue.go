@@ -7,10 +7,31 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 )
 
+// Queue is an in-memory queue, the messages published to the started queue are delivered
+// to its subscriber, and the messages published to other queues are dropped.
 type Queue struct {
+	mu        sync.Mutex
+	queueName string
+	msgs      chan queue.Message
+}
+
+func (r *Queue) messages() chan queue.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.msgs == nil {
+		r.msgs = make(chan queue.Message, 100)
+	}
+
+	return r.msgs
 }
 
 func (r *Queue) Start(ctx context.Context, queueName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.queueName = queueName
+
 	return nil
 }
 
@@ -29,6 +50,14 @@ func (r *Queue) Publish(
 	headers map[string]interface{},
 	expiration *string,
 ) error {
+	r.mu.Lock()
+	started := r.queueName
+	r.mu.Unlock()
+
+	if queueName == started {
+		r.messages() <- queue.Message{Body: msg}
+	}
+
 	return nil
 }
 
@@ -45,5 +74,21 @@ func (r *Queue) Subscribe(
 	msgChan chan<- queue.Message,
 	wg *sync.WaitGroup,
 ) error {
-	return nil
+	wg.Add(1)
+	defer wg.Done()
+
+	msgs := r.messages()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-msgs:
+			select {
+			case <-ctx.Done():
+				return nil
+			case msgChan <- msg:
+			}
+		}
+	}
 }
@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// NotSyncedMsgHash is the hash of a message whose source chain header is not synced yet.
+var NotSyncedMsgHash = [32]byte{0x4}
+
+type SelfClaimer struct {
+}
+
+func (s *SelfClaimer) SelfClaim(ctx context.Context, event *bridge.BridgeMessageSent) (*relayer.SelfClaim, error) {
+	switch event.MsgHash {
+	case SuccessMsgHash:
+	case NotSyncedMsgHash:
+		return nil, errors.Wrap(relayer.ErrHeaderNotSynced, "p.waitHeaderSynced")
+	default:
+		return nil, relayer.ErrMessageNotClaimable
+	}
+
+	return &relayer.SelfClaim{
+		To:           common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+		Data:         []byte{0x1},
+		EstimatedGas: 1,
+	}, nil
+}
@@ -0,0 +1,316 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var (
+	// pollInterval is the interval to poll the available messages.
+	pollInterval = 1 * time.Second
+	// lockTimeout is the duration a delivered message is hidden from other consumers, if it's
+	// neither acknowledged nor negatively acknowledged in time, it will be delivered again.
+	lockTimeout = 5 * time.Minute
+	// defaultPrefetchCount is the maximum number of unacknowledged messages, if no prefetch
+	// count is configured.
+	defaultPrefetchCount = 100
+)
+
+// Message is a queue message persisted in the `queue_messages` table.
+type Message struct {
+	ID          int
+	QueueName   string
+	MessageID   string
+	Body        []byte
+	Headers     datatypes.JSON
+	AvailableAt time.Time
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+}
+
+// TableName implements the gorm.Tabler interface.
+func (Message) TableName() string {
+	return "queue_messages"
+}
+
+// MySQL is a queue backed by the relayer's MySQL database, with the same dead-lettering and
+// unprofitable message expiration semantics as the RabbitMQ queue, for deployments
+// without a message broker.
+type MySQL struct {
+	db            db.DB
+	queueName     string
+	prefetchCount int
+	unacked       atomic.Int64
+}
+
+// NewQueue creates a new MySQL queue with the given database connection.
+func NewQueue(database db.DB, opts queue.NewQueueOpts) (*MySQL, error) {
+	if database == nil {
+		return nil, db.ErrNoDB
+	}
+
+	prefetchCount := int(opts.PrefetchCount)
+	if prefetchCount == 0 {
+		prefetchCount = defaultPrefetchCount
+	}
+
+	return &MySQL{db: database, prefetchCount: prefetchCount}, nil
+}
+
+// Start implements the queue.Queue interface, all queues share the `queue_messages` table,
+// so there is nothing to declare.
+func (m *MySQL) Start(ctx context.Context, queueName string) error {
+	m.queueName = queueName
+
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return nil
+}
+
+// Close closes the database connection.
+func (m *MySQL) Close(ctx context.Context) {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		slog.Error("error getting mysql queue connection", "err", err.Error())
+		return
+	}
+
+	if err := sqlDB.Close(); err != nil {
+		slog.Error("error closing mysql queue connection", "err", err.Error())
+	}
+
+	slog.Info("closed mysql queue connection")
+}
+
+// Publish implements the queue.Queue interface.
+func (m *MySQL) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	now := time.Now().UTC()
+
+	message := &Message{
+		QueueName:   queueName,
+		MessageID:   uuid.New().String(),
+		Body:        msg,
+		AvailableAt: now,
+	}
+
+	if headers != nil {
+		marshalledHeaders, err := json.Marshal(headers)
+		if err != nil {
+			return err
+		}
+
+		message.Headers = datatypes.JSON(marshalledHeaders)
+	}
+
+	// nobody consumes the unprofitable queue, its messages are dead-lettered back to the
+	// processing queue once expired, so we publish them to the processing queue directly,
+	// and make them available after the expiration.
+	if processQueueName, ok := strings.CutSuffix(queueName, "-unprofitable"); ok && expiration != nil {
+		expirationMs, err := strconv.ParseInt(*expiration, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid message expiration %v: %w", *expiration, err)
+		}
+
+		message.QueueName = processQueueName
+		message.AvailableAt = now.Add(time.Duration(expirationMs) * time.Millisecond)
+	}
+
+	slog.Info("publishing mysql queue msg to queue", "queue", message.QueueName)
+
+	if err := m.db.GormDB().WithContext(ctx).Create(message).Error; err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+// Ack implements the queue.Queue interface, the acknowledged message is deleted.
+func (m *MySQL) Ack(ctx context.Context, msg queue.Message) error {
+	message := msg.Internal.(*Message)
+
+	if err := m.db.GormDB().WithContext(ctx).Delete(&Message{}, message.ID).Error; err != nil {
+		slog.Error("error acknowledging mysql queue message", "err", err.Error())
+		return err
+	}
+
+	m.release()
+
+	slog.Info("acknowledged mysql queue message", "msgId", message.MessageID)
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+// Nack implements the queue.Queue interface, the message is made available again if requeue
+// is set, otherwise it's moved to the dead letter queue.
+func (m *MySQL) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	message := msg.Internal.(*Message)
+
+	updates := map[string]interface{}{
+		"locked_until": nil,
+		"available_at": time.Now().UTC(),
+	}
+
+	// like the RabbitMQ dead letter queue, nobody consumes the dead lettered messages.
+	if !requeue {
+		updates["queue_name"] = fmt.Sprintf("dlx-%v", message.QueueName)
+	}
+
+	if err := m.db.GormDB().
+		WithContext(ctx).
+		Model(&Message{}).
+		Where("id = ?", message.ID).
+		Updates(updates).Error; err != nil {
+		slog.Error("error negatively acknowledging mysql queue message", "err", err.Error())
+		return err
+	}
+
+	m.release()
+
+	slog.Info("negatively acknowledged mysql queue message", "msgId", message.MessageID, "requeue", requeue)
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// Notify implements the queue.Queue interface, the database connection is managed by the
+// connection pool, so there is nothing to be notified of.
+func (m *MySQL) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	<-ctx.Done()
+
+	slog.Info("mysql queue context closed")
+
+	return nil
+}
+
+// Subscribe implements the queue.Queue interface, it polls the available messages of the
+// started queue, and delivers at most `prefetchCount` unacknowledged messages.
+func (m *MySQL) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	slog.Info("subscribing to mysql queue messages", "queue", m.queueName)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		messages, err := m.claim(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			slog.Error("error claiming mysql queue messages", "err", err.Error())
+
+			return err
+		}
+
+		for _, message := range messages {
+			slog.Info("mysql queue message found", "msgId", message.MessageID)
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case msgChan <- queue.Message{Body: message.Body, Internal: message}:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("mysql queue context cancelled")
+
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim locks the next available messages of the started queue, so that they are not
+// delivered to other consumers.
+func (m *MySQL) claim(ctx context.Context) ([]*Message, error) {
+	limit := m.prefetchCount - int(m.unacked.Load())
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var (
+		now      = time.Now().UTC()
+		messages []*Message
+	)
+
+	err := m.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue_name = ?", m.queueName).
+			Where("available_at <= ?", now).
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			Order("id ASC").
+			Limit(limit).
+			Find(&messages).Error; err != nil {
+			return err
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		ids := make([]int, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		return tx.Model(&Message{}).Where("id IN ?", ids).Update("locked_until", now.Add(lockTimeout)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.unacked.Add(int64(len(messages)))
+
+	return messages, nil
+}
+
+// release releases the prefetch capacity of an acknowledged or negatively acknowledged message.
+func (m *MySQL) release() {
+	if m.unacked.Add(-1) < 0 {
+		m.unacked.Store(0)
+	}
+}
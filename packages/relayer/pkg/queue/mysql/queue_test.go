@@ -0,0 +1,193 @@
+package mysql
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var testQueueName = "1-2-MessageSent-queue"
+
+func Test_NewQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewQueue(tt.db, queue.NewQueueOpts{})
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// subscribe starts the given queue and subscribes to its messages until the test ends.
+func subscribe(t *testing.T, q *MySQL) <-chan queue.Message {
+	pollInterval = 100 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	msgCh := make(chan queue.Message)
+
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	assert.Equal(t, nil, q.Start(ctx, testQueueName))
+
+	go func() {
+		_ = q.Subscribe(ctx, msgCh, wg)
+	}()
+
+	return msgCh
+}
+
+// receive waits for the next delivered message, and returns false if none is delivered in time.
+func receive(msgCh <-chan queue.Message, timeout time.Duration) (queue.Message, bool) {
+	select {
+	case msg := <-msgCh:
+		return msg, true
+	case <-time.After(timeout):
+		return queue.Message{}, false
+	}
+}
+
+func TestIntegration_Queue_PublishAck(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q, err := NewQueue(db, queue.NewQueueOpts{})
+	assert.Equal(t, nil, err)
+
+	msgCh := subscribe(t, q)
+
+	assert.Equal(t, nil, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	msg, ok := receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []byte("msg"), msg.Body)
+
+	// a delivered message is not delivered again before it's acknowledged
+	_, ok = receive(msgCh, time.Second)
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, nil, q.Ack(context.Background(), msg))
+
+	var count int64
+	assert.Equal(t, nil, db.GormDB().Model(&Message{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestIntegration_Queue_Nack(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q, err := NewQueue(db, queue.NewQueueOpts{})
+	assert.Equal(t, nil, err)
+
+	msgCh := subscribe(t, q)
+
+	assert.Equal(t, nil, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	msg, ok := receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+
+	// requeued messages are delivered again
+	assert.Equal(t, nil, q.Nack(context.Background(), msg, true))
+
+	msg, ok = receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []byte("msg"), msg.Body)
+
+	// otherwise they are dead lettered
+	assert.Equal(t, nil, q.Nack(context.Background(), msg, false))
+
+	_, ok = receive(msgCh, time.Second)
+	assert.Equal(t, false, ok)
+
+	var message Message
+	assert.Equal(t, nil, db.GormDB().First(&message).Error)
+	assert.Equal(t, "dlx-"+testQueueName, message.QueueName)
+}
+
+func TestIntegration_Queue_PrefetchCount(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q, err := NewQueue(db, queue.NewQueueOpts{PrefetchCount: 1})
+	assert.Equal(t, nil, err)
+
+	msgCh := subscribe(t, q)
+
+	assert.Equal(t, nil, q.Publish(context.Background(), testQueueName, []byte("first"), nil, nil))
+	assert.Equal(t, nil, q.Publish(context.Background(), testQueueName, []byte("second"), nil, nil))
+
+	msg, ok := receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []byte("first"), msg.Body)
+
+	_, ok = receive(msgCh, time.Second)
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, nil, q.Ack(context.Background(), msg))
+
+	msg, ok = receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []byte("second"), msg.Body)
+}
+
+func TestIntegration_Queue_Unprofitable(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q, err := NewQueue(db, queue.NewQueueOpts{})
+	assert.Equal(t, nil, err)
+
+	msgCh := subscribe(t, q)
+
+	expiration := "2000"
+
+	assert.Equal(t, nil, q.Publish(
+		context.Background(),
+		testQueueName+"-unprofitable",
+		[]byte("msg"),
+		nil,
+		&expiration,
+	))
+
+	// unprofitable messages are delivered to the processing queue once expired
+	_, ok := receive(msgCh, time.Second)
+	assert.Equal(t, false, ok)
+
+	msg, ok := receive(msgCh, 5*time.Second)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []byte("msg"), msg.Body)
+}
@@ -12,6 +12,12 @@ var (
 	ErrClosed = errors.New("queue connection closed")
 )
 
+// Queue types which can be selected by the `queue.type` flag.
+const (
+	TypeRabbitMQ = "rabbitmq"
+	TypeMySQL    = "mysql"
+)
+
 type Queue interface {
 	Start(ctx context.Context, queueName string) error
 	Close(ctx context.Context)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 	"log/slog"
 	"github.com/morkid/paginate"
 	"github.com/pkg/errors"
@@ -95,6 +96,9 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 		"gas_limit":                  opts.GasLimit,
 		"is_profitable":              opts.IsProfitable,
 		"estimated_onchain_fee":      opts.EstimatedOnchainFee,
+		"simulated_gas_used":         opts.SimulatedGasUsed,
+		"l1_data_fee":                opts.L1DataFee,
+		"simulated_onchain_fee":      opts.SimulatedOnchainFee,
 		"is_profitable_evaluated_at": opts.IsProfitableEvaluatedAt,
 	}).Error
 
@@ -208,6 +212,29 @@ func (r *EventRepository) FindAllByAddress(
 	return &page, nil
 }
 
+// FindAllMessageEventsUpdatedSince returns the message lifecycle events saved or updated
+// at or after the given time, oldest first.
+func (r *EventRepository) FindAllMessageEventsUpdatedSince(
+	ctx context.Context,
+	since time.Time,
+) ([]*relayer.Event, error) {
+	var events []*relayer.Event
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("event IN ?", []string{
+			relayer.EventNameMessageSent,
+			relayer.EventNameMessageStatusChanged,
+			relayer.EventNameMessageProcessed,
+		}).
+		Where("updated_at >= ?", since).
+		Order("updated_at ASC, id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -0,0 +1,107 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	ErrNoRoutes       = errors.New("empty route table")
+	ErrNoSrcRPCUrl    = errors.New("srcRpcUrl is required")
+	ErrNoDestRPCUrl   = errors.New("destRpcUrl is required")
+	ErrNoSrcBridge    = errors.New("srcBridgeAddress is required")
+	ErrNoDestBridge   = errors.New("destBridgeAddress is required")
+	ErrIncompleteHop  = errors.New("hops require signalServiceAddress, taikoAddress and rpcUrl")
+	ErrDuplicateRoute = errors.New("duplicate route")
+)
+
+// Hop is an intermediary chain a message has to be proven through, when the destination
+// chain does not sync the source chain directly.
+type Hop struct {
+	SignalServiceAddress common.Address `json:"signalServiceAddress"`
+	TaikoAddress         common.Address `json:"taikoAddress"`
+	RPCUrl               string         `json:"rpcUrl"`
+}
+
+// Route is a source => destination chain pair served by the relayer, the keys of its JSON
+// encoding are the names of the corresponding single route flags.
+type Route struct {
+	SrcRPCUrl               string         `json:"srcRpcUrl"`
+	DestRPCUrl              string         `json:"destRpcUrl"`
+	SrcBridgeAddress        common.Address `json:"srcBridgeAddress"`
+	SrcSignalServiceAddress common.Address `json:"srcSignalServiceAddress"`
+	SrcTaikoAddress         common.Address `json:"srcTaikoAddress"`
+	DestBridgeAddress       common.Address `json:"destBridgeAddress"`
+	DestERC20VaultAddress   common.Address `json:"destERC20VaultAddress"`
+	DestERC721VaultAddress  common.Address `json:"destERC721Address"`
+	DestERC1155VaultAddress common.Address `json:"destERC1155Address"`
+	DestTaikoAddress        common.Address `json:"destTaikoAddress"`
+	DestQuotaManagerAddress common.Address `json:"destQuotaManagerAddress"`
+	Hops                    []Hop          `json:"hops"`
+}
+
+// Validate checks the fields every route requires, the addresses only required by
+// one of the indexer or the processor are left to them.
+func (r *Route) Validate() error {
+	if r.SrcRPCUrl == "" {
+		return ErrNoSrcRPCUrl
+	}
+
+	if r.DestRPCUrl == "" {
+		return ErrNoDestRPCUrl
+	}
+
+	if r.DestBridgeAddress == (common.Address{}) {
+		return ErrNoDestBridge
+	}
+
+	for _, hop := range r.Hops {
+		if hop.SignalServiceAddress == (common.Address{}) ||
+			hop.TaikoAddress == (common.Address{}) ||
+			hop.RPCUrl == "" {
+			return ErrIncompleteHop
+		}
+	}
+
+	return nil
+}
+
+// LoadRoutes reads the route table from the given JSON file, which is a list of routes.
+func LoadRoutes(path string) ([]Route, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("invalid route table %v: %w", path, err)
+	}
+
+	if len(routes) == 0 {
+		return nil, ErrNoRoutes
+	}
+
+	seen := make(map[[2]string]int, len(routes))
+
+	for i, r := range routes {
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid route %d: %w", i, err)
+		}
+
+		// routes are told apart by their chain IDs, which are only known once connected,
+		// so at least make sure the same pair of endpoints is not served twice.
+		endpoints := [2]string{r.SrcRPCUrl, r.DestRPCUrl}
+		if j, ok := seen[endpoints]; ok {
+			return nil, fmt.Errorf("%w: routes %d and %d", ErrDuplicateRoute, j, i)
+		}
+
+		seen[endpoints] = i
+	}
+
+	return routes, nil
+}
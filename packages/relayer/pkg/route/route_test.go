@@ -0,0 +1,96 @@
+package route
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	bridgeAddr = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	taikoAddr  = "0x53FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+)
+
+func writeRoutes(t *testing.T, routes string) string {
+	path := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(path, []byte(routes), 0600))
+
+	return path
+}
+
+func TestLoadRoutes(t *testing.T) {
+	routes, err := LoadRoutes(writeRoutes(t, `[
+		{"srcRpcUrl": "l1", "destRpcUrl": "l2", "destBridgeAddress": "`+bridgeAddr+`"},
+		{"srcRpcUrl": "l3", "destRpcUrl": "l2", "destBridgeAddress": "`+bridgeAddr+`",
+			"hops": [{"signalServiceAddress": "`+bridgeAddr+`", "taikoAddress": "`+taikoAddr+`", "rpcUrl": "l1"}]}
+	]`))
+	assert.Nil(t, err)
+	assert.Equal(t, []Route{
+		{
+			SrcRPCUrl:         "l1",
+			DestRPCUrl:        "l2",
+			DestBridgeAddress: common.HexToAddress(bridgeAddr),
+		},
+		{
+			SrcRPCUrl:         "l3",
+			DestRPCUrl:        "l2",
+			DestBridgeAddress: common.HexToAddress(bridgeAddr),
+			Hops: []Hop{{
+				SignalServiceAddress: common.HexToAddress(bridgeAddr),
+				TaikoAddress:         common.HexToAddress(taikoAddr),
+				RPCUrl:               "l1",
+			}},
+		},
+	}, routes)
+}
+
+func TestLoadRoutes_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  string
+		wantErr error
+	}{
+		{
+			"empty",
+			`[]`,
+			ErrNoRoutes,
+		},
+		{
+			"noSrcRpcUrl",
+			`[{"destRpcUrl": "l2", "destBridgeAddress": "` + bridgeAddr + `"}]`,
+			ErrNoSrcRPCUrl,
+		},
+		{
+			"noDestRpcUrl",
+			`[{"srcRpcUrl": "l1", "destBridgeAddress": "` + bridgeAddr + `"}]`,
+			ErrNoDestRPCUrl,
+		},
+		{
+			"noDestBridge",
+			`[{"srcRpcUrl": "l1", "destRpcUrl": "l2"}]`,
+			ErrNoDestBridge,
+		},
+		{
+			"incompleteHop",
+			`[{"srcRpcUrl": "l1", "destRpcUrl": "l2", "destBridgeAddress": "` + bridgeAddr + `",
+				"hops": [{"rpcUrl": "l1"}]}]`,
+			ErrIncompleteHop,
+		},
+		{
+			"duplicate",
+			`[{"srcRpcUrl": "l1", "destRpcUrl": "l2", "destBridgeAddress": "` + bridgeAddr + `"},
+				{"srcRpcUrl": "l1", "destRpcUrl": "l2", "destBridgeAddress": "` + bridgeAddr + `"}]`,
+			ErrDuplicateRoute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRoutes(writeRoutes(t, tt.routes))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
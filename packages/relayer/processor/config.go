@@ -16,7 +16,8 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/route"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // hopConfig is a config struct that must be provided for an individual
@@ -44,6 +45,7 @@ type Config struct {
 
 	// private key
 	ProcessorPrivateKey *ecdsa.PrivateKey
+	RemoteSigner        *signer.RemoteSignerConfig
 
 	TargetTxHash *common.Hash
 
@@ -88,34 +90,57 @@ type Config struct {
 
 	MaxMessageRetries uint64
 	MinFeeToProcess   uint64
+
+	// txmgrs are the transaction managers shared by the routes with the same destination
+	// chain, keyed by its chain ID, so that their transactions don't race for the nonces.
+	txmgrs map[string]txmgr.TxManager
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	processorPrivateKey, err := crypto.ToECDSA(
-		common.Hex2Bytes(c.String(flags.ProcessorPrivateKey.Name)),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("invalid processorPrivateKey: %w", err)
+	if !c.IsSet(flags.Routes.Name) {
+		if err := flags.CheckRequired(
+			c,
+			flags.SrcRPCUrl,
+			flags.DestRPCUrl,
+			flags.DestBridgeAddress,
+			flags.DestTaikoAddress,
+			flags.DestERC20VaultAddress,
+			flags.DestERC721VaultAddress,
+			flags.DestERC1155VaultAddress,
+		); err != nil {
+			return nil, err
+		}
 	}
 
-	hopSignalServiceAddresses := c.StringSlice(flags.HopSignalServiceAddresses.Name)
-	hopTaikoAddresses := c.StringSlice(flags.HopTaikoAddresses.Name)
-	hopRPCUrls := c.StringSlice(flags.HopRPCUrls.Name)
+	var (
+		processorPrivateKey *ecdsa.PrivateKey
+		remoteSigner        *signer.RemoteSignerConfig
+		err                 error
+	)
+	if c.IsSet(flags.ProcessorRemoteSignerEndpoint.Name) {
+		address := c.String(flags.ProcessorRemoteSignerAddress.Name)
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid processorRemoteSigner.address: %s", address)
+		}
 
-	if len(hopSignalServiceAddresses) != len(hopTaikoAddresses) ||
-		len(hopSignalServiceAddresses) != len(hopRPCUrls) ||
-		len(hopTaikoAddresses) != len(hopRPCUrls) {
-		return nil, fmt.Errorf("all hop parameters must be of same length")
+		remoteSigner = &signer.RemoteSignerConfig{
+			Endpoint: c.String(flags.ProcessorRemoteSignerEndpoint.Name),
+			Address:  common.HexToAddress(address),
+			Timeout:  c.Duration(flags.RPCTimeout.Name),
+		}
+	} else {
+		processorPrivateKey, err = crypto.ToECDSA(
+			common.Hex2Bytes(c.String(flags.ProcessorPrivateKey.Name)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid processorPrivateKey: %w", err)
+		}
 	}
 
-	hopConfigs := []hopConfig{}
-	for i, hopSignalServiceAddress := range hopSignalServiceAddresses {
-		hopConfigs = append(hopConfigs, hopConfig{
-			signalServiceAddress: common.HexToAddress(hopSignalServiceAddress),
-			rpcURL:               hopRPCUrls[i],
-			taikoAddress:         common.HexToAddress(hopTaikoAddresses[i]),
-		})
+	hopConfigs, err := hopConfigsFromCliContext(c)
+	if err != nil {
+		return nil, err
 	}
 
 	var targetTxHash *common.Hash
@@ -137,9 +162,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
 	}
 
-	return &Config{
+	cfg := &Config{
 		hopConfigs:                         hopConfigs,
 		ProcessorPrivateKey:                processorPrivateKey,
+		RemoteSigner:                       remoteSigner,
 		SrcSignalServiceAddress:            common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
 		DestTaikoAddress:                   common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
 		DestBridgeAddress:                  common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
@@ -200,21 +226,133 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
-		},
+	}
+
+	// The MySQL queue is opened through OpenDBFunc, which may be replaced after the config is created.
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		opts := queue.NewQueueOpts{
+			Username:      c.String(flags.QueueUsername.Name),
+			Password:      c.String(flags.QueuePassword.Name),
+			Host:          c.String(flags.QueueHost.Name),
+			Port:          c.String(flags.QueuePort.Name),
+			PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+		}
+
+		return pkgFlags.OpenQueueFromCli(c, opts, cfg.OpenDBFunc)
+	}
+
+	return cfg, nil
+}
+
+// NewProverConfigFromCliContext creates a config instance with only the fields needed to
+// generate the signal proofs from command line flags, see InitProverFromConfig.
+func NewProverConfigFromCliContext(c *cli.Context) (*Config, error) {
+	if err := flags.CheckRequired(
+		c,
+		flags.SrcRPCUrl,
+		flags.DestRPCUrl,
+		flags.SrcSignalServiceAddress,
+		flags.DestBridgeAddress,
+	); err != nil {
+		return nil, err
+	}
+
+	hopConfigs, err := hopConfigsFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		hopConfigs:              hopConfigs,
+		SrcSignalServiceAddress: common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
+		DestBridgeAddress:       common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
+		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
+		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
+		ETHClientTimeout:        c.Uint64(flags.ETHClientTimeout.Name),
+		CacheOption:             c.Int(flags.CacheOption.Name),
 	}, nil
 }
+
+// hopConfigsFromCliContext creates the hop configs from the hop flags, which must all be
+// of the same length.
+func hopConfigsFromCliContext(c *cli.Context) ([]hopConfig, error) {
+	hopSignalServiceAddresses := c.StringSlice(flags.HopSignalServiceAddresses.Name)
+	hopTaikoAddresses := c.StringSlice(flags.HopTaikoAddresses.Name)
+	hopRPCUrls := c.StringSlice(flags.HopRPCUrls.Name)
+
+	if len(hopSignalServiceAddresses) != len(hopTaikoAddresses) ||
+		len(hopSignalServiceAddresses) != len(hopRPCUrls) ||
+		len(hopTaikoAddresses) != len(hopRPCUrls) {
+		return nil, fmt.Errorf("all hop parameters must be of same length")
+	}
+
+	hopConfigs := []hopConfig{}
+	for i, hopSignalServiceAddress := range hopSignalServiceAddresses {
+		hopConfigs = append(hopConfigs, hopConfig{
+			signalServiceAddress: common.HexToAddress(hopSignalServiceAddress),
+			rpcURL:               hopRPCUrls[i],
+			taikoAddress:         common.HexToAddress(hopTaikoAddresses[i]),
+		})
+	}
+
+	return hopConfigs, nil
+}
+
+// NewRouteConfigsFromCliContext creates a config instance for each route of the route table
+// set by the command line flags, or a single one from the single route flags if not set.
+func NewRouteConfigsFromCliContext(c *cli.Context) ([]*Config, error) {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.IsSet(flags.Routes.Name) {
+		return []*Config{cfg}, nil
+	}
+
+	if cfg.TargetTxHash != nil {
+		return nil, fmt.Errorf("%v can not be used with %v", flags.TargetTxHash.Name, flags.Routes.Name)
+	}
+
+	routes, err := route.LoadRoutes(c.String(flags.Routes.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cfgs   = make([]*Config, 0, len(routes))
+		txmgrs = make(map[string]txmgr.TxManager)
+	)
+
+	for _, r := range routes {
+		hopConfigs := []hopConfig{}
+		for _, hop := range r.Hops {
+			hopConfigs = append(hopConfigs, hopConfig{
+				signalServiceAddress: hop.SignalServiceAddress,
+				rpcURL:               hop.RPCUrl,
+				taikoAddress:         hop.TaikoAddress,
+			})
+		}
+
+		txmgrConfigs := *cfg.TxmgrConfigs
+		txmgrConfigs.L1RPCURL = r.DestRPCUrl
+
+		routeCfg := *cfg
+		routeCfg.hopConfigs = hopConfigs
+		routeCfg.SrcRPCUrl = r.SrcRPCUrl
+		routeCfg.DestRPCUrl = r.DestRPCUrl
+		routeCfg.SrcSignalServiceAddress = r.SrcSignalServiceAddress
+		routeCfg.DestBridgeAddress = r.DestBridgeAddress
+		routeCfg.DestERC20VaultAddress = r.DestERC20VaultAddress
+		routeCfg.DestERC721VaultAddress = r.DestERC721VaultAddress
+		routeCfg.DestERC1155VaultAddress = r.DestERC1155VaultAddress
+		routeCfg.DestTaikoAddress = r.DestTaikoAddress
+		routeCfg.DestQuotaManagerAddress = r.DestQuotaManagerAddress
+		routeCfg.TxmgrConfigs = &txmgrConfigs
+		routeCfg.txmgrs = txmgrs
+
+		cfgs = append(cfgs, &routeCfg)
+	}
+
+	return cfgs, nil
+}
@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,6 +16,7 @@ import (
 )
 
 var (
+	dummyEcdsaKey           = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
 	destBridgeAddr          = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
 	destQuotaManagerAddr    = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357"
 	headerSyncInterval      = "30"
@@ -145,3 +148,75 @@ func TestNewConfigFromCliContext_PrivKeyError(t *testing.T) {
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
 	}), "invalid processorPrivateKey")
 }
+
+func TestNewRouteConfigsFromCliContext(t *testing.T) {
+	routes := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(routes, []byte(`[
+		{"srcRpcUrl": "l1RpcUrl", "destRpcUrl": "l2RpcUrl", "destBridgeAddress": "`+destBridgeAddr+`",
+			"destQuotaManagerAddress": "`+destQuotaManagerAddr+`"},
+		{"srcRpcUrl": "l3RpcUrl", "destRpcUrl": "l2RpcUrl", "destBridgeAddress": "`+destBridgeAddr+`",
+			"hops": [{"signalServiceAddress": "`+destBridgeAddr+`", "taikoAddress": "`+destBridgeAddr+`",
+				"rpcUrl": "l1RpcUrl"}]}
+	]`), 0600))
+
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		cfgs, err := NewRouteConfigsFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(cfgs))
+		assert.Equal(t, "l1RpcUrl", cfgs[0].SrcRPCUrl)
+		assert.Equal(t, "l2RpcUrl", cfgs[0].DestRPCUrl)
+		assert.Equal(t, "l2RpcUrl", cfgs[0].TxmgrConfigs.L1RPCURL)
+		assert.Equal(t, common.HexToAddress(destQuotaManagerAddr), cfgs[0].DestQuotaManagerAddress)
+		assert.Empty(t, cfgs[0].hopConfigs)
+		assert.Equal(t, "l3RpcUrl", cfgs[1].SrcRPCUrl)
+		assert.Equal(t, []hopConfig{{
+			signalServiceAddress: common.HexToAddress(destBridgeAddr),
+			taikoAddress:         common.HexToAddress(destBridgeAddr),
+			rpcURL:               "l1RpcUrl",
+		}}, cfgs[1].hopConfigs)
+
+		// the routes share their transaction managers.
+		assert.NotNil(t, cfgs[0].txmgrs)
+		cfgs[0].txmgrs["167"] = &mock.TxManager{}
+		assert.Equal(t, cfgs[0].txmgrs, cfgs[1].txmgrs)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewRouteConfigsFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.Routes.Name, routes,
+	}))
+}
+
+func TestNewRouteConfigsFromCliContext_TargetTxHash(t *testing.T) {
+	routes := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(routes, []byte(`[
+		{"srcRpcUrl": "l1RpcUrl", "destRpcUrl": "l2RpcUrl", "destBridgeAddress": "`+destBridgeAddr+`"}
+	]`), 0600))
+
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		_, err := NewRouteConfigsFromCliContext(ctx)
+		return err
+	}
+
+	assert.ErrorContains(t, app.Run([]string{
+		"TestNewRouteConfigsFromCliContext_TargetTxHash",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.TargetTxHash.Name, "0x1",
+		"--" + flags.Routes.Name, routes,
+	}), "targetTxHash can not be used with routes")
+}
@@ -15,12 +15,15 @@ var (
 
 // isProfitable determines whether a message is profitable or not. It should
 // check the processing fee, if one does not exist at all, it is definitely not
-// profitable. Otherwise, we compare it to the estimated cost.
+// profitable. Otherwise, we compare it to the cost of the simulated transaction,
+// and record it along with the cost of the gas limit declared by the message.
 func (p *Processor) isProfitable(
 	ctx context.Context,
 	id int,
 	fee uint64,
 	gasLimit uint64,
+	simulatedGasUsed uint64,
+	l1DataFee uint64,
 	destChainBaseFee uint64,
 	gasTipCap uint64,
 ) (bool, error) {
@@ -35,10 +38,13 @@ func (p *Processor) isProfitable(
 		return shouldProcess, errImpossible
 	}
 
-	// if processing fee is higher than baseFee * 2 +gasTipCap +  gasLimit,
-	// we should process.
-	estimatedOnchainFee := ((destChainBaseFee * 2) + gasTipCap) * uint64(gasLimit)
-	if fee > estimatedOnchainFee {
+	// if processing fee is higher than (baseFee * 2 + gasTipCap) * simulatedGasUsed
+	// + l1DataFee, we should process.
+	gasPrice := (destChainBaseFee * 2) + gasTipCap
+	estimatedOnchainFee := gasPrice * gasLimit
+	simulatedOnchainFee := gasPrice*simulatedGasUsed + l1DataFee
+
+	if fee > simulatedOnchainFee {
 		shouldProcess = true
 	}
 
@@ -47,8 +53,11 @@ func (p *Processor) isProfitable(
 		"destChainBaseFee", destChainBaseFee,
 		"gasTipCap", gasTipCap,
 		"gasLimit", gasLimit,
+		"simulatedGasUsed", simulatedGasUsed,
+		"l1DataFee", l1DataFee,
 		"shouldProcess", shouldProcess,
 		"estimatedOnchainFee", estimatedOnchainFee,
+		"simulatedOnchainFee", simulatedOnchainFee,
 	)
 
 	opts := relayer.UpdateFeesAndProfitabilityOpts{
@@ -58,6 +67,9 @@ func (p *Processor) isProfitable(
 		GasLimit:                gasLimit,
 		IsProfitable:            shouldProcess,
 		EstimatedOnchainFee:     estimatedOnchainFee,
+		SimulatedGasUsed:        simulatedGasUsed,
+		L1DataFee:               l1DataFee,
+		SimulatedOnchainFee:     simulatedOnchainFee,
 		IsProfitableEvaluatedAt: time.Now().UTC(),
 	}
 
@@ -66,7 +78,7 @@ func (p *Processor) isProfitable(
 	}
 
 	if !shouldProcess {
-		relayer.UnprofitableMessagesDetected.Inc()
+		relayer.UnprofitableMessagesDetected.WithLabelValues(p.routeLabels()...).Inc()
 
 		return false, nil
 	}
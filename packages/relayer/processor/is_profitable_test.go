@@ -11,14 +11,16 @@ func Test_isProfitable(t *testing.T) {
 	p := newTestProcessor(true)
 
 	tests := []struct {
-		id             int
-		name           string
-		fee            uint64
-		gasLimit       uint64
-		baseFee        uint64
-		gasTipCap      uint64
-		wantProfitable bool
-		wantErr        error
+		id               int
+		name             string
+		fee              uint64
+		gasLimit         uint64
+		simulatedGasUsed uint64
+		l1DataFee        uint64
+		baseFee          uint64
+		gasTipCap        uint64
+		wantProfitable   bool
+		wantErr          error
 	}{
 		{
 			0,
@@ -26,6 +28,8 @@ func Test_isProfitable(t *testing.T) {
 			0,
 			1,
 			1,
+			0,
+			1,
 			1,
 			false,
 			errImpossible,
@@ -35,6 +39,8 @@ func Test_isProfitable(t *testing.T) {
 			"profitable",
 			7000000000600001,
 			600000,
+			600000,
+			0,
 			1000000000,
 			1,
 			true,
@@ -45,6 +51,32 @@ func Test_isProfitable(t *testing.T) {
 			"unprofitable",
 			590000000600000,
 			600000,
+			600000,
+			0,
+			1000000000,
+			1,
+			false,
+			nil,
+		},
+		{
+			3,
+			"profitableSimulatedGasBelowGasLimit",
+			590000000600000,
+			600000,
+			200000,
+			0,
+			1000000000,
+			1,
+			true,
+			nil,
+		},
+		{
+			4,
+			"unprofitableL1DataFee",
+			590000000600000,
+			600000,
+			200000,
+			200000000000000,
 			1000000000,
 			1,
 			false,
@@ -59,6 +91,8 @@ func Test_isProfitable(t *testing.T) {
 				tt.id,
 				tt.fee,
 				tt.gasLimit,
+				tt.simulatedGasUsed,
+				tt.l1DataFee,
 				tt.baseFee,
 				tt.gasTipCap,
 			)
@@ -0,0 +1,67 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v2"
+)
+
+// MultiProcessor serves all the routes of the route table in one process, with a Processor
+// per route. Each of them has its own clients, queue and backoff, so a failing route does not
+// hold the others back, while the routes to the same destination chain share their
+// transaction manager.
+type MultiProcessor struct {
+	processors []*Processor
+}
+
+// InitFromCli creates a new MultiProcessor from a cli context
+func (m *MultiProcessor) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfgs, err := NewRouteConfigsFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitMultiFromConfigs(ctx, m, cfgs)
+}
+
+// InitMultiFromConfigs inits a new MultiProcessor with a Processor for each of the provided
+// Config structs.
+func InitMultiFromConfigs(ctx context.Context, m *MultiProcessor, cfgs []*Config) error {
+	for i, cfg := range cfgs {
+		p := new(Processor)
+
+		if err := InitFromConfig(ctx, p, cfg); err != nil {
+			return fmt.Errorf("route %d: %w", i, err)
+		}
+
+		m.processors = append(m.processors, p)
+	}
+
+	return nil
+}
+
+func (m *MultiProcessor) Name() string {
+	return "processor"
+}
+
+// Start starts the processors of all the routes.
+func (m *MultiProcessor) Start() error {
+	for _, p := range m.processors {
+		slog.Info("starting route processor", "srcChainId", p.srcChainId, "destChainId", p.destChainId)
+
+		if err := p.Start(); err != nil {
+			return fmt.Errorf("route %v => %v: %w", p.srcChainId, p.destChainId, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the processors of all the routes.
+func (m *MultiProcessor) Close(ctx context.Context) {
+	for _, p := range m.processors {
+		p.Close(ctx)
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -221,9 +220,9 @@ func (p *Processor) processMessage(
 	)
 
 	if messageStatus == uint8(relayer.EventStatusRetriable) {
-		relayer.RetriableEvents.Inc()
+		relayer.RetriableEvents.WithLabelValues(p.routeLabels()...).Inc()
 	} else if messageStatus == uint8(relayer.EventStatusDone) {
-		relayer.DoneEvents.Inc()
+		relayer.DoneEvents.WithLabelValues(p.routeLabels()...).Inc()
 	}
 	slog.Info("processing message EventStatusRetriable")
 	// internal will only be set if it's an actual queue message, not a targeted
@@ -427,7 +426,7 @@ func (p *Processor) sendProcessMessageCall(
 			"srcChainId", event.Message.SrcChainId,
 		)
 
-		relayer.MessagesNotReceivedOnDestChain.Inc()
+		relayer.MessagesNotReceivedOnDestChain.WithLabelValues(p.routeLabels()...).Inc()
 
 		return nil, errors.New("message not received")
 	}
@@ -463,11 +462,28 @@ func (p *Processor) sendProcessMessageCall(
 	var estimatedMaxCost uint64
 
 	if bool(p.profitableOnly) {
+		// simulate the transaction, so we price the gas it actually uses rather
+		// than the gas limit declared by the message.
+		gasUsed, l1DataFee, err := p.simulateProcessMessage(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("estimatedGasUsed",
+			"gasUsed", gasUsed,
+			"l1DataFee", l1DataFee,
+			"messageGasLimit", event.Message.GasLimit,
+			"paddedGasLimit", gasLimit,
+			"srcTxHash", event.Raw.TxHash.Hex(),
+		)
+
 		profitable, err := p.isProfitable(
 			ctx,
 			id,
 			event.Message.Fee,
 			gasLimit,
+			gasUsed,
+			l1DataFee,
 			baseFee.Uint64(),
 			gasTipCap.Uint64(),
 		)
@@ -479,30 +495,6 @@ func (p *Processor) sendProcessMessageCall(
 			return nil, relayer.ErrUnprofitable
 		}
 
-		// now simulate the transaction and lets confirm it is profitable
-		auth, err := bind.NewKeyedTransactorWithChainID(p.ecdsaKey, p.destChainId)
-		if err != nil {
-			return nil, err
-		}
-
-		msg := ethereum.CallMsg{
-			From: auth.From,
-			To:   &p.cfg.DestBridgeAddress,
-			Data: data,
-		}
-
-		gasUsed, err := p.destEthClient.EstimateGas(context.Background(), msg)
-		if err != nil {
-			return nil, err
-		}
-
-		slog.Info("estimatedGasUsed",
-			"gasUsed", gasUsed,
-			"messageGasLimit", event.Message.GasLimit,
-			"paddedGasLimit", gasLimit,
-			"srcTxHash", event.Raw.TxHash.Hex(),
-		)
-
 		if gasUsed > gasLimit {
 			return nil, relayer.ErrUnprofitable
 		}
@@ -549,7 +541,7 @@ func (p *Processor) sendProcessMessageCall(
 	)
 
 	if receipt.Status != types.ReceiptStatusSuccessful {
-		relayer.MessageSentEventsProcessedReverted.Inc()
+		relayer.MessageSentEventsProcessedReverted.WithLabelValues(p.routeLabels()...).Inc()
 		slog.Warn("Transaction reverted", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
 			"srcTxHash", event.Raw.TxHash.Hex(),
 			"status", receipt.Status)
@@ -557,7 +549,7 @@ func (p *Processor) sendProcessMessageCall(
 		return nil, errTxReverted
 	}
 
-	relayer.MessageSentEventsProcessed.Inc()
+	relayer.MessageSentEventsProcessed.WithLabelValues(p.routeLabels()...).Inc()
 
 	if p.profitableOnly {
 		cost := receipt.GasUsed * receipt.EffectiveGasPrice.Uint64()
@@ -569,9 +561,9 @@ func (p *Processor) sendProcessMessageCall(
 		)
 
 		if cost > estimatedMaxCost {
-			relayer.UnprofitableMessageAfterTransacting.Inc()
+			relayer.UnprofitableMessageAfterTransacting.WithLabelValues(p.routeLabels()...).Inc()
 		} else {
-			relayer.ProfitableMessageAfterTransacting.Inc()
+			relayer.ProfitableMessageAfterTransacting.WithLabelValues(p.routeLabels()...).Inc()
 		}
 	}
 
@@ -602,7 +594,7 @@ func (p *Processor) logRelayerBalance(ctx context.Context) {
 	)
 
 	balanceEthFloat, _ := balanceEth.Float64()
-	relayer.RelayerKeyBalanceGauge.Set(balanceEthFloat)
+	relayer.RelayerKeyBalanceGauge.WithLabelValues(p.routeLabels()...).Set(balanceEthFloat)
 }
 
 // saveMessageStatusChangedEvent writes the MessageStatusChanged event to the
@@ -49,23 +49,7 @@ func Test_sendProcessMessageCall(t *testing.T) {
 
 func Test_ProcessMessage_messageUnprocessable(t *testing.T) {
 	p := newTestProcessor(true)
-	body := &queue.QueueMessageSentBody{
-		Event: &bridge.BridgeMessageSent{
-			Message: bridge.IBridgeMessage{
-				GasLimit:   1,
-				SrcChainId: mock.MockChainID.Uint64(),
-				Id:         1,
-			},
-			Raw: types.Log{
-				Address: relayer.ZeroAddress,
-				Topics: []common.Hash{
-					relayer.ZeroHash,
-				},
-				Data: []byte{0xff},
-			},
-		},
-		ID: 0,
-	}
+	body := unprocessableMessageBody()
 
 	marshalled, err := json.Marshal(body)
 	assert.Nil(t, err)
@@ -84,32 +68,7 @@ func Test_ProcessMessage_messageUnprocessable(t *testing.T) {
 func Test_ProcessMessage_unprofitable(t *testing.T) {
 	p := newTestProcessor(true)
 
-	body := queue.QueueMessageSentBody{
-		Event: &bridge.BridgeMessageSent{
-			Message: bridge.IBridgeMessage{
-				Id:          1,
-				From:        common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
-				DestChainId: mock.MockChainID.Uint64(),
-				SrcChainId:  mock.MockChainID.Uint64(),
-				SrcOwner:    common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
-				DestOwner:   common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
-				To:          common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
-				Value:       big.NewInt(0),
-				GasLimit:    600000,
-				Fee:         1,
-				Data:        []byte{},
-			},
-			MsgHash: mock.SuccessMsgHash,
-			Raw: types.Log{
-				Address: relayer.ZeroAddress,
-				Topics: []common.Hash{
-					relayer.ZeroHash,
-				},
-				Data: []byte{0xff},
-			},
-		},
-		ID: 0,
-	}
+	body := unprofitableMessageBody()
 
 	marshalled, err := json.Marshal(body)
 	assert.Nil(t, err)
@@ -2,7 +2,6 @@ package processor
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,7 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -37,6 +35,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // ethClient is a slimmed down interface of a go-ethereum ethclient.Client
@@ -46,10 +45,12 @@ type ethClient interface {
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	BlockNumber(ctx context.Context) (uint64, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
 	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	BlobBaseFee(ctx context.Context) (*big.Int, error)
 	ChainID(ctx context.Context) (*big.Int, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
 	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
@@ -84,8 +85,6 @@ type Processor struct {
 	destEthClient ethClient
 	srcCaller     relayer.Caller
 
-	ecdsaKey *ecdsa.PrivateKey
-
 	srcSignalService relayer.SignalService
 
 	destBridge       relayer.Bridge
@@ -104,6 +103,10 @@ type Processor struct {
 	profitableOnly            bool
 	headerSyncIntervalSeconds int64
 
+	// failIfHeaderNotSynced makes the proof generation fail instead of waiting for the
+	// source chain header to be synced, for the API requests which can't be kept waiting.
+	failIfHeaderNotSynced bool
+
 	confTimeoutInSeconds int64
 
 	backOffRetryInterval time.Duration
@@ -145,31 +148,169 @@ func (p *Processor) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // nolint: funlen
 func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
+	destEthClient, err := initProver(ctx, p, cfg)
+	if err != nil {
+		return err
+	}
+
+	destERC20Vault, err := erc20vault.NewERC20Vault(
+		cfg.DestERC20VaultAddress,
+		destEthClient,
+	)
+	if err != nil {
+		return err
+	}
+
+	var destQuotaManager *quotamanager.QuotaManager
+
+	if cfg.DestQuotaManagerAddress.Hex() != relayer.ZeroAddress.Hex() {
+		destQuotaManager, err = quotamanager.NewQuotaManager(
+			cfg.DestQuotaManagerAddress,
+			destEthClient,
+		)
+		if err != nil {
+			return err
+		}
+
+		p.destQuotaManager = destQuotaManager
+	}
+
+	var destERC721Vault *erc721vault.ERC721Vault
+	if cfg.DestERC721VaultAddress.Hex() != relayer.ZeroAddress.Hex() {
+		destERC721Vault, err = erc721vault.NewERC721Vault(cfg.DestERC721VaultAddress, destEthClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	var destERC1155Vault *erc1155vault.ERC1155Vault
+	if cfg.DestERC1155VaultAddress.Hex() != relayer.ZeroAddress.Hex() {
+		destERC1155Vault, err = erc1155vault.NewERC1155Vault(
+			cfg.DestERC1155VaultAddress,
+			destEthClient,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	processorSigner, err := signer.New(ctx, cfg.ProcessorPrivateKey, cfg.RemoteSigner)
+	if err != nil {
+		return err
+	}
+
+	relayerAddr := processorSigner.Address()
+
+	var taikoL2 *taikol2.TaikoL2
+	if cfg.EnableTaikoL2 {
+		taikoL2, err = taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient)
+		if err != nil {
+			return err
+		}
+
+		p.taikoL2 = taikoL2
+	}
+
+	var q queue.Queue
+	if cfg.TargetTxHash == nil {
+		q, err = cfg.OpenQueueFunc()
+		if err != nil {
+			return err
+		}
+	}
+
+	// routes to the same destination chain share their transaction manager.
+	if destTxmgr, ok := cfg.txmgrs[p.destChainId.String()]; ok {
+		p.txmgr = destTxmgr
+	} else {
+		if p.txmgr, err = signer.NewTxManager(
+			"processor",
+			log.Root(),
+			new(txmgrMetrics.NoopTxMetrics),
+			*cfg.TxmgrConfigs,
+			processorSigner,
+		); err != nil {
+			return err
+		}
+
+		if cfg.txmgrs != nil {
+			cfg.txmgrs[p.destChainId.String()] = p.txmgr
+		}
+	}
+
+	p.destERC1155Vault = destERC1155Vault
+	p.destERC20Vault = destERC20Vault
+	p.destERC721Vault = destERC721Vault
+
+	p.relayerAddr = relayerAddr
+
+	p.profitableOnly = cfg.ProfitableOnly
+
+	p.queue = q
+
+	p.confTimeoutInSeconds = int64(cfg.ConfirmationsTimeout)
+	p.confirmations = cfg.Confirmations
+
+	p.msgCh = make(chan queue.Message)
+
+	p.backOffRetryInterval = time.Duration(cfg.BackoffRetryInterval) * time.Second
+	p.backOffMaxRetries = cfg.BackOffMaxRetrys
+
+	p.targetTxHash = cfg.TargetTxHash
+
+	p.maxMessageRetries = cfg.MaxMessageRetries
+
+	p.processingTxHashes = make(map[common.Hash]bool, 0)
+
+	p.minFeeToProcess = p.cfg.MinFeeToProcess
+
+	slog.Info("minFeeToProcess", "minFeeToProcess", p.minFeeToProcess)
+
+	return nil
+}
+
+// InitProverFromConfig inits the parts of a processor which are needed to generate the signal
+// proofs of the messages, it's also used by the API to serve the self-claim proofs, so that the
+// message owners can process their messages themselves.
+func InitProverFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
+	if _, err := initProver(ctx, p, cfg); err != nil {
+		return err
+	}
+
+	p.failIfHeaderNotSynced = true
+
+	return nil
+}
+
+// initProver inits the parts of a processor which are needed to generate the signal proofs,
+// and returns the destination chain client for the remaining contract bindings.
+// nolint: funlen
+func initProver(ctx context.Context, p *Processor, cfg *Config) (*ethclient.Client, error) {
 	p.cfg = cfg
 
 	db, err := cfg.OpenDBFunc()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	eventRepository, err := repo.NewEventRepository(db)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srcRpcClient, err := rpc.Dial(cfg.SrcRPCUrl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	hops := []hop{}
@@ -187,12 +328,12 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 		hopEthClient, err = ethclient.Dial(hopConfig.rpcURL)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		hopChainID, err = hopEthClient.ChainID(context.Background())
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		hopSignalService, err = signalservice.NewSignalService(
@@ -200,12 +341,12 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 			hopEthClient,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		hopRpcClient, err = rpc.Dial(hopConfig.rpcURL)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// only support one hop rn, add in array configs
@@ -225,104 +366,27 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		srcEthClient,
 	)
 	if err != nil {
-		return err
-	}
-
-	destERC20Vault, err := erc20vault.NewERC20Vault(
-		cfg.DestERC20VaultAddress,
-		destEthClient,
-	)
-	if err != nil {
-		return err
-	}
-
-	var destQuotaManager *quotamanager.QuotaManager
-
-	if cfg.DestQuotaManagerAddress.Hex() != relayer.ZeroAddress.Hex() {
-		destQuotaManager, err = quotamanager.NewQuotaManager(
-			cfg.DestQuotaManagerAddress,
-			destEthClient,
-		)
-		if err != nil {
-			return err
-		}
-
-		p.destQuotaManager = destQuotaManager
-	}
-
-	var destERC721Vault *erc721vault.ERC721Vault
-	if cfg.DestERC721VaultAddress.Hex() != relayer.ZeroAddress.Hex() {
-		destERC721Vault, err = erc721vault.NewERC721Vault(cfg.DestERC721VaultAddress, destEthClient)
-		if err != nil {
-			return err
-		}
-	}
-
-	var destERC1155Vault *erc1155vault.ERC1155Vault
-	if cfg.DestERC1155VaultAddress.Hex() != relayer.ZeroAddress.Hex() {
-		destERC1155Vault, err = erc1155vault.NewERC1155Vault(
-			cfg.DestERC1155VaultAddress,
-			destEthClient,
-		)
-		if err != nil {
-			return err
-		}
+		return nil, err
 	}
 
 	destBridge, err := bridge.NewBridge(cfg.DestBridgeAddress, destEthClient)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srcChainID, err := srcEthClient.ChainID(context.Background())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	destChainID, err := destEthClient.ChainID(context.Background())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	prover, err := proof.New(srcEthClient, p.cfg.CacheOption)
 	if err != nil {
-		return err
-	}
-
-	publicKey := cfg.ProcessorPrivateKey.Public()
-
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return errors.New("unable to convert public key")
-	}
-
-	relayerAddr := crypto.PubkeyToAddress(*publicKeyECDSA)
-
-	var taikoL2 *taikol2.TaikoL2
-	if cfg.EnableTaikoL2 {
-		taikoL2, err = taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient)
-		if err != nil {
-			return err
-		}
-
-		p.taikoL2 = taikoL2
-	}
-
-	var q queue.Queue
-	if cfg.TargetTxHash == nil {
-		q, err = cfg.OpenQueueFunc()
-		if err != nil {
-			return err
-		}
-	}
-
-	if p.txmgr, err = txmgr.NewSimpleTxManager(
-		"processor",
-		log.Root(),
-		new(txmgrMetrics.NoopTxMetrics),
-		*cfg.TxmgrConfigs,
-	); err != nil {
-		return err
+		return nil, err
 	}
 
 	p.hops = hops
@@ -335,44 +399,19 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 	p.srcSignalService = srcSignalService
 
 	p.destBridge = destBridge
-	p.destERC1155Vault = destERC1155Vault
-	p.destERC20Vault = destERC20Vault
-	p.destERC721Vault = destERC721Vault
-
-	p.ecdsaKey = cfg.ProcessorPrivateKey
-	p.relayerAddr = relayerAddr
-
-	p.profitableOnly = cfg.ProfitableOnly
-
-	p.queue = q
 
 	p.srcChainId = srcChainID
 	p.destChainId = destChainID
 
 	p.headerSyncIntervalSeconds = int64(cfg.HeaderSyncInterval)
-	p.confTimeoutInSeconds = int64(cfg.ConfirmationsTimeout)
-	p.confirmations = cfg.Confirmations
 
 	p.srcSignalServiceAddress = cfg.SrcSignalServiceAddress
 
-	p.msgCh = make(chan queue.Message)
 	p.srcCaller = srcRpcClient
 
-	p.backOffRetryInterval = time.Duration(cfg.BackoffRetryInterval) * time.Second
-	p.backOffMaxRetries = cfg.BackOffMaxRetrys
 	p.ethClientTimeout = time.Duration(cfg.ETHClientTimeout) * time.Second
 
-	p.targetTxHash = cfg.TargetTxHash
-
-	p.maxMessageRetries = cfg.MaxMessageRetries
-
-	p.processingTxHashes = make(map[common.Hash]bool, 0)
-
-	p.minFeeToProcess = p.cfg.MinFeeToProcess
-
-	slog.Info("minFeeToProcess", "minFeeToProcess", p.minFeeToProcess)
-
-	return nil
+	return destEthClient, nil
 }
 
 func (p *Processor) Name() string {
@@ -445,6 +484,11 @@ func (p *Processor) queueName() string {
 	return fmt.Sprintf("%v-%v-%v-queue", p.srcChainId.String(), p.destChainId.String(), relayer.EventNameMessageSent)
 }
 
+// routeLabels returns the metric label values of the route the processor serves.
+func (p *Processor) routeLabels() []string {
+	return []string{p.srcChainId.String(), p.destChainId.String()}
+}
+
 // eventLoop is the main event loop of a Processor which should read
 // messages from a queue and then process them.
 func (p *Processor) eventLoop(ctx context.Context) {
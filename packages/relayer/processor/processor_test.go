@@ -1,20 +1,25 @@
 package processor
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	mysqlQueue "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/mysql"
 )
 
-var dummyEcdsaKey = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
-
 func newTestProcessor(profitableOnly bool) *Processor {
-	privateKey, _ := crypto.HexToECDSA(dummyEcdsaKey)
-
 	prover, _ := proof.New(
 		&mock.Blocker{},
 		encoding.CACHE_NOTHING,
@@ -27,7 +32,6 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		destEthClient:             &mock.EthClient{},
 		destERC20Vault:            &mock.TokenVault{},
 		srcSignalService:          &mock.SignalService{},
-		ecdsaKey:                  privateKey,
 		prover:                    prover,
 		srcCaller:                 &mock.Caller{},
 		profitableOnly:            profitableOnly,
@@ -49,3 +53,177 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		processingTxHashes: make(map[common.Hash]bool, 0),
 	}
 }
+
+// testQueues are the queues the processor tests run against, the MySQL queue is backed
+// by a MySQL container.
+var testQueues = []struct {
+	name string
+	open func(t *testing.T) queue.Queue
+}{
+	{
+		"mock",
+		func(t *testing.T) queue.Queue {
+			return &mock.Queue{}
+		},
+	},
+	{
+		"mysql",
+		func(t *testing.T) queue.Queue {
+			db, close, err := testMysql(t)
+			assert.Nil(t, err)
+
+			t.Cleanup(close)
+
+			q, err := mysqlQueue.NewQueue(db, queue.NewQueueOpts{})
+			assert.Nil(t, err)
+
+			return q
+		},
+	},
+}
+
+// recordingQueue records the messages acknowledged and published by the processor.
+type recordingQueue struct {
+	queue.Queue
+	acked     chan error
+	published chan string
+}
+
+func (q *recordingQueue) Ack(ctx context.Context, msg queue.Message) error {
+	err := q.Queue.Ack(ctx, msg)
+	q.acked <- err
+
+	return err
+}
+
+func (q *recordingQueue) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	q.published <- queueName
+
+	return q.Queue.Publish(ctx, queueName, msg, headers, expiration)
+}
+
+func unprocessableMessageBody() *queue.QueueMessageSentBody {
+	return &queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			Message: bridge.IBridgeMessage{
+				GasLimit:   1,
+				SrcChainId: mock.MockChainID.Uint64(),
+				Id:         1,
+			},
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics: []common.Hash{
+					relayer.ZeroHash,
+				},
+				Data: []byte{0xff},
+			},
+		},
+		ID: 0,
+	}
+}
+
+func unprofitableMessageBody() *queue.QueueMessageSentBody {
+	return &queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			Message: bridge.IBridgeMessage{
+				Id:          1,
+				From:        common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+				DestChainId: mock.MockChainID.Uint64(),
+				SrcChainId:  mock.MockChainID.Uint64(),
+				SrcOwner:    common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+				DestOwner:   common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+				To:          common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+				Value:       big.NewInt(0),
+				GasLimit:    600000,
+				Fee:         1,
+				Data:        []byte{},
+			},
+			MsgHash: mock.SuccessMsgHash,
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics: []common.Hash{
+					relayer.ZeroHash,
+				},
+				Data: []byte{0xff},
+			},
+		},
+		ID: 0,
+	}
+}
+
+func TestIntegration_eventLoop(t *testing.T) {
+	for _, tq := range testQueues {
+		t.Run(tq.name, func(t *testing.T) {
+			p := newTestProcessor(true)
+			q := &recordingQueue{
+				Queue:     tq.open(t),
+				acked:     make(chan error, 1),
+				published: make(chan string, 1),
+			}
+			p.queue = q
+			p.msgCh = make(chan queue.Message)
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			t.Cleanup(func() {
+				cancel()
+				p.wg.Wait()
+			})
+
+			assert.Nil(t, q.Start(ctx, p.queueName()))
+
+			go func() {
+				_ = q.Subscribe(ctx, p.msgCh, &p.wg)
+			}()
+
+			go p.eventLoop(ctx)
+
+			tests := []struct {
+				name          string
+				body          *queue.QueueMessageSentBody
+				wantPublished string
+			}{
+				{
+					"messageUnprocessable",
+					unprocessableMessageBody(),
+					"",
+				},
+				{
+					"unprofitable",
+					unprofitableMessageBody(),
+					p.queueName() + "-unprofitable",
+				},
+			}
+
+			for _, tt := range tests {
+				marshalled, err := json.Marshal(tt.body)
+				assert.Nil(t, err)
+
+				// publish to the wrapped queue, so that only the processor publishes are recorded.
+				assert.Nil(t, q.Queue.Publish(ctx, p.queueName(), marshalled, nil, nil))
+
+				if tt.wantPublished != "" {
+					select {
+					case queueName := <-q.published:
+						assert.Equal(t, tt.wantPublished, queueName)
+					case <-time.After(30 * time.Second):
+						t.Fatalf("%v: message not published", tt.name)
+					}
+				}
+
+				select {
+				case err := <-q.acked:
+					assert.Nil(t, err)
+				case <-time.After(30 * time.Second):
+					t.Fatalf("%v: message not acknowledged", tt.name)
+				}
+			}
+		})
+	}
+}
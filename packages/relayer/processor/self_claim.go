@@ -0,0 +1,55 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// SelfClaim generates the proof of the given message, and returns the `processMessage`
+// transaction the message owner can send to the destination bridge to claim it themselves,
+// with its gas estimated for the owner as the sender.
+func (p *Processor) SelfClaim(ctx context.Context, event *bridge.BridgeMessageSent) (*relayer.SelfClaim, error) {
+	eventStatus, err := p.eventStatusFromMsgHash(ctx, event.MsgHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if eventStatus != relayer.EventStatusNew {
+		return nil, relayer.ErrMessageNotClaimable
+	}
+
+	encodedSignalProof, err := p.generateEncodedSignalProof(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encoding.BridgeABI.Pack("processMessage", event.Message, encodedSignalProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding.BridgeABI.Pack")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.ethClientTimeout)
+
+	defer cancel()
+
+	gas, err := p.destEthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: event.Message.DestOwner,
+		To:   &p.cfg.DestBridgeAddress,
+		Data: data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "p.destEthClient.EstimateGas")
+	}
+
+	return &relayer.SelfClaim{
+		To:           p.cfg.DestBridgeAddress,
+		Data:         data,
+		EstimatedGas: gas,
+	}, nil
+}
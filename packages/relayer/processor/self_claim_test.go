@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_SelfClaim_messageNotClaimable(t *testing.T) {
+	p := newTestProcessor(false)
+
+	_, err := p.SelfClaim(context.Background(), &bridge.BridgeMessageSent{
+		MsgHash: mock.FailSignal,
+		Message: bridge.IBridgeMessage{
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+		},
+	})
+
+	assert.Equal(t, relayer.ErrMessageNotClaimable, err)
+}
+
+func Test_SelfClaim(t *testing.T) {
+	p := newTestProcessor(false)
+	p.failIfHeaderNotSynced = true
+
+	claim, err := p.SelfClaim(context.Background(), &bridge.BridgeMessageSent{
+		MsgHash: mock.SuccessMsgHash,
+		Message: bridge.IBridgeMessage{
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+			Value:       big.NewInt(0),
+			Data:        []byte{},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, p.cfg.DestBridgeAddress, claim.To)
+	assert.NotEmpty(t, claim.Data)
+	assert.Equal(t, uint64(1), claim.EstimatedGas)
+}
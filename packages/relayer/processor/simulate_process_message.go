@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// simulateProcessMessage estimates the gas the `processMessage` transaction with the given
+// calldata, which includes the signal proof, actually uses on the destination chain, rather
+// than relying on the gas limit declared by the message. For L2 destination chains, it also
+// returns the fee of posting the transaction data to L1 in a blob. A reverting simulation is
+// reported as unprofitable, so that the message is retried later from the unprofitable queue.
+func (p *Processor) simulateProcessMessage(ctx context.Context, data []byte) (uint64, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ethClientTimeout)
+
+	defer cancel()
+
+	gasUsed, err := p.destEthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: p.relayerAddr,
+		To:   &p.cfg.DestBridgeAddress,
+		Data: data,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), vm.ErrExecutionReverted.Error()) {
+			return 0, 0, errors.Wrap(relayer.ErrUnprofitable, err.Error())
+		}
+
+		return 0, 0, errors.Wrap(err, "p.destEthClient.EstimateGas")
+	}
+
+	if p.taikoL2 == nil {
+		return gasUsed, 0, nil
+	}
+
+	// the L1 of the destination chain is the chain it syncs the headers of, which is
+	// the last hop, or the source chain if there are no hops.
+	l1EthClient := p.srcEthClient
+	if len(p.hops) > 0 {
+		l1EthClient = p.hops[len(p.hops)-1].ethClient
+	}
+
+	blobBaseFee, err := l1EthClient.BlobBaseFee(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "l1EthClient.BlobBaseFee")
+	}
+
+	blobGas, err := blobDataGas(data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return gasUsed, blobGas * blobBaseFee.Uint64(), nil
+}
+
+// blobDataGas returns the blob gas of the given transaction data when posted to L1, the L2
+// transaction lists are posted in blobs compressed with zlib, and each 32 bytes field element
+// of a blob holds 31 bytes of data.
+func blobDataGas(data []byte) (uint64, error) {
+	var b bytes.Buffer
+
+	w := zlib.NewWriter(&b)
+
+	if _, err := w.Write(data); err != nil {
+		return 0, errors.Wrap(err, "w.Write")
+	}
+
+	if err := w.Close(); err != nil {
+		return 0, errors.Wrap(err, "w.Close")
+	}
+
+	bytesPerBlob := uint64(params.BlobTxFieldElementsPerBlob * (params.BlobTxBytesPerFieldElement - 1))
+
+	return (uint64(b.Len())*params.BlobTxBlobGasPerBlob + bytesPerBlob - 1) / bytesPerBlob, nil
+}
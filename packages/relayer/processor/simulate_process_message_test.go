@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// blobBaseFeeEthClient is a mock eth client with the given blob base fee.
+type blobBaseFeeEthClient struct {
+	*mock.EthClient
+	blobBaseFee *big.Int
+}
+
+func (c *blobBaseFeeEthClient) BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	return c.blobBaseFee, nil
+}
+
+func Test_simulateProcessMessage(t *testing.T) {
+	p := newTestProcessor(true)
+
+	gasUsed, l1DataFee, err := p.simulateProcessMessage(context.Background(), []byte{0x0, 0x1})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), gasUsed)
+	assert.Equal(t, uint64(0), l1DataFee)
+}
+
+// revertingEthClient is a mock eth client whose gas estimations fail with the given error.
+type revertingEthClient struct {
+	*mock.EthClient
+	err error
+}
+
+func (c *revertingEthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return 0, c.err
+}
+
+func Test_simulateProcessMessage_reverted(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		wantUnprofitable bool
+	}{
+		{
+			"reverted",
+			errors.New("execution reverted: B_INVALID_STATUS"),
+			true,
+		},
+		{
+			"otherError",
+			errors.New("connection refused"),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProcessor(true)
+			p.destEthClient = &revertingEthClient{EthClient: &mock.EthClient{}, err: tt.err}
+
+			_, _, err := p.simulateProcessMessage(context.Background(), []byte{0x0, 0x1})
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.wantUnprofitable, errors.Is(err, relayer.ErrUnprofitable))
+		})
+	}
+}
+
+func Test_simulateProcessMessage_l1DataFee(t *testing.T) {
+	data := []byte{0x0, 0x1}
+
+	blobGas, err := blobDataGas(data)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name           string
+		srcBlobBaseFee *big.Int
+		hopBlobBaseFee *big.Int
+		wantL1DataFee  uint64
+	}{
+		{
+			"srcChain",
+			big.NewInt(10),
+			nil,
+			blobGas * 10,
+		},
+		{
+			"lastHop",
+			big.NewInt(10),
+			big.NewInt(20),
+			blobGas * 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProcessor(true)
+
+			// the L1 data fee is only charged when the destination chain is a L2.
+			taikoL2, err := taikol2.NewTaikoL2(common.Address{}, nil)
+			assert.Nil(t, err)
+
+			p.taikoL2 = taikoL2
+			p.srcEthClient = &blobBaseFeeEthClient{EthClient: &mock.EthClient{}, blobBaseFee: tt.srcBlobBaseFee}
+
+			if tt.hopBlobBaseFee != nil {
+				p.hops = []hop{{ethClient: &blobBaseFeeEthClient{EthClient: &mock.EthClient{}, blobBaseFee: tt.hopBlobBaseFee}}}
+			}
+
+			gasUsed, l1DataFee, err := p.simulateProcessMessage(context.Background(), data)
+			assert.Nil(t, err)
+			assert.Equal(t, uint64(1), gasUsed)
+			assert.Equal(t, tt.wantL1DataFee, l1DataFee)
+
+			// the L1 data fee is added to the simulated onchain fee, a fee covering only the
+			// simulated gas is not profitable once the L1 data fee is charged.
+			var (
+				destChainBaseFee uint64 = 1
+				gasTipCap        uint64 = 1
+				fee                     = (destChainBaseFee*2+gasTipCap)*gasUsed + tt.wantL1DataFee
+			)
+
+			profitable, err := p.isProfitable(
+				context.Background(), 0, fee, 1, gasUsed, l1DataFee, destChainBaseFee, gasTipCap,
+			)
+			assert.Nil(t, err)
+			assert.False(t, profitable)
+
+			profitable, err = p.isProfitable(
+				context.Background(), 0, fee+1, 1, gasUsed, l1DataFee, destChainBaseFee, gasTipCap,
+			)
+			assert.Nil(t, err)
+			assert.True(t, profitable)
+		})
+	}
+}
+
+func Test_blobDataGas(t *testing.T) {
+	// the zlib header and checksum of empty data take 8 bytes.
+	gas, err := blobDataGas([]byte{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(9), gas)
+
+	// the data is priced by its compressed size.
+	gas, err = blobDataGas(make([]byte, 10000))
+	assert.Nil(t, err)
+	assert.Less(t, gas, uint64(100))
+}
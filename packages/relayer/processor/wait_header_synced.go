@@ -47,6 +47,10 @@ func (p *Processor) waitHeaderSynced(
 		return event, nil
 	}
 
+	if p.failIfHeaderNotSynced {
+		return nil, relayer.ErrHeaderNotSynced
+	}
+
 	slog.Info("No ChainDataSynced event found, starting ticker loop")
 	ticker := time.NewTicker(time.Duration(p.headerSyncIntervalSeconds) * time.Second)
 	defer ticker.Stop()
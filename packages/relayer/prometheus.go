@@ -5,6 +5,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+// RouteLabels are the labels of the metrics which are collected per source => destination
+// chain route, so that the routes served by one process can be told apart.
+var RouteLabels = []string{"src_chain_id", "dest_chain_id"}
+
 var (
 	BlocksScanned = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "blocks_scanned_ops_total",
@@ -42,26 +46,26 @@ var (
 		Name: "queue_connection_instantiated_errors_ops_total",
 		Help: "The total number of times a queue connection was instantiated with an error",
 	})
-	ChainDataSyncedEventsIndexed = promauto.NewCounter(prometheus.CounterOpts{
+	ChainDataSyncedEventsIndexed = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "chain_data_synced_events_indexed_ops_total",
 		Help: "The total number of ChainDataSynced indexed events",
-	})
-	MessageSentEventsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageSentEventsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_sent_events_processed_ops_total",
 		Help: "The total number of MessageSent processed events",
-	})
-	MessageSentEventsProcessedReverted = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageSentEventsProcessedReverted = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_sent_events_processed_reverted_ops_total",
 		Help: "The total number of MessageSent processed events that reverted",
-	})
-	MessageSentEventsIndexed = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageSentEventsIndexed = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_sent_events_indexed_ops_total",
 		Help: "The total number of MessageSent indexed events",
-	})
-	MessageSentEventsIndexingErrors = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageSentEventsIndexingErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_sent_events_indexing_errors_ops_total",
 		Help: "The total number of errors indexing MessageSent events",
-	})
+	}, RouteLabels)
 	MessageSentEventsRetries = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "message_sent_events_retries_ops_total",
 		Help: "The total number of MessageSent events retries",
@@ -70,26 +74,26 @@ var (
 		Name: "message_sent_events_max_retries_reached_ops_total",
 		Help: "The total number of MessageSent events that reached max retries",
 	})
-	MessageProcessedEventsIndexingErrors = promauto.NewCounter(prometheus.CounterOpts{
+	MessageProcessedEventsIndexingErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_processed_events_indexing_errors_ops_total",
 		Help: "The total number of errors indexing MessageProcessed events",
-	})
-	MessageStatusChangedEventsIndexed = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageStatusChangedEventsIndexed = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_status_changed_events_indexed_ops_total",
 		Help: "The total number of MessageStatusChanged indexed events",
-	})
-	MessageStatusChangedEventsIndexingErrors = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageStatusChangedEventsIndexingErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_status_changed_events_indexing_errors_ops_total",
 		Help: "The total number of errors indexing MessageStatusChanged events",
-	})
-	ChainDataSyncedEventsIndexingErrors = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	ChainDataSyncedEventsIndexingErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "chain_data_synced_events_indexing_errors_ops_total",
 		Help: "The total number of errors indexing ChainDataSynced events",
-	})
-	UnprofitableMessagesDetected = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	UnprofitableMessagesDetected = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "unprofitable_messages_detected",
 		Help: "The total number of messages deemed unprofitable",
-	})
+	}, RouteLabels)
 	BlocksProcessed = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "blocks_processed_ops_total",
 		Help: "The total number of processed blocks",
@@ -106,48 +110,48 @@ var (
 		Name: "bridge_paused_errors_ops_total",
 		Help: "The total number of times the bridge has encountered an error while attempting to have been paused",
 	})
-	RetriableEvents = promauto.NewCounter(prometheus.CounterOpts{
+	RetriableEvents = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "events_processed_retriable_status_ops_total",
 		Help: "The total number of processed events that ended up in Retriable status",
-	})
-	DoneEvents = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	DoneEvents = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "events_processed_done_status_ops_total",
 		Help: "The total number of processed events that ended up in Done status",
-	})
-	ErrorEvents = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	ErrorEvents = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "events_processed_error_ops_total",
 		Help: "The total number of processed events that failed due to an error",
-	})
-	MessagesNotReceivedOnDestChain = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessagesNotReceivedOnDestChain = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "messages_not_received_on_dest_chain_opts_total",
 		Help: "The total number of messages that were not received on the destination chain",
-	})
-	ProfitableMessageAfterTransacting = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	ProfitableMessageAfterTransacting = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "profitable_message_after_transacting_ops_total",
 		Help: "The total number of processed events that ended up profitable",
-	})
-	UnprofitableMessageAfterTransacting = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	UnprofitableMessageAfterTransacting = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "unprofitable_message_after_transacting_ops_total",
 		Help: "The total number of processed events that ended up unprofitable",
-	})
-	MessageSentEventsAfterRetryErrorCount = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageSentEventsAfterRetryErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_sent_events_after_retry_error_count",
 		Help: "The total number of errors logged for MessageSent events after retries",
-	})
-	MessageStatusChangedEventsAfterRetryErrorCount = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageStatusChangedEventsAfterRetryErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_status_changed_events_after_retry_error_count",
 		Help: "The total number of errors logged for MessageStatusChanged events after retries",
-	})
-	ChainDataSyncedEventsAfterRetryErrorCount = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	ChainDataSyncedEventsAfterRetryErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "chain_data_synced_events_after_retry_error_count",
 		Help: "The total number of errors logged for ChainDataSynced events after retries",
-	})
-	MessageProcessedEventsAfterRetryErrorCount = promauto.NewCounter(prometheus.CounterOpts{
+	}, RouteLabels)
+	MessageProcessedEventsAfterRetryErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "message_processed_events_after_retry_error_count",
 		Help: "The total number of errors logged for MessageProcessed events after retries",
-	})
-	RelayerKeyBalanceGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	}, RouteLabels)
+	RelayerKeyBalanceGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "relayer_key_balance",
 		Help: "Current balance of the relayer key",
-	})
+	}, RouteLabels)
 )
@@ -0,0 +1,22 @@
+package relayer
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// SelfClaim is the `processMessage` transaction of a message on the destination bridge, which
+// the message owner can send to claim the message themselves.
+type SelfClaim struct {
+	To           common.Address
+	Data         []byte
+	EstimatedGas uint64
+}
+
+// SelfClaimer generates the self-claim transaction of a message sent on the source chain.
+type SelfClaimer interface {
+	SelfClaim(ctx context.Context, event *bridge.BridgeMessageSent) (*SelfClaim, error)
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -63,6 +62,16 @@ type Config struct {
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	if err := flags.CheckRequired(
+		c,
+		flags.SrcRPCUrl,
+		flags.DestRPCUrl,
+		flags.SrcBridgeAddress,
+		flags.DestBridgeAddress,
+	); err != nil {
+		return nil, err
+	}
+
 	watchdogPrivateKey, err := crypto.ToECDSA(
 		common.Hex2Bytes(c.String(flags.WatchdogPrivateKey.Name)),
 	)
@@ -70,7 +79,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid watchdogPrivateKey: %w", err)
 	}
 
-	return &Config{
+	cfg := &Config{
 		WatchdogPrivateKey:      watchdogPrivateKey,
 		DestBridgeAddress:       common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
 		SrcBridgeAddress:        common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
@@ -115,22 +124,6 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
-		},
 		SrcTxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.SrcRPCUrl.Name),
 			watchdogPrivateKey,
@@ -141,5 +134,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			watchdogPrivateKey,
 			c,
 		),
-	}, nil
+	}
+
+	// The MySQL queue is opened through OpenDBFunc, which may be replaced after the config is created.
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		opts := queue.NewQueueOpts{
+			Username:      c.String(flags.QueueUsername.Name),
+			Password:      c.String(flags.QueuePassword.Name),
+			Host:          c.String(flags.QueueHost.Name),
+			Port:          c.String(flags.QueuePort.Name),
+			PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+		}
+
+		return pkgFlags.OpenQueueFromCli(c, opts, cfg.OpenDBFunc)
+	}
+
+	return cfg, nil
 }
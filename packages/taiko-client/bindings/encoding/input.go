@@ -405,6 +405,16 @@ func EncodeBlockParamsOntake(params *BlockParamsV2) ([]byte, error) {
 	return b, nil
 }
 
+// DecodeBlockParamsOntake performs the solidity `abi.decode` for the given ontake blockParams bytes.
+func DecodeBlockParamsOntake(data []byte) (*BlockParamsV2, error) {
+	unpacked, err := blockParamsV2ComponentsArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.decode ontake block params, %w", err)
+	}
+
+	return abi.ConvertType(unpacked[0], new(BlockParamsV2)).(*BlockParamsV2), nil
+}
+
 // EncodeProveBlockInput performs the solidity `abi.encode` for the given TaikoL1.proveBlock input.
 func EncodeProveBlockInput(
 	meta metadata.TaikoBlockMetaData,
@@ -61,3 +61,24 @@ func TestUnpackTxListBytes(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, txListBytes, b)
 }
+
+func TestDecodeBlockParamsOntake(t *testing.T) {
+	params := &BlockParamsV2{
+		Coinbase:         common.BytesToAddress(randomBytes(20)),
+		AnchorBlockId:    1,
+		Timestamp:        2,
+		BlobTxListOffset: 3,
+		BlobTxListLength: 4,
+		BlobIndex:        5,
+	}
+
+	encoded, err := EncodeBlockParamsOntake(params)
+	require.Nil(t, err)
+
+	decoded, err := DecodeBlockParamsOntake(encoded)
+	require.Nil(t, err)
+	require.Equal(t, params, decoded)
+
+	_, err = DecodeBlockParamsOntake(randomBytes(10))
+	require.NotNil(t, err)
+}
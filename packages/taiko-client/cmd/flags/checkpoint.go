@@ -0,0 +1,30 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags used by the L2 checkpoint exporter.
+var (
+	CheckpointOutput = &cli.StringFlag{
+		Name:     "checkpoint.output",
+		Usage:    "Path of the exported L2 checkpoint file",
+		Required: true,
+		Category: driverCategory,
+		EnvVars:  []string{"CHECKPOINT_OUTPUT"},
+	}
+	CheckpointSignerPrivKey = &cli.StringFlag{
+		Name:     "checkpoint.signerPrivKey",
+		Usage:    "Private key of the L2 checkpoint signer",
+		Required: true,
+		Category: driverCategory,
+		EnvVars:  []string{"CHECKPOINT_SIGNER_PRIV_KEY"},
+	}
+)
+
+// ExportCheckpointFlags All L2 checkpoint exporter flags.
+var ExportCheckpointFlags = MergeFlags(CommonFlags, []cli.Flag{
+	L2WSEndpoint,
+	CheckpointOutput,
+	CheckpointSignerPrivKey,
+})
@@ -1,6 +1,9 @@
 package flags
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -16,6 +19,7 @@ var (
 	driverCategory   = "DRIVER"
 	proposerCategory = "PROPOSER"
 	proverCategory   = "PROVER"
+	verifierCategory = "VERIFIER"
 	txmgrCategory    = "TX_MANAGER"
 )
 
@@ -28,6 +32,19 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"L1_WS"},
 	}
+	L1QuorumEndpoints = &cli.StringSliceFlag{
+		Name:     "l1.quorumEndpoints",
+		Usage:    "Comma separated additional L1 RPC endpoints, L1 reads are only trusted when enough endpoints agree",
+		Category: commonCategory,
+		EnvVars:  []string{"L1_QUORUM_ENDPOINTS"},
+	}
+	L1QuorumThreshold = &cli.Uint64Flag{
+		Name:     "l1.quorumThreshold",
+		Usage:    "Number of L1 endpoints which must agree on a read, 0 means the majority of all L1 endpoints",
+		Value:    0,
+		Category: commonCategory,
+		EnvVars:  []string{"L1_QUORUM_THRESHOLD"},
+	}
 	L1PrivateEndpoint = &cli.StringFlag{
 		Name:     "l1.private",
 		Usage:    "RPC endpoint of a L1 node with private mempool",
@@ -162,6 +179,13 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"INBOX"},
 	}
+	TxListCodecForks = &cli.StringSliceFlag{
+		Name: "txList.codecForks",
+		Usage: "Comma separated transactions list compression codec forks in `<codec>@<height>` format, " +
+			"supported codecs: zlib, brotli, zstd, zlib is used until the first fork",
+		Category: commonCategory,
+		EnvVars:  []string{"TX_LIST_CODEC_FORKS"},
+	}
 )
 
 // CommonFlags All common flags.
@@ -181,6 +205,8 @@ var CommonFlags = []cli.Flag{
 	BackOffRetryInterval,
 	RPCTimeout,
 	L1PrivateEndpoint,
+	L1QuorumEndpoints,
+	L1QuorumThreshold,
 	InboxAddress,
 }
 
@@ -192,3 +218,56 @@ func MergeFlags(groups ...[]cli.Flag) []cli.Flag {
 	}
 	return merged
 }
+
+// OptionalFlags returns the given flags with copies of the required ones which are not required
+// by the command line parser, since a command's required flags are checked before dispatching
+// its subcommands. The command's action should check them through CheckRequiredFlags instead.
+func OptionalFlags(flags []cli.Flag) []cli.Flag {
+	optional := make([]cli.Flag, 0, len(flags))
+	for _, f := range flags {
+		if rf, ok := f.(cli.RequiredFlag); ok && rf.IsRequired() {
+			v := reflect.ValueOf(f)
+			if v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Struct {
+				if required := v.Elem().FieldByName("Required"); required.IsValid() && required.Kind() == reflect.Bool {
+					copied := reflect.New(v.Elem().Type())
+					copied.Elem().Set(v.Elem())
+					copied.Elem().FieldByName("Required").SetBool(false)
+					f = copied.Interface().(cli.Flag)
+				}
+			}
+		}
+		optional = append(optional, f)
+	}
+	return optional
+}
+
+// CheckRequiredFlags returns an error if any of the required flags in the given flags is not set.
+func CheckRequiredFlags(c *cli.Context, flags []cli.Flag) error {
+	var missing []string
+	for _, f := range flags {
+		rf, ok := f.(cli.RequiredFlag)
+		if !ok || !rf.IsRequired() {
+			continue
+		}
+
+		var isSet bool
+		for _, name := range f.Names() {
+			if c.IsSet(strings.TrimSpace(name)) {
+				isSet = true
+				break
+			}
+		}
+		if !isSet {
+			missing = append(missing, f.Names()[0])
+		}
+	}
+
+	switch len(missing) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("required flag %q not set", missing[0])
+	default:
+		return fmt.Errorf("required flags %q not set", strings.Join(missing, ", "))
+	}
+}
@@ -30,6 +30,20 @@ var (
 		Category: driverCategory,
 		EnvVars:  []string{"P2P_CHECK_POINT_SYNC_URL"},
 	}
+	// checkpoint
+	CheckpointImport = &cli.StringFlag{
+		Name: "checkpoint.import",
+		Usage: "Path of a signed L2 checkpoint file, driver will P2P sync to the checkpoint block, " +
+			"and then start deriving the following blocks from its L1 cursor",
+		Category: driverCategory,
+		EnvVars:  []string{"CHECKPOINT_IMPORT"},
+	}
+	CheckpointTrustedSigner = &cli.StringFlag{
+		Name:     "checkpoint.trustedSigner",
+		Usage:    "Address of the trusted signer of the imported L2 checkpoint",
+		Category: driverCategory,
+		EnvVars:  []string{"CHECKPOINT_TRUSTED_SIGNER"},
+	}
 	// syncer specific flag
 	MaxExponent = &cli.Uint64Flag{
 		Name: "syncer.maxExponent",
@@ -39,19 +53,74 @@ var (
 		Category: driverCategory,
 		EnvVars:  []string{"SYNCER_MAX_EXPONENT"},
 	}
-	// blob server endpoint
-	BlobServerEndpoint = &cli.StringFlag{
+	// blob sidecar sources
+	BlobServerEndpoint = &cli.StringSliceFlag{
 		Name:     "blob.server",
-		Usage:    "Blob sidecar storage server",
+		Usage:    "Comma separated blob sidecar storage servers",
 		Category: driverCategory,
 		EnvVars:  []string{"BLOB_SERVER"},
 	}
-	SocialScanEndpoint = &cli.StringFlag{
+	SocialScanEndpoint = &cli.StringSliceFlag{
 		Name:     "blob.socialScanEndpoint",
-		Usage:    "Social Scan's blob storage server",
+		Usage:    "Comma separated Social Scan's blob storage servers",
 		Category: driverCategory,
 		EnvVars:  []string{"BLOB_SOCIAL_SCAN_ENDPOINT"},
 	}
+	BlobBeaconEndpoints = &cli.StringSliceFlag{
+		Name:     "blob.beaconEndpoints",
+		Usage:    "Comma separated additional L1 beacon endpoints for fetching blob sidecars",
+		Category: driverCategory,
+		EnvVars:  []string{"BLOB_BEACON_ENDPOINTS"},
+	}
+	BlobCacheDir = &cli.StringFlag{
+		Name:     "blob.cacheDir",
+		Usage:    "Directory to cache the verified blobs, empty means disabled",
+		Category: driverCategory,
+		EnvVars:  []string{"BLOB_CACHE_DIR"},
+	}
+	BlobCacheMaxEntries = &cli.Uint64Flag{
+		Name:     "blob.cacheMaxEntries",
+		Usage:    "Maximum number of the cached blobs, the oldest blobs will be removed once exceeded",
+		Value:    4096,
+		Category: driverCategory,
+		EnvVars:  []string{"BLOB_CACHE_MAX_ENTRIES"},
+	}
+	// L1 reorg journal
+	ReorgJournalSize = &cli.Uint64Flag{
+		Name:     "reorgJournal.size",
+		Usage:    "Maximum number of the latest detected L1 reorgs kept in the reorg journal",
+		Value:    256,
+		Category: driverCategory,
+		EnvVars:  []string{"REORG_JOURNAL_SIZE"},
+	}
+	ReorgJournalAlertDepth = &cli.Uint64Flag{
+		Name:     "reorgJournal.alertDepth",
+		Usage:    "L1 reorg depth in blocks, from which the detected reorgs will be alerted, 0 means disabled",
+		Value:    0,
+		Category: driverCategory,
+		EnvVars:  []string{"REORG_JOURNAL_ALERT_DEPTH"},
+	}
+	ReorgJournalServerPort = &cli.Uint64Flag{
+		Name:     "reorgJournal.serverPort",
+		Usage:    "HTTP port of the reorg journal query server, 0 means disabled",
+		Value:    0,
+		Category: driverCategory,
+		EnvVars:  []string{"REORG_JOURNAL_SERVER_PORT"},
+	}
+	// preconfirmation block server
+	PreconfBlockServerPort = &cli.Uint64Flag{
+		Name:     "preconfirmation.serverPort",
+		Usage:    "HTTP port of the preconfirmation block server, 0 means disabled",
+		Value:    0,
+		Category: driverCategory,
+		EnvVars:  []string{"PRECONFIRMATION_SERVER_PORT"},
+	}
+	PreconfProposers = &cli.StringSliceFlag{
+		Name:     "preconfirmation.proposers",
+		Usage:    "Comma separated designated proposer addresses, whose signed preconfirmation blocks are accepted",
+		Category: driverCategory,
+		EnvVars:  []string{"PRECONFIRMATION_PROPOSERS"},
+	}
 )
 
 // DriverFlags All driver flags.
@@ -63,7 +132,18 @@ var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	P2PSync,
 	P2PSyncTimeout,
 	CheckPointSyncURL,
+	CheckpointImport,
+	CheckpointTrustedSigner,
 	MaxExponent,
 	BlobServerEndpoint,
 	SocialScanEndpoint,
+	BlobBeaconEndpoints,
+	BlobCacheDir,
+	BlobCacheMaxEntries,
+	TxListCodecForks,
+	ReorgJournalSize,
+	ReorgJournalAlertDepth,
+	ReorgJournalServerPort,
+	PreconfBlockServerPort,
+	PreconfProposers,
 })
@@ -0,0 +1,43 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// L1 recording related.
+var (
+	L1RecordingFile = &cli.StringFlag{
+		Name:     "l1Recording.file",
+		Usage:    "Path of the JSON file of recorded L1 data, which is written by the recorder and read by the replayer",
+		Required: true,
+		Category: driverCategory,
+		EnvVars:  []string{"L1_RECORDING_FILE"},
+	}
+	L1RecordingAddr = &cli.StringFlag{
+		Name: "l1Recording.addr",
+		Usage: "Listening address of the L1 recorder or replayer, which serves the L1 JSON-RPC methods " +
+			"over HTTP and WebSocket, the beacon API (recorder) or the recorded blobs under /blobs/ (replayer)",
+		Value:    "127.0.0.1:18545",
+		Category: driverCategory,
+		EnvVars:  []string{"L1_RECORDING_ADDR"},
+	}
+)
+
+// L1RecorderFlags All L1 recorder flags.
+var L1RecorderFlags = []cli.Flag{
+	L1WSEndpoint,
+	L1BeaconEndpoint,
+	L1RecordingFile,
+	L1RecordingAddr,
+	RPCTimeout,
+	Verbosity,
+	LogJSON,
+}
+
+// L1ReplayerFlags All L1 replayer flags.
+var L1ReplayerFlags = []cli.Flag{
+	L1RecordingFile,
+	L1RecordingAddr,
+	Verbosity,
+	LogJSON,
+}
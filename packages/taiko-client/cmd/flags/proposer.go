@@ -9,9 +9,9 @@ import (
 // Required flags used by proposer.
 var (
 	L1ProposerPrivKey = &cli.StringFlag{
-		Name:     "l1.proposerPrivKey",
-		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
-		Required: true,
+		Name: "l1.proposerPrivKey",
+		Usage: "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions, " +
+			"required unless a remote signer is set",
 		Category: proposerCategory,
 		EnvVars:  []string{"L1_PROPOSER_PRIV_KEY"},
 	}
@@ -91,6 +91,28 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"TX_POOL_LOCALS"},
 	}
+	// Multi-proposer lookahead related.
+	LookaheadProposers = &cli.StringSliceFlag{
+		Name: "lookahead.proposers",
+		Usage: "Comma separated proposer accounts which are assigned the L1 slots in turn, " +
+			"if set, proposer will only propose during its assigned slots, requires --l1.beacon",
+		Category: proposerCategory,
+		EnvVars:  []string{"LOOKAHEAD_PROPOSERS"},
+	}
+	LookaheadSlotsPerProposer = &cli.Uint64Flag{
+		Name:     "lookahead.slotsPerProposer",
+		Usage:    "Number of the consecutive L1 slots assigned to each proposer in the lookahead",
+		Value:    32,
+		Category: proposerCategory,
+		EnvVars:  []string{"LOOKAHEAD_SLOTS_PER_PROPOSER"},
+	}
+	LookaheadWhitelistAddress = &cli.StringFlag{
+		Name: "lookahead.whitelist",
+		Usage: "PreconfWhitelist contract `address`, if set, proposer will only propose during the L1 epochs " +
+			"assigned to it by the contract, requires --l1.beacon",
+		Category: proposerCategory,
+		EnvVars:  []string{"LOOKAHEAD_WHITELIST"},
+	}
 	TxPoolLocalsOnly = &cli.BoolFlag{
 		Name:     "txPool.localsOnly",
 		Usage:    "If set to true, proposer will only propose transactions of local accounts",
@@ -156,6 +178,30 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"OFF_CHAIN_COSTS"},
 	}
+	TxListCodec = &cli.StringFlag{
+		Name: "txList.codec",
+		Usage: "Compression codec of the proposed transactions lists (zlib, brotli, zstd), " +
+			"the codec scheduled by --txList.codecForks is used if not set",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_LIST_CODEC"},
+	}
+)
+
+// Proposer simulation related.
+var (
+	SimulationRecording = &cli.StringFlag{
+		Name:     "simulation.recording",
+		Usage:    "Path of the JSON file of recorded L2 transaction pool snapshots and L1 fees to replay",
+		Required: true,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROPOSER_SIMULATION_RECORDING"},
+	}
+	SimulationReport = &cli.StringFlag{
+		Name:     "simulation.report",
+		Usage:    "Path to write the JSON simulation report to, the report is written to stdout if not set",
+		Category: proposerCategory,
+		EnvVars:  []string{"PROPOSER_SIMULATION_REPORT"},
+	}
 )
 
 // ProposerFlags All proposer flags.
@@ -184,4 +230,37 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	GasNeededForProvingBlock,
 	PriceFluctuationModifier,
 	OffChainCosts,
+	TxListCodec,
+	TxListCodecForks,
+	L1BeaconEndpoint,
+	LookaheadProposers,
+	LookaheadSlotsPerProposer,
+	LookaheadWhitelistAddress,
 }, TxmgrFlags)
+
+// ProposerSimulationFlags All flags of the proposer simulate subcommand.
+var ProposerSimulationFlags = []cli.Flag{
+	SimulationRecording,
+	SimulationReport,
+	Verbosity,
+	LogJSON,
+	ProverSetAddress,
+	TxPoolLocals,
+	TxPoolLocalsOnly,
+	ExtraData,
+	MinGasUsed,
+	MinTxListBytes,
+	MinTip,
+	MinProposingInternal,
+	AllowZeroInterval,
+	MaxProposedTxListsPerEpoch,
+	BlobAllowed,
+	CheckProfitability,
+	AllowEmptyBlocks,
+	GasNeededForProvingBlock,
+	PriceFluctuationModifier,
+	OffChainCosts,
+	TxGasLimit,
+	TxListCodec,
+	TxListCodecForks,
+}
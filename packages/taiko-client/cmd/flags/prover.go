@@ -11,9 +11,9 @@ import (
 // Required flags used by prover.
 var (
 	L1ProverPrivKey = &cli.StringFlag{
-		Name:     "l1.proverPrivKey",
-		Usage:    "Private key of L1 prover, who will send TaikoL1.proveBlock transactions",
-		Required: true,
+		Name: "l1.proverPrivKey",
+		Usage: "Private key of L1 prover, who will send TaikoL1.proveBlock transactions, " +
+			"required unless a remote signer is set",
 		Category: proverCategory,
 		EnvVars:  []string{"L1_PROVER_PRIV_KEY"},
 	}
@@ -30,13 +30,13 @@ var (
 var (
 	RaikoHostEndpoint = &cli.StringFlag{
 		Name:     "raiko.host",
-		Usage:    "RPC endpoint of a Raiko host service",
+		Usage:    "RPC endpoint of a Raiko host service, multiple endpoints can be separated by commas",
 		Category: proverCategory,
 		EnvVars:  []string{"RAIKO_HOST"},
 	}
 	RaikoZKVMHostEndpoint = &cli.StringFlag{
 		Name:     "raiko.host.zkvm",
-		Usage:    "RPC endpoint of a Raiko ZKVM host service",
+		Usage:    "RPC endpoint of a Raiko ZKVM host service, multiple endpoints can be separated by commas",
 		Category: proverCategory,
 		EnvVars:  []string{"RAIKO_HOST_ZKVM"},
 	}
@@ -71,6 +71,13 @@ var (
 		Value:    10 * time.Minute,
 		EnvVars:  []string{"RAIKO_REQUEST_TIMEOUT"},
 	}
+	RaikoHealthCheckInterval = &cli.DurationFlag{
+		Name:     "raiko.healthCheckInterval",
+		Usage:    "Interval of the health checks of the Raiko hosts, 0 disables the health checks",
+		Category: proverCategory,
+		Value:    30 * time.Second,
+		EnvVars:  []string{"RAIKO_HEALTH_CHECK_INTERVAL"},
+	}
 	RaikoSP1Recursion = &cli.StringFlag{
 		Name:     "raiko.sp1Recursion",
 		Usage:    "SP1 recursion type",
@@ -164,6 +171,12 @@ var (
 		Value:    9876,
 		EnvVars:  []string{"PROVER_PORT"},
 	}
+	ProverAdminToken = &cli.StringFlag{
+		Name:     "prover.adminToken",
+		Usage:    "Bearer token for the admin routes of the http server, admin routes are disabled if not set",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_ADMIN_TOKEN"},
+	}
 	MaxExpiry = &cli.DurationFlag{
 		Name:     "http.maxExpiry",
 		Usage:    "Maximum accepted expiry in seconds for accepting proving a block",
@@ -247,6 +260,43 @@ var (
 		Category: proverCategory,
 		EnvVars:  []string{"PROVER_L2_NODE_VERSION"},
 	}
+	// Proof job store related.
+	JobStorePath = &cli.StringFlag{
+		Name:     "prover.jobStorePath",
+		Usage:    "Directory of the local proof job store, if set, in-flight proofs will be resumed after a restart",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_JOB_STORE_PATH"},
+	}
+	// Proof cost ledger related.
+	CostLedgerPath = &cli.StringFlag{
+		Name:     "prover.costLedgerPath",
+		Usage:    "Directory of the local proof cost ledger, if set, the costs of each submitted proof will be persisted",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_COST_LEDGER_PATH"},
+	}
+	// Batch proof submission related.
+	ProofBatchSize = &cli.Uint64Flag{
+		Name:     "prover.proofBatchSize",
+		Usage:    "Maximum number of proofs to submit in a single proveBlocks transaction, 0 or 1 disables batching",
+		Value:    0,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_PROOF_BATCH_SIZE"},
+	}
+	ProofBatchTimeout = &cli.DurationFlag{
+		Name:     "prover.proofBatchTimeout",
+		Usage:    "Maximum time a generated proof waits in the buffer before the batch is submitted",
+		Value:    5 * time.Minute,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_PROOF_BATCH_TIMEOUT"},
+	}
+	// Proof backends related.
+	ProofBackendsConfig = &cli.StringFlag{
+		Name: "prover.proofBackendsConfig",
+		Usage: "Path of the JSON file which configures the proof backends of each tier, " +
+			"the tiers which are not configured will use the built-in proof backends",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_PROOF_BACKENDS_CONFIG"},
+	}
 	// Confirmations specific flag
 	BlockConfirmations = &cli.Uint64Flag{
 		Name:     "prover.blockConfirmations",
@@ -276,6 +326,7 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ProveUnassignedBlocks,
 	ContesterMode,
 	ProverHTTPServerPort,
+	ProverAdminToken,
 	ProverCapacity,
 	MaxExpiry,
 	MaxProposedIn,
@@ -286,6 +337,7 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2NodeVersion,
 	BlockConfirmations,
 	RaikoRequestTimeout,
+	RaikoHealthCheckInterval,
 	RaikoZKVMHostEndpoint,
 	Risc0VerifierAddress,
 	Sp1VerifierAddress,
@@ -296,4 +348,9 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	RaikoRISC0Snark,
 	RaikoRISC0Profile,
 	RaikoRISC0ExecutionPo2,
+	JobStorePath,
+	CostLedgerPath,
+	ProofBatchSize,
+	ProofBatchTimeout,
+	ProofBackendsConfig,
 }, TxmgrFlags)
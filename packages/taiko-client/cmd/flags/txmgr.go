@@ -86,6 +86,19 @@ var (
 		Category: txmgrCategory,
 		EnvVars:  []string{"TX_GAS_LIMIT"},
 	}
+	RemoteSignerEndpoint = &cli.StringFlag{
+		Name: "remoteSigner.endpoint",
+		Usage: "HTTP endpoint of a Web3Signer compatible remote signer, " +
+			"which signs for the L1 account instead of the private key flag",
+		Category: txmgrCategory,
+		EnvVars:  []string{"REMOTE_SIGNER_ENDPOINT"},
+	}
+	RemoteSignerAddress = &cli.StringFlag{
+		Name:     "remoteSigner.address",
+		Usage:    "L1 account `address` managed by the remote signer",
+		Category: txmgrCategory,
+		EnvVars:  []string{"REMOTE_SIGNER_ADDRESS"},
+	}
 )
 
 var TxmgrFlags = []cli.Flag{
@@ -100,4 +113,6 @@ var TxmgrFlags = []cli.Flag{
 	TxNotInMempoolTimeout,
 	ReceiptQueryInterval,
 	TxGasLimit,
+	RemoteSignerEndpoint,
+	RemoteSignerAddress,
 }
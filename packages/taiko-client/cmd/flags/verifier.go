@@ -0,0 +1,42 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Required flags used by verifier.
+var (
+	VerifierReferenceL2Endpoint = &cli.StringFlag{
+		Name:     "verifier.referenceL2",
+		Usage:    "HTTP RPC endpoint of the L2 node to compare the re-derived blocks against",
+		Required: true,
+		Category: verifierCategory,
+		EnvVars:  []string{"VERIFIER_REFERENCE_L2"},
+	}
+)
+
+// Optional flags used by verifier.
+var (
+	VerifierAlertWebhook = &cli.StringFlag{
+		Name:     "verifier.alertWebhook",
+		Usage:    "HTTP endpoint which the divergence alerts are posted to as JSON, empty means only logging",
+		Category: verifierCategory,
+		EnvVars:  []string{"VERIFIER_ALERT_WEBHOOK"},
+	}
+)
+
+// VerifierFlags All verifier flags.
+var VerifierFlags = MergeFlags(CommonFlags, []cli.Flag{
+	L1BeaconEndpoint,
+	L2WSEndpoint,
+	L2AuthEndpoint,
+	JWTSecret,
+	BlobServerEndpoint,
+	SocialScanEndpoint,
+	BlobBeaconEndpoints,
+	BlobCacheDir,
+	BlobCacheMaxEntries,
+	TxListCodecForks,
+	VerifierReferenceL2Endpoint,
+	VerifierAlertWebhook,
+})
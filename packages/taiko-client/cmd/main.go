@@ -7,11 +7,15 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/logger"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/checkpoint"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/version"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/l1recording"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/verifier"
 )
 
 func main() {
@@ -35,11 +39,54 @@ func main() {
 			Action:      utils.SubcommandAction(new(driver.Driver)),
 		},
 		{
-			Name:        "proposer",
-			Flags:       flags.ProposerFlags,
+			Name:        "export-checkpoint",
+			Flags:       flags.ExportCheckpointFlags,
+			Usage:       "Exports a signed checkpoint of the last verified L2 block",
+			Description: "Taiko L2 checkpoint exporter, whose checkpoint lets a new driver skip the historical blocks",
+			Action: func(c *cli.Context) error {
+				logger.InitLogger(c)
+				return checkpoint.ExportFromCli(c.Context, c)
+			},
+		},
+		{
+			Name:        "record-l1",
+			Flags:       flags.L1RecorderFlags,
+			Usage:       "Records the L1 data served to a driver",
+			Description: "Taiko L1 recorder, which proxies the L1 nodes to a driver and records the served L1 data",
+			Action:      utils.SubcommandAction(new(l1recording.Recorder)),
+		},
+		{
+			Name:        "replay-l1",
+			Flags:       flags.L1ReplayerFlags,
+			Usage:       "Serves the recorded L1 data to a driver",
+			Description: "Taiko L1 replayer, which serves a L1 recording to re-derive the recorded L2 blocks offline",
+			Action:      utils.SubcommandAction(new(l1recording.Replayer)),
+		},
+		{
+			Name: "proposer",
+			// The required proposer flags are checked by the action, so that the simulate
+			// subcommand can run without them.
+			Flags:       flags.OptionalFlags(flags.ProposerFlags),
 			Usage:       "Starts the proposer software",
 			Description: "Taiko proposer software",
-			Action:      utils.SubcommandAction(new(proposer.Proposer)),
+			Action: func(c *cli.Context) error {
+				if err := flags.CheckRequiredFlags(c, flags.ProposerFlags); err != nil {
+					return err
+				}
+				return utils.SubcommandAction(new(proposer.Proposer))(c)
+			},
+			Subcommands: []*cli.Command{
+				{
+					Name:        "simulate",
+					Flags:       flags.ProposerSimulationFlags,
+					Usage:       "Replays a recorded L2 mempool through the proposer",
+					Description: "Taiko proposer simulation, which reports the blocks that would have been proposed",
+					Action: func(c *cli.Context) error {
+						logger.InitLogger(c)
+						return proposer.SimulateFromCli(c.Context, c)
+					},
+				},
+			},
 		},
 		{
 			Name:        "prover",
@@ -48,6 +95,13 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "verifier",
+			Flags:       flags.VerifierFlags,
+			Usage:       "Starts the chain derivation verifier software",
+			Description: "Taiko chain derivation verifier, which re-derives L2 blocks from L1 and alerts on divergence",
+			Action:      utils.SubcommandAction(new(verifier.Verifier)),
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
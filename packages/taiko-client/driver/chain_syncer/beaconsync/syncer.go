@@ -13,6 +13,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/checkpoint"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
@@ -24,7 +25,8 @@ type Syncer struct {
 	rpc             *rpc.Client
 	state           *state.State
 	syncMode        string
-	progressTracker *SyncProgressTracker // Sync progress tracker
+	progressTracker *SyncProgressTracker   // Sync progress tracker
+	checkpoint      *checkpoint.Checkpoint // Imported checkpoint, optional
 }
 
 // NewSyncer creates a new syncer instance.
@@ -34,8 +36,9 @@ func NewSyncer(
 	state *state.State,
 	syncMode string,
 	progressTracker *SyncProgressTracker,
+	checkpoint *checkpoint.Checkpoint,
 ) *Syncer {
-	return &Syncer{ctx, rpc, state, syncMode, progressTracker}
+	return &Syncer{ctx, rpc, state, syncMode, progressTracker, checkpoint}
 }
 
 // TriggerBeaconSync triggers the L2 execution engine to start performing a beacon sync, if the
@@ -76,20 +79,9 @@ func (s *Syncer) TriggerBeaconSync(blockID uint64) error {
 	if lastVerifiedBlockHash, err = s.rpc.GetLastVerifiedBlockHash(s.ctx); err != nil {
 		log.Debug("Failed to fetch the last verified block hash", "err", err)
 
-		stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: s.ctx})
-		if err != nil {
-			return fmt.Errorf("failed to fetch protocol state variables: %w", err)
-		}
-
-		lastVerifiedBlockHeader, err := s.rpc.L2CheckPoint.HeaderByNumber(
-			s.ctx,
-			new(big.Int).SetUint64(stateVars.B.LastVerifiedBlockId),
-		)
-		if err != nil {
-			return fmt.Errorf("failed to fetch the last verified block hash: %w", err)
+		if lastVerifiedBlockHash, err = s.getLastVerifiedBlockHashFallback(); err != nil {
+			return err
 		}
-
-		lastVerifiedBlockHash = lastVerifiedBlockHeader.Hash()
 	}
 
 	fcRes, err := s.rpc.L2Engine.ForkchoiceUpdate(s.ctx, &engine.ForkchoiceStateV1{
@@ -116,9 +108,38 @@ func (s *Syncer) TriggerBeaconSync(blockID uint64) error {
 	return nil
 }
 
+// getLastVerifiedBlockHashFallback fetches the last verified block hash from the L2 checkpoint node, or uses
+// the imported checkpoint's last verified block hash if it's set.
+func (s *Syncer) getLastVerifiedBlockHashFallback() (common.Hash, error) {
+	if s.checkpoint != nil {
+		return s.checkpoint.LastVerifiedBlockHash, nil
+	}
+
+	stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: s.ctx})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch protocol state variables: %w", err)
+	}
+
+	lastVerifiedBlockHeader, err := s.rpc.L2CheckPoint.HeaderByNumber(
+		s.ctx,
+		new(big.Int).SetUint64(stateVars.B.LastVerifiedBlockId),
+	)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch the last verified block hash: %w", err)
+	}
+
+	return lastVerifiedBlockHeader.Hash(), nil
+}
+
 // getBlockPayload fetches the block's header, and converts it to an Engine API executable data,
 // which will be used to let the node start beacon syncing.
 func (s *Syncer) getBlockPayload(ctx context.Context, blockID uint64) (*engine.ExecutableData, error) {
+	// The imported checkpoint has been verified against the protocol when the driver started.
+	if s.checkpoint != nil && s.checkpoint.L2Header.Number.Uint64() == blockID {
+		log.Info("Block header to sync loaded from checkpoint", "hash", s.checkpoint.L2Header.Hash())
+		return encoding.ToExecutableData(s.checkpoint.L2Header), nil
+	}
+
 	header, err := s.rpc.L2CheckPoint.HeaderByNumber(s.ctx, new(big.Int).SetUint64(blockID))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,260 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	consensus "github.com/ethereum/go-ethereum/consensus/taiko"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+)
+
+var (
+	errPreconfBlockBeforeOntake = errors.New("preconfirmation blocks are only supported after ontake fork")
+	difficultyArgs              = abi.Arguments{{Type: stringType}, {Type: uint64Type}}
+	stringType, _               = abi.NewType("string", "", nil)
+	uint64Type, _               = abi.NewType("uint64", "", nil)
+)
+
+// PreconfBlock is a preconfirmation (soft) block built by the designated proposer, which will be
+// inserted as the unsafe L2 head before the corresponding TaikoL1.proposeBlockV2 transaction
+// lands on L1.
+type PreconfBlock struct {
+	BlockID       *big.Int
+	ParentHash    common.Hash
+	Timestamp     uint64
+	Coinbase      common.Address
+	AnchorBlockID uint64
+	// Compressed transactions list, without the TaikoL2.anchorV2 transaction
+	TxList []byte
+}
+
+// InsertPreconfBlock inserts the given preconfirmation block as the new unsafe L2 head through
+// Engine APIs, all block fields which are decided by TaikoL1 when proposing are derived in the
+// same way as the protocol, so the preconfirmation block will be kept if the matching L1
+// proposal has the same parameters.
+func (s *Syncer) InsertPreconfBlock(ctx context.Context, block *PreconfBlock) (*types.Header, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.state.IsOnTake(block.BlockID) {
+		return nil, errPreconfBlockBeforeOntake
+	}
+
+	headL1Origin, err := s.rpc.L2.HeadL1Origin(ctx)
+	if err != nil && err.Error() != ethereum.NotFound.Error() {
+		return nil, fmt.Errorf("failed to fetch head L1 origin: %w", err)
+	}
+	if headL1Origin != nil && block.BlockID.Cmp(headL1Origin.BlockID) <= 0 {
+		return nil, fmt.Errorf("block %d has already been derived from L1", block.BlockID)
+	}
+
+	parent, err := s.rpc.L2.HeaderByHash(ctx, block.ParentHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parent block %s: %w", block.ParentHash, err)
+	}
+	if new(big.Int).Add(parent.Number, common.Big1).Cmp(block.BlockID) != 0 {
+		return nil, fmt.Errorf("block %d is not the child of parent block %d", block.BlockID, parent.Number)
+	}
+	if block.Timestamp < parent.Time || block.Timestamp > uint64(time.Now().Unix()) {
+		return nil, fmt.Errorf("invalid block timestamp: %d, parent timestamp: %d", block.Timestamp, parent.Time)
+	}
+
+	protocolConfigs := encoding.GetProtocolConfig(s.rpc.L2.ChainID.Uint64())
+
+	if err := checkPreconfAnchorBlockID(
+		block.AnchorBlockID,
+		s.state.GetL1Head().Number.Uint64(),
+		protocolConfigs.MaxAnchorHeightOffset,
+	); err != nil {
+		return nil, err
+	}
+
+	anchorBlockHeader, err := s.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(block.AnchorBlockID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch anchor block %d: %w", block.AnchorBlockID, err)
+	}
+
+	var txList []*types.Transaction
+	if txListBytes := s.txListDecompressor.TryDecompress(block.BlockID, block.TxList, true); len(txListBytes) != 0 {
+		if err := rlp.DecodeBytes(txListBytes, &txList); err != nil {
+			return nil, fmt.Errorf("invalid transactions list: %w", err)
+		}
+	}
+
+	baseFeeConfig := &protocolConfigs.BaseFeeConfig
+	baseFee, err := s.rpc.CalculateBaseFee(
+		ctx,
+		parent,
+		new(big.Int).SetUint64(block.AnchorBlockID),
+		true,
+		baseFeeConfig,
+		block.Timestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx, err := s.anchorConstructor.AssembleAnchorV2Tx(
+		ctx,
+		new(big.Int).SetUint64(block.AnchorBlockID),
+		anchorBlockHeader.Root,
+		parent.GasUsed,
+		baseFeeConfig,
+		block.BlockID,
+		baseFee,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TaikoL2.anchorV2 transaction: %w", err)
+	}
+
+	txListBytes, err := rlp.EncodeToBytes(append([]*types.Transaction{anchorTx}, txList...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transactions list: %w", err)
+	}
+
+	difficulty, err := preconfBlockDifficulty(block.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	// TaikoL1 encodes the base fee sharing percentage into the extra data after ontake fork.
+	extraData := common.BigToHash(new(big.Int).SetUint64(uint64(baseFeeConfig.SharingPctg)))
+
+	payload, err := s.executePayload(
+		ctx,
+		block.BlockID,
+		&engine.ForkchoiceStateV1{HeadBlockHash: parent.Hash()},
+		&engine.PayloadAttributes{
+			Timestamp:             block.Timestamp,
+			Random:                difficulty,
+			SuggestedFeeRecipient: block.Coinbase,
+			Withdrawals:           make(types.Withdrawals, 0),
+			BlockMetadata: &engine.BlockMetadata{
+				Beneficiary: block.Coinbase,
+				GasLimit:    uint64(protocolConfigs.BlockMaxGasLimit) + consensus.AnchorGasLimit,
+				Timestamp:   block.Timestamp,
+				TxList:      txListBytes,
+				MixHash:     difficulty,
+				ExtraData:   extraData[:],
+			},
+			BaseFeePerGas: baseFee,
+			// An L1 origin without L1 block height marks a preconfirmation block.
+			L1Origin: &rawdb.L1Origin{BlockID: block.BlockID},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create execution payloads: %w", err)
+	}
+
+	if err := s.updateL2Head(ctx, payload.BlockHash); err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"⏳ New preconfirmation L2 block inserted",
+		"blockID", block.BlockID,
+		"hash", payload.BlockHash,
+		"transactions", len(payload.Transactions),
+		"baseFee", utils.WeiToGWei(payload.BaseFeePerGas),
+		"anchorBlockID", block.AnchorBlockID,
+	)
+	metrics.DriverPreconfInsertedCounter.Inc()
+
+	return s.rpc.L2.HeaderByHash(ctx, payload.BlockHash)
+}
+
+// reconcilePreconfBlocks decides the L2 head after inserting the given block derived from L1. If
+// the current L2 head is a preconfirmation block, and the derived block is the same as the
+// preconfirmation block at its height, the preconfirmation blocks are kept and the current head
+// is returned, otherwise the preconfirmation blocks are reorged out by the derived block.
+func (s *Syncer) reconcilePreconfBlocks(ctx context.Context, payload *engine.ExecutableData) (common.Hash, error) {
+	head, err := s.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if head.Number.Uint64() < payload.Number {
+		return payload.BlockHash, nil
+	}
+
+	headL1Origin, err := s.rpc.L2.L1OriginByID(ctx, head.Number)
+	if err != nil {
+		if err.Error() == ethereum.NotFound.Error() {
+			return payload.BlockHash, nil
+		}
+		return common.Hash{}, err
+	}
+	if !headL1Origin.IsPreconfBlock() {
+		return payload.BlockHash, nil
+	}
+
+	preconfHeader, err := s.rpc.L2.HeaderByNumber(ctx, new(big.Int).SetUint64(payload.Number))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if preconfHeader.Hash() == payload.BlockHash {
+		log.Info(
+			"Preconfirmation block confirmed by L1 proposal",
+			"blockID", payload.Number,
+			"hash", payload.BlockHash,
+			"preconfHead", head.Number,
+		)
+		metrics.DriverPreconfConfirmedCounter.Inc()
+		return head.Hash(), nil
+	}
+
+	log.Warn(
+		"Preconfirmation blocks reorged by L1 proposal",
+		"blockID", payload.Number,
+		"preconfHash", preconfHeader.Hash(),
+		"derivedHash", payload.BlockHash,
+		"preconfHead", head.Number,
+	)
+	metrics.DriverPreconfReorgedCounter.Add(float64(head.Number.Uint64() - payload.Number + 1))
+
+	return payload.BlockHash, nil
+}
+
+// checkPreconfAnchorBlockID checks the anchor block of a preconfirmation block against the given L1
+// head, the matching L1 proposal lands after the L1 head, and TaikoL1 requires its anchor block to
+// be within the max anchor height offset before the proposing L1 block.
+func checkPreconfAnchorBlockID(anchorBlockID uint64, l1Head uint64, maxAnchorHeightOffset uint64) error {
+	if anchorBlockID > l1Head {
+		return fmt.Errorf("anchor block %d is ahead of L1 head %d", anchorBlockID, l1Head)
+	}
+	if anchorBlockID+maxAnchorHeightOffset <= l1Head {
+		return fmt.Errorf(
+			"anchor block %d is older than the max anchor height offset %d of L1 head %d",
+			anchorBlockID,
+			maxAnchorHeightOffset,
+			l1Head,
+		)
+	}
+
+	return nil
+}
+
+// preconfBlockDifficulty returns the difficulty of the given block, which is calculated in the
+// same way as TaikoL1 after ontake fork: keccak256(abi.encode("TAIKO_DIFFICULTY", blockID)).
+func preconfBlockDifficulty(blockID *big.Int) (common.Hash, error) {
+	encoded, err := difficultyArgs.Pack("TAIKO_DIFFICULTY", blockID.Uint64())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
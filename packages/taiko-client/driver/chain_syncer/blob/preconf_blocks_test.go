@@ -0,0 +1,124 @@
+package blob
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
+)
+
+func TestPreconfBlockDifficulty(t *testing.T) {
+	// abi.encode("TAIKO_DIFFICULTY", 1024)
+	var encoded []byte
+	encoded = append(encoded, common.LeftPadBytes([]byte{0x40}, 32)...)
+	encoded = append(encoded, common.LeftPadBytes(big.NewInt(1024).Bytes(), 32)...)
+	encoded = append(encoded, common.LeftPadBytes([]byte{16}, 32)...)
+	encoded = append(encoded, common.RightPadBytes([]byte("TAIKO_DIFFICULTY"), 32)...)
+
+	difficulty, err := preconfBlockDifficulty(big.NewInt(1024))
+	require.Nil(t, err)
+	require.Equal(t, crypto.Keccak256Hash(encoded), difficulty)
+}
+
+func TestCheckPreconfAnchorBlockID(t *testing.T) {
+	require.Nil(t, checkPreconfAnchorBlockID(100, 100, 64))
+	require.Nil(t, checkPreconfAnchorBlockID(37, 100, 64))
+	require.ErrorContains(t, checkPreconfAnchorBlockID(101, 100, 64), "ahead of L1 head")
+	require.ErrorContains(t, checkPreconfAnchorBlockID(36, 100, 64), "older than the max anchor height offset")
+}
+
+func (s *BlobSyncerTestSuite) TestInsertPreconfBlock() {
+	s.Nil(s.s.ProcessL1Blocks(context.Background()))
+
+	block := s.newPreconfBlock()
+	header, err := s.s.InsertPreconfBlock(context.Background(), block)
+	s.Nil(err)
+	s.Equal(block.BlockID, header.Number)
+	s.Equal(block.ParentHash, header.ParentHash)
+	s.Equal(block.Coinbase, header.Coinbase)
+
+	head, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	s.Equal(header.Hash(), head.Hash())
+
+	l1Origin, err := s.RPCClient.L2.L1OriginByID(context.Background(), block.BlockID)
+	s.Nil(err)
+	s.True(l1Origin.IsPreconfBlock())
+}
+
+func (s *BlobSyncerTestSuite) TestInsertPreconfBlockInvalid() {
+	s.Nil(s.s.ProcessL1Blocks(context.Background()))
+
+	for _, tt := range []struct {
+		name        string
+		modify      func(block *PreconfBlock)
+		expectedErr string
+	}{
+		{
+			"unknown parent",
+			func(block *PreconfBlock) { block.ParentHash = testutils.RandomHash() },
+			"failed to fetch parent block",
+		},
+		{
+			"not the child of parent",
+			func(block *PreconfBlock) { block.BlockID = new(big.Int).Add(block.BlockID, common.Big1) },
+			"is not the child of parent block",
+		},
+		{
+			"anchor block ahead of L1 head",
+			func(block *PreconfBlock) { block.AnchorBlockID++ },
+			"ahead of L1 head",
+		},
+	} {
+		s.Run(tt.name, func() {
+			block := s.newPreconfBlock()
+			tt.modify(block)
+
+			_, err := s.s.InsertPreconfBlock(context.Background(), block)
+			s.ErrorContains(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *BlobSyncerTestSuite) TestReconcilePreconfBlocksReorged() {
+	s.Nil(s.s.ProcessL1Blocks(context.Background()))
+
+	preconfHeader, err := s.s.InsertPreconfBlock(context.Background(), s.newPreconfBlock())
+	s.Nil(err)
+
+	// The L1 proposal at the same height has different transactions, so the derived block
+	// replaces the preconfirmation block.
+	meta := s.ProposeAndInsertValidBlock(s.p, s.s)
+	s.Equal(preconfHeader.Number, meta.GetBlockID())
+
+	head, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	s.Equal(preconfHeader.Number, head.Number)
+	s.NotEqual(preconfHeader.Hash(), head.Hash())
+
+	l1Origin, err := s.RPCClient.L2.L1OriginByID(context.Background(), head.Number)
+	s.Nil(err)
+	s.False(l1Origin.IsPreconfBlock())
+	s.Equal(head.Hash(), l1Origin.L2BlockHash)
+}
+
+// newPreconfBlock creates a new preconfirmation block on top of the current L2 head, which is
+// anchored to the current L1 head.
+func (s *BlobSyncerTestSuite) newPreconfBlock() *PreconfBlock {
+	parent, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	return &PreconfBlock{
+		BlockID:       new(big.Int).Add(parent.Number, common.Big1),
+		ParentHash:    parent.Hash(),
+		Timestamp:     max(parent.Time, uint64(time.Now().Unix())),
+		Coinbase:      common.BytesToAddress(testutils.RandomBytes(20)),
+		AnchorBlockID: s.s.state.GetL1Head().Number.Uint64(),
+	}
+}
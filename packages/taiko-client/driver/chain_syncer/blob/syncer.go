@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"net/url"
+	"sync"
 	"time"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
@@ -24,9 +24,11 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
+	reorgJournal "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/reorg_journal"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 
 	anchorTxConstructor "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/anchor_tx_constructor"
@@ -49,6 +51,9 @@ type Syncer struct {
 	reorgDetectedFlag   bool
 	maxRetrieveExponent uint64
 	blobDatasource      *rpc.BlobDataSource
+	reorgJournal        *reorgJournal.Journal // Optional
+	// Guards the L2 head updates of both the L1 derived blocks and the preconfirmation blocks
+	mutex sync.Mutex
 }
 
 // NewSyncer creates a new syncer instance.
@@ -58,14 +63,26 @@ func NewSyncer(
 	state *state.State,
 	progressTracker *beaconsync.SyncProgressTracker,
 	maxRetrieveExponent uint64,
-	blobServerEndpoint *url.URL,
-	socialScanEndpoint *url.URL,
+	blobSources *rpc.BlobDataSourceConfig,
+	txListCodecForks []*config.TxListCodecFork,
+	journal *reorgJournal.Journal,
 ) (*Syncer, error) {
 	constructor, err := anchorTxConstructor.New(client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize anchor constructor: %w", err)
 	}
 
+	blobDataSource, err := rpc.NewBlobDataSource(ctx, client, blobSources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize blob data source: %w", err)
+	}
+
+	protocolConfigs := encoding.GetProtocolConfig(client.L2.ChainID.Uint64())
+	chainConfig, err := config.NewChainConfig(protocolConfigs, txListCodecForks...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Syncer{
 		ctx:               ctx,
 		rpc:               client,
@@ -73,17 +90,14 @@ func NewSyncer(
 		progressTracker:   progressTracker,
 		anchorConstructor: constructor,
 		txListDecompressor: txListDecompressor.NewTxListDecompressor(
-			uint64(encoding.GetProtocolConfig(client.L2.ChainID.Uint64()).BlockMaxGasLimit),
+			uint64(protocolConfigs.BlockMaxGasLimit),
 			rpc.BlockMaxTxListBytes,
 			client.L2.ChainID,
+			chainConfig,
 		),
 		maxRetrieveExponent: maxRetrieveExponent,
-		blobDatasource: rpc.NewBlobDataSource(
-			ctx,
-			client,
-			blobServerEndpoint,
-			socialScanEndpoint,
-		),
+		blobDatasource:      blobDataSource,
+		reorgJournal:        journal,
 	}, nil
 }
 
@@ -127,6 +141,22 @@ func (s *Syncer) processL1Blocks(ctx context.Context) error {
 			"l1Head", l1End.Number,
 		)
 
+		// The L2 head is rolled back to the last block proposed at the new L1Current cursor.
+		lastInsertedBlockID := s.lastInsertedBlockID
+		if lastInsertedBlockID == nil {
+			lastInsertedBlockID = s.state.GetL2Head().Number
+		}
+		stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx, BlockNumber: newL1Current.Number})
+		if err != nil {
+			return fmt.Errorf("failed to fetch protocol state variables at L1 block %d: %w", newL1Current.Number, err)
+		}
+
+		s.reorgJournal.Record(
+			startL1Current,
+			newL1Current,
+			lastInsertedBlockID,
+			new(big.Int).SetUint64(stateVars.B.NumBlocks-1),
+		)
 		s.state.SetL1Current(newL1Current)
 		s.lastInsertedBlockID = nil
 	}
@@ -151,6 +181,7 @@ func (s *Syncer) processL1Blocks(ctx context.Context) error {
 	if !s.reorgDetectedFlag {
 		s.state.SetL1Current(l1End)
 		metrics.DriverL1CurrentHeightGauge.Set(float64(s.state.GetL1Current().Number.Uint64()))
+		s.reorgJournal.MarkRecovered(l1End)
 	}
 
 	return nil
@@ -186,6 +217,16 @@ func (s *Syncer) onBlockProposed(
 				"lastInsertedBlockIDOld", s.lastInsertedBlockID,
 				"lastInsertedBlockIDNew", reorgCheckResult.LastHandledBlockIDToReset,
 			)
+			lastInsertedBlockID := s.lastInsertedBlockID
+			if lastInsertedBlockID == nil {
+				lastInsertedBlockID = s.state.GetL2Head().Number
+			}
+			s.reorgJournal.Record(
+				s.state.GetL1Current(),
+				reorgCheckResult.L1CurrentToReset,
+				lastInsertedBlockID,
+				reorgCheckResult.LastHandledBlockIDToReset,
+			)
 			s.state.SetL1Current(reorgCheckResult.L1CurrentToReset)
 			s.lastInsertedBlockID = reorgCheckResult.LastHandledBlockIDToReset
 			s.reorgDetectedFlag = true
@@ -316,6 +357,9 @@ func (s *Syncer) insertNewHead(
 	txListBytes []byte,
 	l1Origin *rawdb.L1Origin,
 ) (*engine.ExecutableData, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	log.Debug(
 		"Try to insert a new L2 head block",
 		"parentNumber", parent.Number,
@@ -409,13 +453,33 @@ func (s *Syncer) insertNewHead(
 		return nil, fmt.Errorf("failed to create execution payloads: %w", err)
 	}
 
-	var lastVerifiedBlockHash common.Hash
+	// Keep the preconfirmation blocks on top of the inserted block as the L2 head, if the
+	// inserted block is the same as the preconfirmation block at its height.
+	headHash, err := s.reconcilePreconfBlocks(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile preconfirmation blocks: %w", err)
+	}
+
+	if err := s.updateL2Head(ctx, headHash); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// updateL2Head updates the L2 execution engine's fork choice with the given head block, and the
+// protocol's last verified block as the safe and finalized block.
+func (s *Syncer) updateL2Head(ctx context.Context, headHash common.Hash) error {
+	var (
+		lastVerifiedBlockHash common.Hash
+		err                   error
+	)
 	if lastVerifiedBlockHash, err = s.rpc.GetLastVerifiedBlockHash(ctx); err != nil {
 		log.Debug("Failed to fetch last verified block hash", "error", err)
 
 		stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch protocol state variables: %w", err)
+			return fmt.Errorf("failed to fetch protocol state variables: %w", err)
 		}
 
 		lastVerifiedBlockHeader, err := s.rpc.L2.HeaderByNumber(
@@ -423,14 +487,14 @@ func (s *Syncer) insertNewHead(
 			new(big.Int).SetUint64(stateVars.B.LastVerifiedBlockId),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch last verified block: %w", err)
+			return fmt.Errorf("failed to fetch last verified block: %w", err)
 		}
 
 		lastVerifiedBlockHash = lastVerifiedBlockHeader.Hash()
 	}
 
 	fc := &engine.ForkchoiceStateV1{
-		HeadBlockHash:      payload.BlockHash,
+		HeadBlockHash:      headHash,
 		SafeBlockHash:      lastVerifiedBlockHash,
 		FinalizedBlockHash: lastVerifiedBlockHash,
 	}
@@ -438,13 +502,13 @@ func (s *Syncer) insertNewHead(
 	// Update the fork choice
 	fcRes, err := s.rpc.L2Engine.ForkchoiceUpdate(ctx, fc, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if fcRes.PayloadStatus.Status != engine.VALID {
-		return nil, fmt.Errorf("unexpected ForkchoiceUpdate response status: %s", fcRes.PayloadStatus.Status)
+		return fmt.Errorf("unexpected ForkchoiceUpdate response status: %s", fcRes.PayloadStatus.Status)
 	}
 
-	return payload, nil
+	return nil
 }
 
 // createExecutionPayloads creates a new execution payloads through
@@ -492,6 +556,17 @@ func (s *Syncer) createExecutionPayloads(
 		"l1OriginHash", attributes.L1Origin.L1BlockHash,
 	)
 
+	return s.executePayload(ctx, meta.GetBlockID(), fc, attributes)
+}
+
+// executePayload prepares, fetches and executes a new execution payload with the given
+// payload attributes through Engine APIs.
+func (s *Syncer) executePayload(
+	ctx context.Context,
+	blockID *big.Int,
+	fc *engine.ForkchoiceStateV1,
+	attributes *engine.PayloadAttributes,
+) (*engine.ExecutableData, error) {
 	// Step 1, prepare a payload
 	fcRes, err := s.rpc.L2Engine.ForkchoiceUpdate(ctx, fc, attributes)
 	if err != nil {
@@ -512,7 +587,7 @@ func (s *Syncer) createExecutionPayloads(
 
 	log.Debug(
 		"Payload",
-		"blockID", meta.GetBlockID(),
+		"blockID", blockID,
 		"baseFee", utils.WeiToGWei(payload.BaseFeePerGas),
 		"number", payload.Number,
 		"hash", payload.BlockHash,
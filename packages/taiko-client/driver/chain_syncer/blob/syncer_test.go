@@ -47,6 +47,7 @@ func (s *BlobSyncerTestSuite) SetupTest() {
 		0,
 		nil,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
@@ -163,7 +164,8 @@ func (s *BlobSyncerTestSuite) TestTreasuryIncome() {
 	s.True(balanceAfter.Cmp(balance) > 0)
 
 	var hasNoneAnchorTxs bool
-	chainConfig := config.NewChainConfig(encoding.GetProtocolConfig(s.RPCClient.L2.ChainID.Uint64()))
+	chainConfig, err := config.NewChainConfig(encoding.GetProtocolConfig(s.RPCClient.L2.ChainID.Uint64()))
+	s.Nil(err)
 	for i := headBefore + 1; i <= headAfter; i++ {
 		block, err := s.RPCClient.L2.BlockByNumber(context.Background(), new(big.Int).SetUint64(i))
 		s.Nil(err)
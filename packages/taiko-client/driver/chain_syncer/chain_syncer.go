@@ -3,7 +3,6 @@ package chainsyncer
 import (
 	"context"
 	"fmt"
-	"net/url"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -12,7 +11,10 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/checkpoint"
+	reorgJournal "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/reorg_journal"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -36,6 +38,10 @@ type L2ChainSyncer struct {
 	// If this flag is activated, will try P2P beacon sync if current node is behind of the protocol's
 	// the latest verified block head
 	p2pSync bool
+
+	// If an imported checkpoint is set, will beacon sync to the checkpoint block, and then start
+	// inserting the following blocks from the checkpoint's L1 cursor
+	checkpoint *checkpoint.Checkpoint
 }
 
 // New creates a new chain syncer instance.
@@ -45,9 +51,11 @@ func New(
 	state *state.State,
 	p2pSync bool,
 	p2pSyncTimeout time.Duration,
+	l2Checkpoint *checkpoint.Checkpoint,
 	maxRetrieveExponent uint64,
-	blobServerEndpoint *url.URL,
-	socialScanEndpoint *url.URL,
+	blobSources *rpc.BlobDataSourceConfig,
+	txListCodecForks []*config.TxListCodecFork,
+	journal *reorgJournal.Journal,
 ) (*L2ChainSyncer, error) {
 	tracker := beaconsync.NewSyncProgressTracker(rpc.L2, p2pSyncTimeout)
 	go tracker.Track(ctx)
@@ -56,15 +64,16 @@ func New(
 	if err != nil {
 		return nil, err
 	}
-	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, syncMode, tracker)
+	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, syncMode, tracker, l2Checkpoint)
 	blobSyncer, err := blob.NewSyncer(
 		ctx,
 		rpc,
 		state,
 		tracker,
 		maxRetrieveExponent,
-		blobServerEndpoint,
-		socialScanEndpoint,
+		blobSources,
+		txListCodecForks,
+		journal,
 	)
 	if err != nil {
 		return nil, err
@@ -79,6 +88,7 @@ func New(
 		progressTracker: tracker,
 		syncMode:        syncMode,
 		p2pSync:         p2pSync,
+		checkpoint:      l2Checkpoint,
 	}, nil
 }
 
@@ -125,8 +135,11 @@ func (s *L2ChainSyncer) Sync() error {
 			"lastSyncedVerifiedBlockHash", s.progressTracker.LastSyncedBlockHash(),
 		)
 
-		// Reset the L1Current cursor.
-		if err := s.state.ResetL1Current(s.ctx, l2Head.Number); err != nil {
+		// Reset the L1Current cursor, the checkpoint's L1 cursor is used if the checkpoint block
+		// is the L2 head, since its block info may have been overwritten in protocol.
+		if s.checkpoint != nil && s.checkpoint.L2Header.Hash() == l2Head.Hash() {
+			s.state.SetL1Current(s.checkpoint.L1Current)
+		} else if err := s.state.ResetL1Current(s.ctx, l2Head.Number); err != nil {
 			return err
 		}
 
@@ -170,13 +183,24 @@ func (s *L2ChainSyncer) AheadOfHeadToSync(heightToSync uint64) bool {
 
 // needNewBeaconSyncTriggered checks whether the current L2 execution engine needs to trigger
 // another new beacon sync, the following conditions should be met:
-// 1. The `P2PSync` flag is set.
+// 1. The `P2PSync` flag is set, or a checkpoint is imported.
 // 2. The protocol's latest verified block head is not zero.
 // 3. The L2 execution engine's chain is behind of the protocol's latest verified block head.
 // 4. The L2 execution engine's chain has met a sync timeout issue.
 func (s *L2ChainSyncer) needNewBeaconSyncTriggered() (uint64, bool, error) {
-	// If the flag is not set or there was a finished beacon sync, we simply return false.
-	if !s.p2pSync || s.progressTracker.Finished() {
+	// If there was a finished beacon sync, we simply return false.
+	if s.progressTracker.Finished() {
+		return 0, false, nil
+	}
+
+	// If a checkpoint is imported, we will use the checkpoint block as the head to sync.
+	if s.checkpoint != nil {
+		blockID := s.checkpoint.L2Header.Number.Uint64()
+		return blockID, !s.AheadOfHeadToSync(blockID) && !s.progressTracker.OutOfSync(), nil
+	}
+
+	// If the flag is not set, we simply return false.
+	if !s.p2pSync {
 		return 0, false, nil
 	}
 
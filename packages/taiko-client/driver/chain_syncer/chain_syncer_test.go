@@ -41,9 +41,11 @@ func (s *ChainSyncerTestSuite) SetupTest() {
 		state,
 		false,
 		1*time.Hour,
+		nil,
 		0,
 		nil,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
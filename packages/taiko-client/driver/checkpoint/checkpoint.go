@@ -0,0 +1,263 @@
+package checkpoint
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+var (
+	errInvalidSignature = errors.New("invalid checkpoint signature")
+	errUntrustedSigner  = errors.New("untrusted checkpoint signer")
+)
+
+// Checkpoint is a signed snapshot of a verified L2 block, a new L2 node can beacon sync to the
+// checkpoint L2 block through P2P, and then start deriving the following blocks from the
+// checkpoint L1 cursor, instead of replaying every proposal from genesis.
+type Checkpoint struct {
+	L2ChainID *big.Int      `json:"l2ChainId"`
+	L2Header  *types.Header `json:"l2Header"`
+	// L1Current is the L1 block which proposed the checkpoint L2 block, i.e. the driver's L1
+	// cursor after inserting the checkpoint L2 block.
+	L1Current             *types.Header `json:"l1Current"`
+	LastVerifiedBlockHash common.Hash   `json:"lastVerifiedBlockHash"`
+	Signature             hexutil.Bytes `json:"signature"`
+}
+
+// Export creates a new checkpoint of the protocol's last verified L2 block, and signs it with
+// the given private key.
+func Export(
+	ctx context.Context,
+	client *rpc.Client,
+	state *state.State,
+	signerKey *ecdsa.PrivateKey,
+) (*Checkpoint, error) {
+	lastVerifiedBlockHash, err := client.GetLastVerifiedBlockHash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the last verified block hash: %w", err)
+	}
+
+	l2Header, err := client.L2.HeaderByHash(ctx, lastVerifiedBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the last verified block %s: %w", lastVerifiedBlockHash, err)
+	}
+
+	if err := state.ResetL1Current(ctx, l2Header.Number); err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{
+		L2ChainID:             client.L2.ChainID,
+		L2Header:              l2Header,
+		L1Current:             state.GetL1Current(),
+		LastVerifiedBlockHash: lastVerifiedBlockHash,
+	}
+	if err := checkpoint.sign(signerKey); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// Load loads a checkpoint from the given JSON file, and checks whether it's signed by the
+// given trusted signer.
+func Load(path string, trustedSigner common.Address) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	checkpoint := new(Checkpoint)
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	if checkpoint.L2ChainID == nil || checkpoint.L2Header == nil || checkpoint.L1Current == nil {
+		return nil, errors.New("incomplete checkpoint")
+	}
+
+	signer, err := checkpoint.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer != trustedSigner {
+		return nil, fmt.Errorf("%w: %s", errUntrustedSigner, signer)
+	}
+
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to the given JSON file.
+func (c *Checkpoint) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Signer returns the address which signed the checkpoint.
+func (c *Checkpoint) Signer() (common.Address, error) {
+	if len(c.Signature) != crypto.SignatureLength {
+		return common.Address{}, errInvalidSignature
+	}
+
+	pubKey, err := crypto.SigToPub(c.digest().Bytes(), c.Signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// Verify checks the checkpoint against the TaikoL1 state, the checkpoint L2 block should have
+// been verified in protocol, and the checkpoint L1 block should be the canonical L1 block which
+// proposed it.
+func (c *Checkpoint) Verify(ctx context.Context, client *rpc.Client, state *state.State) error {
+	if c.L2ChainID.Cmp(client.L2.ChainID) != 0 {
+		return fmt.Errorf("L2 chain ID mismatch: %d != %d", c.L2ChainID, client.L2.ChainID)
+	}
+	if c.L2Header.Hash() != c.LastVerifiedBlockHash {
+		return fmt.Errorf("L2 header hash mismatch: %s != %s", c.L2Header.Hash(), c.LastVerifiedBlockHash)
+	}
+
+	stateVars, err := client.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+	if c.L2Header.Number.Uint64() > stateVars.B.LastVerifiedBlockId {
+		return fmt.Errorf(
+			"L2 block %d not verified, last verified block: %d",
+			c.L2Header.Number,
+			stateVars.B.LastVerifiedBlockId,
+		)
+	}
+
+	var blockInfo bindings.TaikoDataBlockV2
+	if state.IsOnTake(c.L2Header.Number) {
+		blockInfo, err = client.GetL2BlockInfoV2(ctx, c.L2Header.Number)
+	} else {
+		blockInfo, err = client.GetL2BlockInfo(ctx, c.L2Header.Number)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d info, the checkpoint may be too old: %w", c.L2Header.Number, err)
+	}
+
+	transition, err := client.GetTransition(ctx, c.L2Header.Number, uint32(blockInfo.VerifiedTransitionId.Uint64()))
+	if err != nil {
+		return err
+	}
+	if transition.BlockHash != c.L2Header.Hash() {
+		return fmt.Errorf(
+			"verified L2 block hash mismatch: %s != %s",
+			common.Hash(transition.BlockHash),
+			c.L2Header.Hash(),
+		)
+	}
+
+	if c.L1Current.Number.Uint64() != blockInfo.ProposedIn {
+		return fmt.Errorf("L1 current height mismatch: %d != %d", c.L1Current.Number, blockInfo.ProposedIn)
+	}
+	l1Header, err := client.L1.HeaderByNumber(ctx, c.L1Current.Number)
+	if err != nil {
+		return err
+	}
+	if l1Header.Hash() != c.L1Current.Hash() {
+		return fmt.Errorf("L1 current hash mismatch: %s != %s", c.L1Current.Hash(), l1Header.Hash())
+	}
+
+	log.Info(
+		"Checkpoint verified",
+		"l2BlockID", c.L2Header.Number,
+		"l2BlockHash", c.L2Header.Hash(),
+		"l1Current", c.L1Current.Number,
+	)
+
+	return nil
+}
+
+// sign signs the checkpoint with the given private key.
+func (c *Checkpoint) sign(key *ecdsa.PrivateKey) (err error) {
+	c.Signature, err = crypto.Sign(c.digest().Bytes(), key)
+	return err
+}
+
+// digest returns the hash of the checkpoint fields which are signed.
+func (c *Checkpoint) digest() common.Hash {
+	encoded, err := rlp.EncodeToBytes([]interface{}{
+		c.L2ChainID,
+		c.L2Header,
+		c.L1Current,
+		c.LastVerifiedBlockHash,
+	})
+	if err != nil {
+		log.Crit("Failed to encode checkpoint", "error", err)
+	}
+
+	return crypto.Keccak256Hash(encoded)
+}
+
+// ExportFromCli exports a signed checkpoint of the protocol's last verified L2 block based on the
+// command line flags.
+func ExportFromCli(ctx context.Context, c *cli.Context) error {
+	signerKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.CheckpointSignerPrivKey.Name)))
+	if err != nil {
+		return fmt.Errorf("invalid checkpoint signer private key: %w", err)
+	}
+
+	client, err := rpc.NewClient(ctx, &rpc.ClientConfig{
+		L1Endpoint:     c.String(flags.L1WSEndpoint.Name),
+		L2Endpoint:     c.String(flags.L2WSEndpoint.Name),
+		TaikoL1Address: common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+		TaikoL2Address: common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+		Timeout:        c.Duration(flags.RPCTimeout.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	driverState, err := state.New(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer driverState.Close()
+
+	checkpoint, err := Export(ctx, client, driverState, signerKey)
+	if err != nil {
+		return err
+	}
+
+	path := c.String(flags.CheckpointOutput.Name)
+	if err := checkpoint.Save(path); err != nil {
+		return err
+	}
+
+	log.Info(
+		"L2 checkpoint exported",
+		"file", path,
+		"l2BlockID", checkpoint.L2Header.Number,
+		"l2BlockHash", checkpoint.L2Header.Hash(),
+		"l1Current", checkpoint.L1Current.Number,
+		"signer", crypto.PubkeyToAddress(signerKey.PublicKey),
+	)
+
+	return nil
+}
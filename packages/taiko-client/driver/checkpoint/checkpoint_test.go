@@ -0,0 +1,56 @@
+package checkpoint
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	l2Header := &types.Header{Number: big.NewInt(100), Difficulty: common.Big0}
+	checkpoint := &Checkpoint{
+		L2ChainID:             big.NewInt(167),
+		L2Header:              l2Header,
+		L1Current:             &types.Header{Number: big.NewInt(10), Difficulty: common.Big0},
+		LastVerifiedBlockHash: l2Header.Hash(),
+	}
+	require.Nil(t, checkpoint.sign(key))
+
+	recovered, err := checkpoint.Signer()
+	require.Nil(t, err)
+	require.Equal(t, signer, recovered)
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.Nil(t, checkpoint.Save(path))
+
+	loaded, err := Load(path, signer)
+	require.Nil(t, err)
+	require.Equal(t, l2Header.Hash(), loaded.L2Header.Hash())
+	require.Equal(t, checkpoint.L1Current.Hash(), loaded.L1Current.Hash())
+	require.Equal(t, checkpoint.LastVerifiedBlockHash, loaded.LastVerifiedBlockHash)
+
+	// Untrusted signer.
+	_, err = Load(path, common.Address{})
+	require.ErrorIs(t, err, errUntrustedSigner)
+
+	// Tampered checkpoint.
+	checkpoint.L1Current = &types.Header{Number: big.NewInt(11), Difficulty: common.Big0}
+	require.Nil(t, checkpoint.Save(path))
+	_, err = Load(path, signer)
+	require.ErrorIs(t, err, errUntrustedSigner)
+
+	// Missing signature.
+	checkpoint.Signature = nil
+	require.Nil(t, checkpoint.Save(path))
+	_, err = Load(path, signer)
+	require.ErrorIs(t, err, errInvalidSignature)
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/checkpoint"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
@@ -17,12 +20,20 @@ import (
 // Config contains the configurations to initialize a Taiko driver.
 type Config struct {
 	*rpc.ClientConfig
-	P2PSync            bool
-	P2PSyncTimeout     time.Duration
-	RetryInterval      time.Duration
-	MaxExponent        uint64
-	BlobServerEndpoint *url.URL
-	SocialScanEndpoint *url.URL
+	P2PSync          bool
+	P2PSyncTimeout   time.Duration
+	Checkpoint       *checkpoint.Checkpoint
+	RetryInterval    time.Duration
+	MaxExponent      uint64
+	BlobSources      *rpc.BlobDataSourceConfig
+	TxListCodecForks []*config.TxListCodecFork
+	// L1 reorg journal
+	ReorgJournalSize       uint64
+	ReorgJournalAlertDepth uint64
+	ReorgJournalServerPort uint64
+	// Preconfirmation block server
+	PreconfBlockServerPort uint64
+	PreconfProposers       []common.Address
 }
 
 // NewConfigFromCliContext creates a new config instance from
@@ -42,51 +53,121 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("empty L2 check point URL")
 	}
 
+	var l2Checkpoint *checkpoint.Checkpoint
+	if c.IsSet(flags.CheckpointImport.Name) {
+		trustedSigner := c.String(flags.CheckpointTrustedSigner.Name)
+		if !common.IsHexAddress(trustedSigner) {
+			return nil, fmt.Errorf("invalid checkpoint trusted signer address: %s", trustedSigner)
+		}
+		if l2Checkpoint, err = checkpoint.Load(
+			c.String(flags.CheckpointImport.Name),
+			common.HexToAddress(trustedSigner),
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	var beaconEndpoint string
 	if c.IsSet(flags.L1BeaconEndpoint.Name) {
 		beaconEndpoint = c.String(flags.L1BeaconEndpoint.Name)
 	}
 
-	var blobServerEndpoint *url.URL
-	if c.IsSet(flags.BlobServerEndpoint.Name) {
-		if blobServerEndpoint, err = url.Parse(
-			c.String(flags.BlobServerEndpoint.Name),
-		); err != nil {
-			return nil, err
-		}
+	blobSources, err := NewBlobSourcesConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
 	}
 
-	var socialScanEndpoint *url.URL
-	if c.IsSet(flags.SocialScanEndpoint.Name) {
-		if socialScanEndpoint, err = url.Parse(
-			c.String(flags.SocialScanEndpoint.Name),
-		); err != nil {
-			return nil, err
-		}
+	txListCodecForks, err := config.ParseTxListCodecForks(c.StringSlice(flags.TxListCodecForks.Name))
+	if err != nil {
+		return nil, err
 	}
 
-	if beaconEndpoint == "" && blobServerEndpoint == nil && socialScanEndpoint == nil {
-		return nil, errors.New("empty L1 beacon endpoint, blob server and Social Scan endpoint")
+	var preconfProposers []common.Address
+	for _, proposer := range c.StringSlice(flags.PreconfProposers.Name) {
+		if !common.IsHexAddress(proposer) {
+			return nil, fmt.Errorf("invalid preconfirmation proposer address: %s", proposer)
+		}
+		preconfProposers = append(preconfProposers, common.HexToAddress(proposer))
+	}
+	preconfBlockServerPort := c.Uint64(flags.PreconfBlockServerPort.Name)
+	if preconfBlockServerPort > 0 && len(preconfProposers) == 0 {
+		return nil, errors.New("empty preconfirmation proposers")
 	}
 
 	var timeout = c.Duration(flags.RPCTimeout.Name)
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:       c.String(flags.L1WSEndpoint.Name),
-			L1BeaconEndpoint: beaconEndpoint,
-			L2Endpoint:       c.String(flags.L2WSEndpoint.Name),
-			L2CheckPoint:     l2CheckPoint,
-			TaikoL1Address:   common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:   common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			L2EngineEndpoint: c.String(flags.L2AuthEndpoint.Name),
-			JwtSecret:        string(jwtSecret),
-			Timeout:          timeout,
+			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
+			L1BeaconEndpoint:  beaconEndpoint,
+			L2Endpoint:        c.String(flags.L2WSEndpoint.Name),
+			L2CheckPoint:      l2CheckPoint,
+			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:  c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:         string(jwtSecret),
+			Timeout:           timeout,
+			L1QuorumEndpoints: c.StringSlice(flags.L1QuorumEndpoints.Name),
+			L1QuorumThreshold: c.Uint64(flags.L1QuorumThreshold.Name),
+			L1QuorumObserver:  metrics.L1QuorumObserver,
 		},
-		RetryInterval:      c.Duration(flags.BackOffRetryInterval.Name),
-		P2PSync:            p2pSync,
-		P2PSyncTimeout:     c.Duration(flags.P2PSyncTimeout.Name),
-		MaxExponent:        c.Uint64(flags.MaxExponent.Name),
-		BlobServerEndpoint: blobServerEndpoint,
-		SocialScanEndpoint: socialScanEndpoint,
+		RetryInterval:    c.Duration(flags.BackOffRetryInterval.Name),
+		P2PSync:          p2pSync,
+		P2PSyncTimeout:   c.Duration(flags.P2PSyncTimeout.Name),
+		Checkpoint:       l2Checkpoint,
+		MaxExponent:      c.Uint64(flags.MaxExponent.Name),
+		BlobSources:      blobSources,
+		TxListCodecForks: txListCodecForks,
+		// L1 reorg journal
+		ReorgJournalSize:       c.Uint64(flags.ReorgJournalSize.Name),
+		ReorgJournalAlertDepth: c.Uint64(flags.ReorgJournalAlertDepth.Name),
+		ReorgJournalServerPort: c.Uint64(flags.ReorgJournalServerPort.Name),
+		// Preconfirmation block server
+		PreconfBlockServerPort: preconfBlockServerPort,
+		PreconfProposers:       preconfProposers,
 	}, nil
 }
+
+// NewBlobSourcesConfigFromCliContext creates a new blob sidecar sources config from
+// the command line inputs.
+func NewBlobSourcesConfigFromCliContext(c *cli.Context) (*rpc.BlobDataSourceConfig, error) {
+	blobServerEndpoints, err := parseURLs(c.StringSlice(flags.BlobServerEndpoint.Name))
+	if err != nil {
+		return nil, err
+	}
+	socialScanEndpoints, err := parseURLs(c.StringSlice(flags.SocialScanEndpoint.Name))
+	if err != nil {
+		return nil, err
+	}
+	blobBeaconEndpoints := c.StringSlice(flags.BlobBeaconEndpoints.Name)
+
+	if !c.IsSet(flags.L1BeaconEndpoint.Name) &&
+		len(blobBeaconEndpoints) == 0 &&
+		len(blobServerEndpoints) == 0 &&
+		len(socialScanEndpoints) == 0 {
+		return nil, errors.New("empty L1 beacon endpoint, blob server and Social Scan endpoint")
+	}
+
+	return &rpc.BlobDataSourceConfig{
+		BeaconEndpoints:     blobBeaconEndpoints,
+		BlobServerEndpoints: blobServerEndpoints,
+		SocialScanEndpoints: socialScanEndpoints,
+		Timeout:             c.Duration(flags.RPCTimeout.Name),
+		CacheDir:            c.String(flags.BlobCacheDir.Name),
+		CacheMaxEntries:     c.Uint64(flags.BlobCacheMaxEntries.Name),
+		Observer:            metrics.DriverBlobSourceObserver,
+	}, nil
+}
+
+// parseURLs parses the given raw URLs.
+func parseURLs(rawURLs []string) ([]*url.URL, error) {
+	urls := make([]*url.URL, 0, len(rawURLs))
+	for _, rawURL := range rawURLs {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, u)
+	}
+
+	return urls, nil
+}
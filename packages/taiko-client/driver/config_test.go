@@ -75,6 +75,16 @@ func (s *DriverTestSuite) TestNewConfigFromCliContextEmptyL2CheckPoint() {
 	}), "empty L2 check point URL")
 }
 
+func (s *DriverTestSuite) TestNewConfigFromCliContextEmptyPreconfProposers() {
+	app := s.SetupApp()
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.JWTSecret.Name, os.Getenv("JWT_SECRET"),
+		"--" + flags.L1BeaconEndpoint.Name, l1BeaconEndpoint,
+		"--" + flags.PreconfBlockServerPort.Name, "9871",
+	}), "empty preconfirmation proposers")
+}
+
 func (s *DriverTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -89,6 +99,8 @@ func (s *DriverTestSuite) SetupApp() *cli.App {
 		&cli.DurationFlag{Name: flags.P2PSyncTimeout.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.StringFlag{Name: flags.CheckPointSyncURL.Name},
+		&cli.Uint64Flag{Name: flags.PreconfBlockServerPort.Name},
+		&cli.StringSliceFlag{Name: flags.PreconfProposers.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		_, err := NewConfigFromCliContext(ctx)
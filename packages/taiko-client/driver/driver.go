@@ -2,6 +2,9 @@ package driver
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -17,6 +20,8 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	chainSyncer "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer"
+	preconfBlocks "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/preconf_blocks"
+	reorgJournal "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/reorg_journal"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
@@ -34,6 +39,13 @@ type Driver struct {
 	l2ChainSyncer *chainSyncer.L2ChainSyncer
 	state         *state.State
 
+	// L1 reorg journal
+	reorgJournal       *reorgJournal.Journal
+	reorgJournalServer *reorgJournal.ReorgJournalAPIServer
+
+	// Preconfirmation block server
+	preconfBlockServer *preconfBlocks.PreconfBlockAPIServer
+
 	l1HeadCh  chan *types.Header
 	l1HeadSub event.Subscription
 
@@ -65,28 +77,51 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		return err
 	}
 
+	if cfg.Checkpoint != nil {
+		if err := cfg.Checkpoint.Verify(d.ctx, d.rpc, d.state); err != nil {
+			return fmt.Errorf("invalid L2 checkpoint: %w", err)
+		}
+	}
+
 	peers, err := d.rpc.L2.PeerCount(d.ctx)
 	if err != nil {
 		return err
 	}
 
-	if cfg.P2PSync && peers == 0 {
+	if (cfg.P2PSync || cfg.Checkpoint != nil) && peers == 0 {
 		log.Warn("P2P syncing verified blocks enabled, but no connected peer found in L2 execution engine")
 	}
 
+	d.reorgJournal = reorgJournal.New(cfg.ReorgJournalSize, cfg.ReorgJournalAlertDepth)
+	if cfg.ReorgJournalServerPort > 0 {
+		d.reorgJournalServer = reorgJournal.NewServer(d.reorgJournal)
+	}
+
 	if d.l2ChainSyncer, err = chainSyncer.New(
 		d.ctx,
 		d.rpc,
 		d.state,
 		cfg.P2PSync,
 		cfg.P2PSyncTimeout,
+		cfg.Checkpoint,
 		cfg.MaxExponent,
-		cfg.BlobServerEndpoint,
-		cfg.SocialScanEndpoint,
+		cfg.BlobSources,
+		cfg.TxListCodecForks,
+		d.reorgJournal,
 	); err != nil {
 		return err
 	}
 
+	if cfg.PreconfBlockServerPort > 0 {
+		if d.preconfBlockServer, err = preconfBlocks.New(&preconfBlocks.NewPreconfBlockAPIServerOpts{
+			Inserter:  d.l2ChainSyncer.BlobSyncer(),
+			ChainID:   d.rpc.L2.ChainID,
+			Proposers: cfg.PreconfProposers,
+		}); err != nil {
+			return err
+		}
+	}
+
 	d.l1HeadSub = d.state.SubL1HeadsFeed(d.l1HeadCh)
 
 	return nil
@@ -98,11 +133,39 @@ func (d *Driver) Start() error {
 	go d.reportProtocolStatus()
 	go d.exchangeTransitionConfigLoop()
 
+	if d.reorgJournalServer != nil {
+		go func() {
+			address := fmt.Sprintf(":%v", d.ReorgJournalServerPort)
+			if err := d.reorgJournalServer.Start(address); !errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start reorg journal server", "error", err)
+			}
+		}()
+	}
+
+	if d.preconfBlockServer != nil {
+		go func() {
+			address := fmt.Sprintf(":%v", d.PreconfBlockServerPort)
+			if err := d.preconfBlockServer.Start(address); !errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start preconfirmation block server", "error", err)
+			}
+		}()
+	}
+
 	return nil
 }
 
 // Close closes the driver instance.
-func (d *Driver) Close(_ context.Context) {
+func (d *Driver) Close(ctx context.Context) {
+	if d.reorgJournalServer != nil {
+		if err := d.reorgJournalServer.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down reorg journal server", "error", err)
+		}
+	}
+	if d.preconfBlockServer != nil {
+		if err := d.preconfBlockServer.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down preconfirmation block server", "error", err)
+		}
+	}
 	d.l1HeadSub.Unsubscribe()
 	d.state.Close()
 	d.wg.Wait()
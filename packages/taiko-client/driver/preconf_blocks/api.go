@@ -0,0 +1,131 @@
+package preconfblocks
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+)
+
+// BuildPreconfBlockRequestBody represents a request body when handling
+// preconfirmation blocks creation requests.
+type BuildPreconfBlockRequestBody struct {
+	BlockID       uint64         `json:"blockId"`
+	ParentHash    common.Hash    `json:"parentHash"`
+	Timestamp     uint64         `json:"timestamp"`
+	Coinbase      common.Address `json:"coinbase"`
+	AnchorBlockID uint64         `json:"anchorBlockId"`
+	// Compressed transactions list, without the TaikoL2.anchorV2 transaction.
+	Transactions hexutil.Bytes `json:"transactions"`
+	// Unix timestamp after which the payload will be rejected, it should be no later than
+	// maxPayloadLifetime from now.
+	Expiry uint64 `json:"expiry"`
+	// Signature of the designated proposer over the `SigningHash` of this payload.
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// BuildPreconfBlockResponseBody represents a response body when handling
+// preconfirmation blocks creation requests.
+type BuildPreconfBlockResponseBody struct {
+	BlockHeader *types.Header `json:"blockHeader"`
+}
+
+// SigningHash returns the hash signed by the designated proposer, which is
+// keccak256(rlp([chainID, blockID, parentHash, timestamp, coinbase, anchorBlockID, transactions, expiry])).
+func (b *BuildPreconfBlockRequestBody) SigningHash(chainID *big.Int) (common.Hash, error) {
+	encoded, err := rlp.EncodeToBytes([]interface{}{
+		chainID,
+		b.BlockID,
+		b.ParentHash,
+		b.Timestamp,
+		b.Coinbase,
+		b.AnchorBlockID,
+		[]byte(b.Transactions),
+		b.Expiry,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// BuildPreconfBlock handles a preconfirmation block creation request, if the request is signed
+// by one of the designated proposers, and has neither expired nor been handled before, inserts
+// the preconfirmation block as the new unsafe L2 head.
+//
+//	@Summary		Insert a preconfirmation block as the unsafe L2 head
+//	@ID			   	build-preconf-block
+//	@Accept			json
+//	@Param			body	body	BuildPreconfBlockRequestBody	true	"preconfirmation block payload"
+//	@Produce		json
+//	@Success		200	{object} BuildPreconfBlockResponseBody
+//	@Router			/preconfBlocks [post]
+func (s *PreconfBlockAPIServer) BuildPreconfBlock(c echo.Context) error {
+	reqBody := new(BuildPreconfBlockRequestBody)
+	if err := c.Bind(reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	now := time.Now()
+	if reqBody.Expiry < uint64(now.Unix()) {
+		return echo.NewHTTPError(http.StatusBadRequest, "payload expired")
+	}
+	if reqBody.Expiry > uint64(now.Add(maxPayloadLifetime).Unix()) {
+		return echo.NewHTTPError(http.StatusBadRequest, "payload expiry too far in the future")
+	}
+
+	hash, err := reqBody.SigningHash(s.chainID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	pubKey, err := crypto.SigToPub(hash.Bytes(), reqBody.Signature)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid signature")
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+	if _, ok := s.proposers[signer]; !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not signed by a designated proposer")
+	}
+
+	log.Info(
+		"New preconfirmation block request",
+		"blockID", reqBody.BlockID,
+		"parentHash", reqBody.ParentHash,
+		"timestamp", reqBody.Timestamp,
+		"anchorBlockID", reqBody.AnchorBlockID,
+		"expiry", reqBody.Expiry,
+		"signer", signer,
+	)
+
+	// Reject the replayed payloads, the handled payloads are remembered until they expire.
+	if !s.markPayloadSeen(hash, reqBody.Expiry, now) {
+		return echo.NewHTTPError(http.StatusConflict, "duplicate payload")
+	}
+
+	header, err := s.inserter.InsertPreconfBlock(c.Request().Context(), &blob.PreconfBlock{
+		BlockID:       new(big.Int).SetUint64(reqBody.BlockID),
+		ParentHash:    reqBody.ParentHash,
+		Timestamp:     reqBody.Timestamp,
+		Coinbase:      reqBody.Coinbase,
+		AnchorBlockID: reqBody.AnchorBlockID,
+		TxList:        reqBody.Transactions,
+	})
+	if err != nil {
+		log.Warn("Failed to insert preconfirmation block", "blockID", reqBody.BlockID, "error", err)
+		// Allow the same payload to be retried.
+		s.unmarkPayloadSeen(hash)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &BuildPreconfBlockResponseBody{BlockHeader: header})
+}
@@ -0,0 +1,165 @@
+package preconfblocks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+)
+
+// maxPayloadLifetime is the maximum lifetime of a preconfirmation block payload, the payloads
+// whose expiry is later than this from now will be rejected.
+const maxPayloadLifetime = 5 * time.Minute
+
+var (
+	errNoInserter  = errors.New("no preconfirmation block inserter")
+	errNoChainID   = errors.New("no L2 chain ID")
+	errNoProposers = errors.New("no designated preconfirmation proposer")
+)
+
+// preconfBlockInserter inserts the preconfirmation blocks into the L2 execution engine.
+type preconfBlockInserter interface {
+	InsertPreconfBlock(ctx context.Context, block *blob.PreconfBlock) (*types.Header, error)
+}
+
+// PreconfBlockAPIServer represents a preconfirmation block server instance, which accepts the
+// preconfirmation blocks signed by the designated proposers.
+type PreconfBlockAPIServer struct {
+	echo      *echo.Echo
+	inserter  preconfBlockInserter
+	chainID   *big.Int
+	proposers map[common.Address]struct{}
+
+	// Signing hashes of the handled payloads which have not expired yet, with their expiries.
+	seenPayloads   map[common.Hash]uint64
+	seenPayloadsMu sync.Mutex
+}
+
+// NewPreconfBlockAPIServerOpts contains all configurations for creating a preconfirmation block server instance.
+type NewPreconfBlockAPIServerOpts struct {
+	Inserter preconfBlockInserter
+	// L2 chain ID, which is a part of the signed preconfirmation block payload.
+	ChainID *big.Int
+	// Only the preconfirmation blocks signed by these proposers will be accepted.
+	Proposers []common.Address
+}
+
+// New creates a new preconfirmation block server instance.
+func New(opts *NewPreconfBlockAPIServerOpts) (*PreconfBlockAPIServer, error) {
+	if opts.Inserter == nil {
+		return nil, errNoInserter
+	}
+	if opts.ChainID == nil {
+		return nil, errNoChainID
+	}
+	if len(opts.Proposers) == 0 {
+		return nil, errNoProposers
+	}
+
+	srv := &PreconfBlockAPIServer{
+		echo:      echo.New(),
+		inserter:  opts.Inserter,
+		chainID:   opts.ChainID,
+		proposers: make(map[common.Address]struct{}, len(opts.Proposers)),
+
+		seenPayloads: make(map[common.Hash]uint64),
+	}
+	for _, proposer := range opts.Proposers {
+		srv.proposers[proposer] = struct{}{}
+	}
+
+	srv.echo.HideBanner = true
+	srv.configureMiddleware()
+	srv.configureRoutes()
+
+	return srv, nil
+}
+
+// markPayloadSeen records the payload of the given signing hash as handled until it expires, and
+// returns false if it has already been handled. The expired payloads are also pruned.
+func (s *PreconfBlockAPIServer) markPayloadSeen(hash common.Hash, expiry uint64, now time.Time) bool {
+	s.seenPayloadsMu.Lock()
+	defer s.seenPayloadsMu.Unlock()
+
+	for seenHash, seenExpiry := range s.seenPayloads {
+		if seenExpiry < uint64(now.Unix()) {
+			delete(s.seenPayloads, seenHash)
+		}
+	}
+
+	if _, ok := s.seenPayloads[hash]; ok {
+		return false
+	}
+	s.seenPayloads[hash] = expiry
+
+	return true
+}
+
+// unmarkPayloadSeen forgets the handled payload of the given signing hash.
+func (s *PreconfBlockAPIServer) unmarkPayloadSeen(hash common.Hash) {
+	s.seenPayloadsMu.Lock()
+	defer s.seenPayloadsMu.Unlock()
+
+	delete(s.seenPayloads, hash)
+}
+
+// Start starts the HTTP server.
+func (s *PreconfBlockAPIServer) Start(address string) error {
+	return s.echo.Start(address)
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *PreconfBlockAPIServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// ServeHTTP implements the `http.Handler` interface which serves HTTP requests.
+func (s *PreconfBlockAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.echo.ServeHTTP(w, r)
+}
+
+// Health endpoints for probes.
+func (s *PreconfBlockAPIServer) Health(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// LogSkipper implements the `middleware.Skipper` interface.
+func LogSkipper(c echo.Context) bool {
+	switch c.Request().URL.Path {
+	case "/healthz":
+		return true
+	default:
+		return false
+	}
+}
+
+// configureMiddleware configures the server middlewares.
+func (s *PreconfBlockAPIServer) configureMiddleware() {
+	s.echo.Use(middleware.RequestID())
+
+	s.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: LogSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+}
+
+// configureRoutes contains all routes which will be used by preconfirmation block server.
+func (s *PreconfBlockAPIServer) configureRoutes() {
+	s.echo.GET("/", s.Health)
+	s.echo.GET("/healthz", s.Health)
+	s.echo.POST("/preconfBlocks", s.BuildPreconfBlock)
+}
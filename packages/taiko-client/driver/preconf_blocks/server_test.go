@@ -0,0 +1,203 @@
+package preconfblocks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+)
+
+var testChainID = big.NewInt(167001)
+
+type testInserter struct {
+	blocks []*blob.PreconfBlock
+	err    error
+}
+
+func (i *testInserter) InsertPreconfBlock(_ context.Context, block *blob.PreconfBlock) (*types.Header, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	i.blocks = append(i.blocks, block)
+
+	return &types.Header{
+		ParentHash: block.ParentHash,
+		Coinbase:   block.Coinbase,
+		Difficulty: common.Big0,
+		Number:     block.BlockID,
+		Time:       block.Timestamp,
+	}, nil
+}
+
+type PreconfBlockAPIServerTestSuite struct {
+	suite.Suite
+	srv         *PreconfBlockAPIServer
+	inserter    *testInserter
+	proposerKey *ecdsa.PrivateKey
+}
+
+func (s *PreconfBlockAPIServerTestSuite) SetupTest() {
+	proposerKey, err := crypto.GenerateKey()
+	s.Nil(err)
+
+	s.proposerKey = proposerKey
+	s.inserter = &testInserter{}
+	s.srv, err = New(&NewPreconfBlockAPIServerOpts{
+		Inserter:  s.inserter,
+		ChainID:   testChainID,
+		Proposers: []common.Address{crypto.PubkeyToAddress(proposerKey.PublicKey)},
+	})
+	s.Nil(err)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestNew() {
+	_, err := New(&NewPreconfBlockAPIServerOpts{})
+	s.ErrorIs(err, errNoInserter)
+
+	_, err = New(&NewPreconfBlockAPIServerOpts{Inserter: s.inserter})
+	s.ErrorIs(err, errNoChainID)
+
+	_, err = New(&NewPreconfBlockAPIServerOpts{Inserter: s.inserter, ChainID: testChainID})
+	s.ErrorIs(err, errNoProposers)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestHealth() {
+	s.Equal(http.StatusOK, s.request(http.MethodGet, "/healthz", "").Code)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestBuildPreconfBlock() {
+	reqBody := s.signedRequestBody(s.proposerKey)
+
+	res := s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody))
+	s.Equal(http.StatusOK, res.Code)
+
+	resBody := new(BuildPreconfBlockResponseBody)
+	s.Nil(json.Unmarshal(res.Body.Bytes(), resBody))
+	s.Equal(reqBody.BlockID, resBody.BlockHeader.Number.Uint64())
+
+	s.Len(s.inserter.blocks, 1)
+	s.Equal(reqBody.ParentHash, s.inserter.blocks[0].ParentHash)
+	s.Equal(reqBody.AnchorBlockID, s.inserter.blocks[0].AnchorBlockID)
+	s.Equal([]byte(reqBody.Transactions), s.inserter.blocks[0].TxList)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestBuildPreconfBlockUnauthorized() {
+	otherKey, err := crypto.GenerateKey()
+	s.Nil(err)
+
+	// Not signed by a designated proposer.
+	res := s.request(http.MethodPost, "/preconfBlocks", s.encode(s.signedRequestBody(otherKey)))
+	s.Equal(http.StatusUnauthorized, res.Code)
+
+	// Signed payload has been modified.
+	reqBody := s.signedRequestBody(s.proposerKey)
+	reqBody.Timestamp++
+	s.Equal(http.StatusUnauthorized, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+
+	// Invalid signature.
+	reqBody.Signature = reqBody.Signature[:10]
+	s.Equal(http.StatusUnauthorized, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+
+	s.Empty(s.inserter.blocks)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestBuildPreconfBlockReplay() {
+	reqBody := s.signedRequestBody(s.proposerKey)
+	s.Equal(http.StatusOK, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+
+	// The same payload should not be handled again.
+	s.Equal(http.StatusConflict, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+	s.Len(s.inserter.blocks, 1)
+
+	// Expired payload.
+	reqBody = s.signedRequestBody(s.proposerKey)
+	reqBody.Expiry = uint64(time.Now().Add(-time.Minute).Unix())
+	s.sign(reqBody, s.proposerKey)
+	res := s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody))
+	s.Equal(http.StatusBadRequest, res.Code)
+	s.Contains(res.Body.String(), "payload expired")
+
+	// Payload which expires too late.
+	reqBody.Expiry = uint64(time.Now().Add(2 * maxPayloadLifetime).Unix())
+	s.sign(reqBody, s.proposerKey)
+	res = s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody))
+	s.Equal(http.StatusBadRequest, res.Code)
+	s.Contains(res.Body.String(), "too far in the future")
+
+	// The expiry is a part of the signed payload.
+	reqBody = s.signedRequestBody(s.proposerKey)
+	reqBody.Expiry++
+	s.Equal(http.StatusUnauthorized, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+
+	s.Len(s.inserter.blocks, 1)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) TestBuildPreconfBlockErrors() {
+	s.Equal(http.StatusBadRequest, s.request(http.MethodPost, "/preconfBlocks", "{").Code)
+
+	s.inserter.err = errors.New("test error")
+	reqBody := s.signedRequestBody(s.proposerKey)
+	res := s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody))
+	s.Equal(http.StatusInternalServerError, res.Code)
+
+	// A payload which failed to be inserted can be retried.
+	s.inserter.err = nil
+	s.Equal(http.StatusOK, s.request(http.MethodPost, "/preconfBlocks", s.encode(reqBody)).Code)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) signedRequestBody(key *ecdsa.PrivateKey) *BuildPreconfBlockRequestBody {
+	reqBody := &BuildPreconfBlockRequestBody{
+		BlockID:       10,
+		ParentHash:    common.HexToHash("0x01"),
+		Timestamp:     1_700_000_000,
+		Coinbase:      common.HexToAddress("0x02"),
+		AnchorBlockID: 100,
+		Transactions:  []byte{0x78, 0x9c},
+		Expiry:        uint64(time.Now().Add(time.Minute).Unix()),
+	}
+	s.sign(reqBody, key)
+
+	return reqBody
+}
+
+func (s *PreconfBlockAPIServerTestSuite) sign(reqBody *BuildPreconfBlockRequestBody, key *ecdsa.PrivateKey) {
+	hash, err := reqBody.SigningHash(testChainID)
+	s.Nil(err)
+	reqBody.Signature, err = crypto.Sign(hash.Bytes(), key)
+	s.Nil(err)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) encode(reqBody *BuildPreconfBlockRequestBody) string {
+	encoded, err := json.Marshal(reqBody)
+	s.Nil(err)
+
+	return string(encoded)
+}
+
+func (s *PreconfBlockAPIServerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	s.srv.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestPreconfBlockAPIServerTestSuite(t *testing.T) {
+	suite.Run(t, new(PreconfBlockAPIServerTestSuite))
+}
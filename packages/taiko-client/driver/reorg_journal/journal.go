@@ -0,0 +1,163 @@
+package reorgjournal
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+)
+
+// Entry is a record of a detected L1 reorg.
+type Entry struct {
+	DetectedAt time.Time `json:"detectedAt"`
+	// L1Current cursor before and after the reorg was handled.
+	L1HeightOld uint64      `json:"l1HeightOld"`
+	L1HashOld   common.Hash `json:"l1HashOld"`
+	L1HeightNew uint64      `json:"l1HeightNew"`
+	L1HashNew   common.Hash `json:"l1HashNew"`
+	// Number of the L1 blocks which the L1Current cursor has been rewound.
+	Depth uint64 `json:"depth"`
+	// Last handled L2 block ID before and after the reorg was handled, and the number of the
+	// L2 blocks which need to be derived again.
+	L2BlockIDOld       *big.Int `json:"l2BlockIdOld,omitempty"`
+	L2BlockIDNew       *big.Int `json:"l2BlockIdNew,omitempty"`
+	L2BlocksRolledBack uint64   `json:"l2BlocksRolledBack"`
+	// Whether the depth has reached the alert threshold.
+	Alerted bool `json:"alerted"`
+	// Set once the L1Current cursor has caught up the old L1Current height again.
+	RecoveredAt     *time.Time `json:"recoveredAt,omitempty"`
+	RecoverySeconds float64    `json:"recoverySeconds,omitempty"`
+}
+
+// Journal keeps the latest detected L1 reorgs in memory, all methods are safe to be called
+// on a nil journal.
+type Journal struct {
+	entries    []*Entry
+	size       int
+	alertDepth uint64
+	mutex      sync.RWMutex
+}
+
+// New creates a new journal instance which keeps at most the given number of entries, the reorgs
+// whose depth reaches the given alert depth will be alerted, zero means no alert.
+func New(size uint64, alertDepth uint64) *Journal {
+	return &Journal{size: int(max(size, 1)), alertDepth: alertDepth}
+}
+
+// Record records a detected L1 reorg, the L2 block IDs are optional.
+func (j *Journal) Record(
+	l1CurrentOld *types.Header,
+	l1CurrentNew *types.Header,
+	l2BlockIDOld *big.Int,
+	l2BlockIDNew *big.Int,
+) {
+	if j == nil {
+		return
+	}
+
+	entry := &Entry{
+		DetectedAt:   time.Now().UTC(),
+		L1HeightOld:  l1CurrentOld.Number.Uint64(),
+		L1HashOld:    l1CurrentOld.Hash(),
+		L1HeightNew:  l1CurrentNew.Number.Uint64(),
+		L1HashNew:    l1CurrentNew.Hash(),
+		L2BlockIDOld: l2BlockIDOld,
+		L2BlockIDNew: l2BlockIDNew,
+	}
+	if entry.L1HeightOld > entry.L1HeightNew {
+		entry.Depth = entry.L1HeightOld - entry.L1HeightNew
+	}
+	if l2BlockIDOld != nil {
+		var newID uint64
+		if l2BlockIDNew != nil {
+			newID = l2BlockIDNew.Uint64()
+		}
+		if l2BlockIDOld.Uint64() > newID {
+			entry.L2BlocksRolledBack = l2BlockIDOld.Uint64() - newID
+		}
+	}
+	entry.Alerted = j.alertDepth != 0 && entry.Depth >= j.alertDepth
+
+	metrics.DriverL1ReorgDetectedCounter.Inc()
+	metrics.DriverL1ReorgDepthHistogram.Observe(float64(entry.Depth))
+	metrics.DriverL1ReorgL2RolledBackHistogram.Observe(float64(entry.L2BlocksRolledBack))
+
+	if entry.Alerted {
+		metrics.DriverL1ReorgAlertCounter.Inc()
+		log.Error(
+			"Deep L1 reorg detected",
+			"depth", entry.Depth,
+			"alertDepth", j.alertDepth,
+			"l1HeightOld", entry.L1HeightOld,
+			"l1HashOld", entry.L1HashOld,
+			"l1HeightNew", entry.L1HeightNew,
+			"l1HashNew", entry.L1HashNew,
+			"l2BlocksRolledBack", entry.L2BlocksRolledBack,
+		)
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.entries = append(j.entries, entry)
+	if len(j.entries) > j.size {
+		j.entries = j.entries[len(j.entries)-j.size:]
+	}
+}
+
+// MarkRecovered marks the pending reorgs as recovered, if the given L1Current cursor has caught up
+// their old L1Current heights.
+func (j *Journal) MarkRecovered(l1Current *types.Header) {
+	if j == nil {
+		return
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	now := time.Now().UTC()
+	for _, entry := range j.entries {
+		if entry.RecoveredAt != nil || entry.L1HeightOld > l1Current.Number.Uint64() {
+			continue
+		}
+
+		recoveredAt := now
+		entry.RecoveredAt = &recoveredAt
+		entry.RecoverySeconds = now.Sub(entry.DetectedAt).Seconds()
+		metrics.DriverL1ReorgRecoveryHistogram.Observe(entry.RecoverySeconds)
+
+		log.Info(
+			"Recovered from L1 reorg",
+			"l1HeightOld", entry.L1HeightOld,
+			"l1HeightNew", entry.L1HeightNew,
+			"recoverySeconds", entry.RecoverySeconds,
+		)
+	}
+}
+
+// Entries returns copies of the latest recorded entries, from the newest to the oldest, zero
+// limit means all entries.
+func (j *Journal) Entries(limit int) []Entry {
+	if j == nil {
+		return []Entry{}
+	}
+
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	if limit <= 0 || limit > len(j.entries) {
+		limit = len(j.entries)
+	}
+
+	entries := make([]Entry, 0, limit)
+	for i := len(j.entries) - 1; i >= len(j.entries)-limit; i-- {
+		entries = append(entries, *j.entries[i])
+	}
+
+	return entries
+}
@@ -0,0 +1,63 @@
+package reorgjournal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testHeader(number int64) *types.Header {
+	return &types.Header{Number: big.NewInt(number), Difficulty: common.Big0}
+}
+
+func TestRecord(t *testing.T) {
+	j := New(2, 5)
+
+	j.Record(testHeader(100), testHeader(98), big.NewInt(20), big.NewInt(17))
+	j.Record(testHeader(110), testHeader(104), big.NewInt(30), nil)
+	j.Record(testHeader(120), testHeader(121), nil, nil)
+
+	entries := j.Entries(0)
+	require.Len(t, entries, 2)
+
+	// From the newest to the oldest.
+	require.Equal(t, uint64(120), entries[0].L1HeightOld)
+	require.Zero(t, entries[0].Depth)
+	require.Zero(t, entries[0].L2BlocksRolledBack)
+	require.False(t, entries[0].Alerted)
+
+	require.Equal(t, uint64(110), entries[1].L1HeightOld)
+	require.Equal(t, testHeader(104).Hash(), entries[1].L1HashNew)
+	require.Equal(t, uint64(6), entries[1].Depth)
+	require.Equal(t, uint64(30), entries[1].L2BlocksRolledBack)
+	require.True(t, entries[1].Alerted)
+
+	require.Len(t, j.Entries(1), 1)
+}
+
+func TestMarkRecovered(t *testing.T) {
+	j := New(10, 0)
+
+	j.Record(testHeader(100), testHeader(98), big.NewInt(20), big.NewInt(17))
+	j.Record(testHeader(110), testHeader(104), nil, nil)
+
+	j.MarkRecovered(testHeader(105))
+	entries := j.Entries(0)
+	require.Nil(t, entries[0].RecoveredAt)
+	require.NotNil(t, entries[1].RecoveredAt)
+	require.False(t, entries[1].Alerted)
+
+	j.MarkRecovered(testHeader(110))
+	require.NotNil(t, j.Entries(1)[0].RecoveredAt)
+}
+
+func TestNilJournal(t *testing.T) {
+	var j *Journal
+
+	j.Record(testHeader(100), testHeader(98), nil, nil)
+	j.MarkRecovered(testHeader(100))
+	require.Empty(t, j.Entries(0))
+}
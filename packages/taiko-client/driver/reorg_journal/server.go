@@ -0,0 +1,73 @@
+package reorgjournal
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReorgJournalAPIServer represents a reorg journal server instance, which serves the
+// recorded L1 reorgs.
+type ReorgJournalAPIServer struct {
+	echo    *echo.Echo
+	journal *Journal
+}
+
+// NewServer creates a new reorg journal server instance.
+func NewServer(journal *Journal) *ReorgJournalAPIServer {
+	srv := &ReorgJournalAPIServer{echo: echo.New(), journal: journal}
+
+	srv.echo.HideBanner = true
+	srv.configureRoutes()
+
+	return srv
+}
+
+// Start starts the HTTP server.
+func (s *ReorgJournalAPIServer) Start(address string) error {
+	return s.echo.Start(address)
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *ReorgJournalAPIServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// ServeHTTP implements the `http.Handler` interface which serves HTTP requests.
+func (s *ReorgJournalAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.echo.ServeHTTP(w, r)
+}
+
+// Health endpoints for probes.
+func (s *ReorgJournalAPIServer) Health(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// GetReorgs returns the latest recorded L1 reorgs, from the newest to the oldest.
+//
+//	@Summary		Get the latest recorded L1 reorgs
+//	@ID			   	get-reorgs
+//	@Param			limit	query	int	false	"Maximum number of the returned reorgs"
+//	@Produce		json
+//	@Success		200	{object} []Entry
+//	@Router			/reorgs [get]
+func (s *ReorgJournalAPIServer) GetReorgs(c echo.Context) error {
+	var limit int
+	if raw := c.QueryParam("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil || limit < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+	}
+
+	return c.JSON(http.StatusOK, s.journal.Entries(limit))
+}
+
+// configureRoutes contains all routes which will be used by reorg journal server.
+func (s *ReorgJournalAPIServer) configureRoutes() {
+	s.echo.GET("/", s.Health)
+	s.echo.GET("/healthz", s.Health)
+	s.echo.GET("/reorgs", s.GetReorgs)
+}
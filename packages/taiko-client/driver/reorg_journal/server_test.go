@@ -0,0 +1,32 @@
+package reorgjournal
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReorgs(t *testing.T) {
+	j := New(10, 0)
+	j.Record(testHeader(100), testHeader(98), big.NewInt(20), big.NewInt(17))
+	j.Record(testHeader(110), testHeader(104), nil, nil)
+
+	srv := NewServer(j)
+
+	res := httptest.NewRecorder()
+	srv.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/reorgs?limit=1", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var entries []Entry
+	require.Nil(t, json.Unmarshal(res.Body.Bytes(), &entries))
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(110), entries[0].L1HeightOld)
+
+	res = httptest.NewRecorder()
+	srv.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/reorgs?limit=-1", nil))
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
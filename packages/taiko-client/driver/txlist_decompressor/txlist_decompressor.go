@@ -7,7 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 
-	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 )
 
 // TxListDecompressor is responsible for validating and decompressing
@@ -16,18 +16,23 @@ type TxListDecompressor struct {
 	blockMaxGasLimit  uint64
 	maxBytesPerTxList uint64
 	chainID           *big.Int
+	chainConfig       *config.ChainConfig
 }
 
-// NewTxListDecompressor creates a new TxListDecompressor instance based on giving configurations.
+// NewTxListDecompressor creates a new TxListDecompressor instance based on giving configurations,
+// the transactions lists are decompressed by the codec scheduled in the given chain config, zlib
+// is always used if the chain config is nil.
 func NewTxListDecompressor(
 	blockMaxGasLimit uint64,
 	maxBytesPerTxList uint64,
 	chainID *big.Int,
+	chainConfig *config.ChainConfig,
 ) *TxListDecompressor {
 	return &TxListDecompressor{
 		blockMaxGasLimit:  blockMaxGasLimit,
 		maxBytesPerTxList: maxBytesPerTxList,
 		chainID:           chainID,
+		chainConfig:       chainConfig,
 	}
 }
 
@@ -61,8 +66,9 @@ func (v *TxListDecompressor) TryDecompress(
 	)
 
 	// Decompress the transaction list bytes.
-	if txListBytes, err = utils.Decompress(txListBytes); err != nil {
-		log.Info("Failed to decompress tx list bytes", "blockID", blockID, "error", err)
+	codec := v.chainConfig.TxListCodec(blockID)
+	if txListBytes, err = codec.Decompress(txListBytes); err != nil {
+		log.Info("Failed to decompress tx list bytes", "blockID", blockID, "codec", codec.Name(), "error", err)
 		return []byte{}
 	}
 
@@ -95,8 +101,9 @@ func (v *TxListDecompressor) TryDecompressHekla(
 	)
 
 	// Decompress the transaction list bytes.
-	if txListBytes, err = utils.Decompress(txListBytes); err != nil {
-		log.Info("Failed to decompress tx list bytes", "blockID", blockID, "error", err)
+	codec := v.chainConfig.TxListCodec(blockID)
+	if txListBytes, err = codec.Decompress(txListBytes); err != nil {
+		log.Info("Failed to decompress tx list bytes", "blockID", blockID, "codec", codec.Name(), "error", err)
 		return []byte{}
 	}
 
@@ -13,8 +13,11 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -35,6 +38,7 @@ func (s *TxListDecompressorTestSuite) SetupTest() {
 		params.MaxGasLimit,
 		rpc.BlockMaxTxListBytes,
 		chainID,
+		nil,
 	)
 }
 
@@ -70,6 +74,24 @@ func (s *TxListDecompressorTestSuite) TestInvalidZlibBytes() {
 	s.Zero(len(s.d.TryDecompress(chainID, randBytes(1024), false)))
 }
 
+func (s *TxListDecompressorTestSuite) TestTxListCodecFork() {
+	chainConfig, err := config.NewChainConfig(&bindings.TaikoDataConfig{}, &config.TxListCodecFork{Height: 10, Codec: "zstd"})
+	s.Nil(err)
+	d := NewTxListDecompressor(params.MaxGasLimit, rpc.BlockMaxTxListBytes, chainID, chainConfig)
+
+	txListBytes := rlpEncodedTransactionBytes(1, true)
+	zlibCompressed, err := compression.Zlib.Compress(txListBytes)
+	s.Nil(err)
+	zstdCompressed, err := compression.Zstd.Compress(txListBytes)
+	s.Nil(err)
+
+	s.Equal(txListBytes, d.TryDecompress(common.Big0, zlibCompressed, true))
+	s.Zero(len(d.TryDecompress(common.Big0, zstdCompressed, true)))
+	s.Equal(txListBytes, d.TryDecompress(big.NewInt(10), zstdCompressed, true))
+	s.Zero(len(d.TryDecompress(big.NewInt(10), zlibCompressed, true)))
+	s.Equal(txListBytes, d.TryDecompressHekla(big.NewInt(10), zstdCompressed, false))
+}
+
 func TestDriverTestSuite(t *testing.T) {
 	suite.Run(t, new(TxListDecompressorTestSuite))
 }
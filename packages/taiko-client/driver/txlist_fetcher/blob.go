@@ -2,12 +2,10 @@ package txlistdecoder
 
 import (
 	"context"
-	"crypto/sha256"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
@@ -36,37 +34,32 @@ func (d *BlobFetcher) Fetch(
 		return nil, pkg.ErrBlobUsed
 	}
 
-	// Fetch the L1 block sidecars.
-	sidecars, err := d.dataSource.GetBlobs(ctx, meta.GetProposedAt(), meta.GetBlobHash())
+	// Fetch the verified L1 block sidecar, whose blob data matches the meta blob hash.
+	sidecar, err := d.dataSource.GetBlob(
+		ctx,
+		meta.GetRawBlockHeight().Uint64(),
+		meta.GetProposedAt(),
+		meta.GetBlobHash(),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Info("Fetch sidecars", "blockNumber", meta.GetRawBlockHeight(), "sidecars", len(sidecars))
+	log.Info(
+		"Block sidecar",
+		"blockNumber", meta.GetRawBlockHeight(),
+		"KzgCommitment", sidecar.KzgCommitment,
+		"blobHash", meta.GetBlobHash(),
+	)
 
-	// Compare the blob hash with the sidecar's kzg commitment.
-	for i, sidecar := range sidecars {
-		log.Info(
-			"Block sidecar",
-			"index", i,
-			"KzgCommitment", sidecar.KzgCommitment,
-			"blobHash", meta.GetBlobHash(),
-		)
-
-		commitment := kzg4844.Commitment(common.FromHex(sidecar.KzgCommitment))
-		if kzg4844.CalcBlobHashV1(sha256.New(), &commitment) == meta.GetBlobHash() {
-			blob := eth.Blob(common.FromHex(sidecar.Blob))
-			bytes, err := blob.ToData()
-			if err != nil {
-				return nil, err
-			}
-
-			if meta.GetBlobTxListLength() == 0 {
-				return bytes[meta.GetBlobTxListOffset():], nil
-			}
-			return bytes[meta.GetBlobTxListOffset() : meta.GetBlobTxListOffset()+meta.GetBlobTxListLength()], nil
-		}
+	blob := eth.Blob(common.FromHex(sidecar.Blob))
+	bytes, err := blob.ToData()
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, pkg.ErrSidecarNotFound
+	if meta.GetBlobTxListLength() == 0 {
+		return bytes[meta.GetBlobTxListOffset():], nil
+	}
+	return bytes[meta.GetBlobTxListOffset() : meta.GetBlobTxListOffset()+meta.GetBlobTxListLength()], nil
 }
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// DriverBlobSourceObserver records the metrics of the driver blob sidecar requests.
+var DriverBlobSourceObserver rpc.BlobSourceObserver = new(blobSourceObserver)
+
+// blobSourceObserver implements the rpc.BlobSourceObserver interface.
+type blobSourceObserver struct{}
+
+// ObserveBlobRequest implements the rpc.BlobSourceObserver interface.
+func (o *blobSourceObserver) ObserveBlobRequest(source string, latency time.Duration, err error) {
+	DriverBlobSourceLatencyHistogram.WithLabelValues(source).Observe(latency.Seconds())
+
+	if err == nil {
+		DriverBlobSidecarsVerifiedCounter.Inc()
+		return
+	}
+
+	DriverBlobSourceFailedCounter.WithLabelValues(source).Inc()
+	switch {
+	case errors.Is(err, pkg.ErrSidecarCommitmentMismatch):
+		DriverBlobSidecarsTamperedCounter.WithLabelValues(source, "commitment_mismatch").Inc()
+	case errors.Is(err, pkg.ErrSidecarInvalidProof):
+		DriverBlobSidecarsTamperedCounter.WithLabelValues(source, "invalid_proof").Inc()
+	case errors.Is(err, pkg.ErrSidecarMalformed):
+		DriverBlobSidecarsTamperedCounter.WithLabelValues(source, "malformed").Inc()
+	}
+}
+
+// ObserveBlobCache implements the rpc.BlobSourceObserver interface.
+func (o *blobSourceObserver) ObserveBlobCache(hit bool) {
+	if hit {
+		DriverBlobCacheHitCounter.Inc()
+	} else {
+		DriverBlobCacheMissCounter.Inc()
+	}
+}
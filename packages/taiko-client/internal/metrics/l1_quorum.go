@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// L1QuorumObserver records the metrics of the L1 quorum reads.
+var L1QuorumObserver rpc.QuorumObserver = new(l1QuorumObserver)
+
+// l1QuorumObserver implements the rpc.QuorumObserver interface.
+type l1QuorumObserver struct{}
+
+// ObserveQuorumProviderError implements the rpc.QuorumObserver interface.
+func (o *l1QuorumObserver) ObserveQuorumProviderError(method string, provider string) {
+	L1QuorumProviderErrorCounter.WithLabelValues(method, provider).Inc()
+}
+
+// ObserveQuorumDisagreement implements the rpc.QuorumObserver interface.
+func (o *l1QuorumObserver) ObserveQuorumDisagreement(method string, provider string) {
+	L1QuorumDisagreementCounter.WithLabelValues(method, provider).Inc()
+}
+
+// ObserveQuorumNotReached implements the rpc.QuorumObserver interface.
+func (o *l1QuorumObserver) ObserveQuorumNotReached(method string) {
+	L1QuorumNotReachedCounter.WithLabelValues(method).Inc()
+}
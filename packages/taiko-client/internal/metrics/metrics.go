@@ -25,10 +25,47 @@ var (
 	DriverL2HeadIDGauge         = factory.NewGauge(prometheus.GaugeOpts{Name: "driver_l2Head_id"})
 	DriverL2VerifiedHeightGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "driver_l2Verified_id"})
 
+	// Driver preconfirmation blocks
+	DriverPreconfInsertedCounter  = factory.NewCounter(prometheus.CounterOpts{Name: "driver_preconfBlocks_inserted"})
+	DriverPreconfConfirmedCounter = factory.NewCounter(prometheus.CounterOpts{Name: "driver_preconfBlocks_confirmed"})
+	DriverPreconfReorgedCounter   = factory.NewCounter(prometheus.CounterOpts{Name: "driver_preconfBlocks_reorged"})
+
+	// Driver blob sidecars
+	DriverBlobSidecarsVerifiedCounter = factory.NewCounter(prometheus.CounterOpts{Name: "driver_blobSidecars_verified"})
+	DriverBlobSidecarsTamperedCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "driver_blobSidecars_tampered",
+	}, []string{"source", "reason"})
+	DriverBlobSourceLatencyHistogram = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "driver_blobSource_latency_seconds",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"source"})
+	DriverBlobSourceFailedCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "driver_blobSource_failed",
+	}, []string{"source"})
+	DriverBlobCacheHitCounter  = factory.NewCounter(prometheus.CounterOpts{Name: "driver_blobCache_hit"})
+	DriverBlobCacheMissCounter = factory.NewCounter(prometheus.CounterOpts{Name: "driver_blobCache_miss"})
+
+	// Driver L1 reorgs
+	DriverL1ReorgDetectedCounter = factory.NewCounter(prometheus.CounterOpts{Name: "driver_l1Reorg_detected"})
+	DriverL1ReorgAlertCounter    = factory.NewCounter(prometheus.CounterOpts{Name: "driver_l1Reorg_alerted"})
+	DriverL1ReorgDepthHistogram  = factory.NewHistogram(prometheus.HistogramOpts{
+		Name:    "driver_l1Reorg_depth",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	})
+	DriverL1ReorgL2RolledBackHistogram = factory.NewHistogram(prometheus.HistogramOpts{
+		Name:    "driver_l1Reorg_l2RolledBack",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+	DriverL1ReorgRecoveryHistogram = factory.NewHistogram(prometheus.HistogramOpts{
+		Name:    "driver_l1Reorg_recovery_seconds",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+
 	// Proposer
 	ProposerProposeEpochCounter    = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_epoch"})
 	ProposerProposedTxListsCounter = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txLists"})
 	ProposerProposedTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txs"})
+	ProposerPreemptedCounter       = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_preempted"})
 	ProposerPoolContentFetchTime   = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_pool_content_fetch_time"})
 
 	// Prover
@@ -59,6 +96,43 @@ var (
 	ProverSubmissionRevertedCounter = factory.NewCounter(prometheus.CounterOpts{
 		Name: "prover_proof_submission_reverted",
 	})
+	ProverRaikoFailoverCounter = factory.NewCounter(prometheus.CounterOpts{
+		Name: "prover_raiko_failover",
+	})
+	ProverRaikoHealthCheckFailedCounter = factory.NewCounter(prometheus.CounterOpts{
+		Name: "prover_raiko_health_check_failed",
+	})
+	ProverProofCostRecordedCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_proof_cost_recorded",
+	}, []string{"tier"})
+	ProverProofGasUsedCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_proof_gas_used",
+	}, []string{"tier"})
+	ProverProofL1CostGweiCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_proof_l1_cost_gwei",
+	}, []string{"tier"})
+	ProverProofGenerationTimeHistogram = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "prover_proof_generation_time_seconds",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+	}, []string{"tier"})
+
+	// Verifier
+	VerifierLastComparedIDGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "verifier_lastCompared_id"})
+	VerifierLastCheckedL1Gauge  = factory.NewGauge(prometheus.GaugeOpts{Name: "verifier_lastChecked_l1_height"})
+	VerifierDivergenceCounter   = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "verifier_divergence",
+	}, []string{"kind"})
+
+	// L1 quorum
+	L1QuorumProviderErrorCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "l1Quorum_provider_errors",
+	}, []string{"method", "provider"})
+	L1QuorumDisagreementCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "l1Quorum_disagreements",
+	}, []string{"method", "provider"})
+	L1QuorumNotReachedCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "l1Quorum_not_reached",
+	}, []string{"method"})
 
 	// TxManager
 	TxMgrMetrics = txmgrMetrics.MakeTxMetrics("client", factory)
@@ -330,3 +330,39 @@ func SendDynamicFeeTx(
 	}
 	return tx, nil
 }
+
+// AssembleAndSendTestTx assembles a legacy transaction with the given nonce and sends it, used for tests.
+func AssembleAndSendTestTx(
+	client *rpc.EthClient,
+	priv *ecdsa.PrivateKey,
+	nonce uint64,
+	to *common.Address,
+	value *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	auth, err := bind.NewKeyedTransactorWithChainID(priv, client.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := auth.Signer(auth.From, types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      2100_000,
+		To:       to,
+		Value:    value,
+		Data:     data,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err = client.SendTransaction(context.Background(), tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
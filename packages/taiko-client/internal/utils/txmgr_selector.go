@@ -14,8 +14,8 @@ var (
 // it will choose the transaction manager for a private mempool if it is available and works well,
 // otherwise it will choose the normal transaction manager.
 type TxMgrSelector struct {
-	txMgr                     *txmgr.SimpleTxManager
-	privateTxMgr              *txmgr.SimpleTxManager
+	txMgr                     txmgr.TxManager
+	privateTxMgr              txmgr.TxManager
 	privateTxMgrFailedAt      *time.Time
 	privateTxMgrRetryInterval time.Duration
 }
@@ -25,6 +25,21 @@ func NewTxMgrSelector(
 	txMgr *txmgr.SimpleTxManager,
 	privateTxMgr *txmgr.SimpleTxManager,
 	privateTxMgrRetryInterval *time.Duration,
+) *TxMgrSelector {
+	// Avoid wrapping a nil private transaction manager pointer into a non-nil interface value.
+	if privateTxMgr == nil {
+		return NewTxManagerSelector(txMgr, nil, privateTxMgrRetryInterval)
+	}
+
+	return NewTxManagerSelector(txMgr, privateTxMgr, privateTxMgrRetryInterval)
+}
+
+// NewTxManagerSelector creates a new TxMgrSelector instance with the given transaction manager
+// implementations, the private transaction manager is optional.
+func NewTxManagerSelector(
+	txMgr txmgr.TxManager,
+	privateTxMgr txmgr.TxManager,
+	privateTxMgrRetryInterval *time.Duration,
 ) *TxMgrSelector {
 	retryInterval := defaultPrivateTxMgrRetryInterval
 	if privateTxMgrRetryInterval != nil {
@@ -40,7 +55,7 @@ func NewTxMgrSelector(
 }
 
 // Select selects a transaction manager based on the current state.
-func (s *TxMgrSelector) Select() (*txmgr.SimpleTxManager, bool) {
+func (s *TxMgrSelector) Select() (txmgr.TxManager, bool) {
 	// If there is no private transaction manager, return the normal transaction manager.
 	if s.privateTxMgr == nil {
 		return s.txMgr, false
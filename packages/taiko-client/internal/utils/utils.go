@@ -1,14 +1,11 @@
 package utils
 
 import (
-	"bytes"
-	"compress/zlib"
 	"crypto/rand"
 	"errors"
 	"fmt"
 	"math"
 
-	"io"
 	"math/big"
 	"os"
 	"strings"
@@ -19,6 +16,8 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/modern-go/reflect2"
 	"golang.org/x/exp/constraints"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
 )
 
 // LoadEnv loads all the test environment variables.
@@ -80,37 +79,12 @@ func Max[T constraints.Integer](a, b T) T {
 
 // Compress compresses the given txList bytes using zlib.
 func Compress(txList []byte) ([]byte, error) {
-	var b bytes.Buffer
-	w := zlib.NewWriter(&b)
-	defer w.Close()
-
-	if _, err := w.Write(txList); err != nil {
-		return nil, err
-	}
-
-	if err := w.Close(); err != nil {
-		return nil, err
-	}
-
-	return b.Bytes(), nil
+	return compression.Zlib.Compress(txList)
 }
 
 // Decompress decompresses the given txList bytes using zlib.
 func Decompress(compressedTxList []byte) ([]byte, error) {
-	r, err := zlib.NewReader(bytes.NewBuffer(compressedTxList))
-	if err != nil {
-		return nil, err
-	}
-	defer r.Close()
-
-	b, err := io.ReadAll(r)
-	if err != nil {
-		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
-			return nil, err
-		}
-	}
-
-	return b, nil
+	return compression.Zlib.Decompress(compressedTxList)
 }
 
 // GWeiToWei converts gwei value to wei value.
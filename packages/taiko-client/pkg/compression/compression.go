@@ -0,0 +1,204 @@
+package compression
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Names of the supported transactions list compression codecs.
+const (
+	ZlibName   = "zlib"
+	BrotliName = "brotli"
+	ZstdName   = "zstd"
+)
+
+// MaxDecompressedSize is the maximum size of a transactions list decompressed by the codecs, so that a small compressed input can't exhaust the memory of the clients.
+const MaxDecompressedSize = 32 * 1024 * 1024
+
+// ErrDecompressedSizeExceeded is returned when the decompressed transactions list is larger
+// than MaxDecompressedSize.
+var ErrDecompressedSizeExceeded = fmt.Errorf("decompressed size exceeds %d bytes", MaxDecompressedSize)
+
+// Codec compresses and decompresses the RLP encoded transactions lists.
+type Codec interface {
+	// Name returns the unique name of the codec.
+	Name() string
+	// Compress compresses the given bytes.
+	Compress(data []byte) ([]byte, error)
+	// Decompress decompresses the given bytes.
+	Decompress(compressed []byte) ([]byte, error)
+}
+
+var (
+	// Zlib is the zlib codec, which is the codec used by the protocol since genesis.
+	Zlib Codec = &zlibCodec{}
+	// Brotli is the brotli codec, using the best compression level.
+	Brotli Codec = &brotliCodec{}
+	// Zstd is the zstd codec, using the best compression level.
+	Zstd Codec = &zstdCodec{}
+
+	codecs = map[string]Codec{
+		ZlibName:   Zlib,
+		BrotliName: Brotli,
+		ZstdName:   Zstd,
+	}
+
+	zstdEncoder = mustNewZstdEncoder()
+	zstdDecoder = mustNewZstdDecoder()
+)
+
+// CodecByName returns the codec with the given name.
+func CodecByName(name string) (Codec, error) {
+	codec, ok := codecs[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("unknown compression codec: %s, supported codecs: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return codec, nil
+}
+
+// Names returns the sorted names of all supported codecs.
+func Names() []string {
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// zlibCodec is the Codec implementation using zlib.
+type zlibCodec struct{}
+
+// Name implements the Codec interface.
+func (c *zlibCodec) Name() string { return ZlibName }
+
+// Compress implements the Codec interface.
+func (c *zlibCodec) Compress(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	defer w.Close()
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Decompress implements the Codec interface, a truncated stream is not treated as an error,
+// to stay compatible with the existing zlib compressed transactions lists.
+func (c *zlibCodec) Decompress(compressed []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewBuffer(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// Read one more byte than the limit, to tell a list of exactly the maximum size from a larger one.
+	b, err := io.ReadAll(io.LimitReader(r, MaxDecompressedSize+1))
+	if err != nil {
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+	}
+	if len(b) > MaxDecompressedSize {
+		return nil, ErrDecompressedSizeExceeded
+	}
+
+	return b, nil
+}
+
+// brotliCodec is the Codec implementation using brotli.
+type brotliCodec struct{}
+
+// Name implements the Codec interface.
+func (c *brotliCodec) Name() string { return BrotliName }
+
+// Compress implements the Codec interface.
+func (c *brotliCodec) Compress(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := brotli.NewWriterLevel(&b, brotli.BestCompression)
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Decompress implements the Codec interface.
+func (c *brotliCodec) Decompress(compressed []byte) ([]byte, error) {
+	// Read one more byte than the limit, to tell a list of exactly the maximum size from a larger one.
+	b, err := io.ReadAll(io.LimitReader(brotli.NewReader(bytes.NewReader(compressed)), MaxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > MaxDecompressedSize {
+		return nil, ErrDecompressedSizeExceeded
+	}
+
+	return b, nil
+}
+
+// zstdCodec is the Codec implementation using zstd.
+type zstdCodec struct{}
+
+// Name implements the Codec interface.
+func (c *zstdCodec) Name() string { return ZstdName }
+
+// Compress implements the Codec interface.
+func (c *zstdCodec) Compress(data []byte) ([]byte, error) {
+	return zstdEncoder.EncodeAll(data, nil), nil
+}
+
+// Decompress implements the Codec interface.
+func (c *zstdCodec) Decompress(compressed []byte) ([]byte, error) {
+	b, err := zstdDecoder.DecodeAll(compressed, nil)
+	if err != nil {
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+			return nil, ErrDecompressedSizeExceeded
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// mustNewZstdEncoder creates the shared zstd encoder, it panics if the encoder options are invalid.
+func mustNewZstdEncoder() *zstd.Encoder {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		panic(fmt.Sprintf("failed to create zstd encoder: %v", err))
+	}
+
+	return encoder
+}
+
+// mustNewZstdDecoder creates the shared zstd decoder, which limits the decompressed size to
+// MaxDecompressedSize, it panics if the decoder options are invalid.
+func mustNewZstdDecoder() *zstd.Decoder {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxDecompressedSize))
+	if err != nil {
+		panic(fmt.Sprintf("failed to create zstd decoder: %v", err))
+	}
+
+	return decoder
+}
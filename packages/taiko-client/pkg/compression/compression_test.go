@@ -0,0 +1,233 @@
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChainID = big.NewInt(167000)
+	// ERC20 transfer(address,uint256) selector.
+	testTransferSelector = common.FromHex("0xa9059cbb")
+)
+
+func TestCodecByName(t *testing.T) {
+	for _, name := range Names() {
+		codec, err := CodecByName(name)
+		require.Nil(t, err)
+		require.Equal(t, name, codec.Name())
+	}
+
+	codec, err := CodecByName(" ZSTD ")
+	require.Nil(t, err)
+	require.Equal(t, Zstd, codec)
+
+	_, err = CodecByName("lz4")
+	require.ErrorContains(t, err, "unknown compression codec")
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	txListBytes, err := rlp.EncodeToBytes(testTxList(t, 128))
+	require.Nil(t, err)
+
+	for _, name := range Names() {
+		codec, err := CodecByName(name)
+		require.Nil(t, err)
+
+		compressed, err := codec.Compress(txListBytes)
+		require.Nil(t, err)
+		require.Less(t, len(compressed), len(txListBytes))
+
+		decompressed, err := codec.Decompress(compressed)
+		require.Nil(t, err)
+		require.Equal(t, txListBytes, decompressed)
+
+		empty, err := codec.Compress([]byte{})
+		require.Nil(t, err)
+		decompressed, err = codec.Decompress(empty)
+		require.Nil(t, err)
+		require.Empty(t, decompressed)
+	}
+}
+
+func TestCodecDecompressInvalid(t *testing.T) {
+	for _, codec := range []Codec{Zlib, Brotli, Zstd} {
+		_, err := codec.Decompress([]byte{0x01, 0x02, 0x03, 0x04})
+		require.NotNil(t, err, codec.Name())
+	}
+}
+
+func TestCodecDecompressSizeLimit(t *testing.T) {
+	// Compress with the fastest levels, since the best compression levels are slow for such sizes.
+	compressors := map[Codec]func(data []byte) []byte{
+		Zlib: func(data []byte) []byte {
+			var b bytes.Buffer
+			w, err := zlib.NewWriterLevel(&b, zlib.BestSpeed)
+			require.Nil(t, err)
+			_, err = w.Write(data)
+			require.Nil(t, err)
+			require.Nil(t, w.Close())
+			return b.Bytes()
+		},
+		Brotli: func(data []byte) []byte {
+			var b bytes.Buffer
+			w := brotli.NewWriterLevel(&b, brotli.BestSpeed)
+			_, err := w.Write(data)
+			require.Nil(t, err)
+			require.Nil(t, w.Close())
+			return b.Bytes()
+		},
+		Zstd: func(data []byte) []byte {
+			encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+			require.Nil(t, err)
+			return encoder.EncodeAll(data, nil)
+		},
+	}
+
+	for codec, compress := range compressors {
+		decompressed, err := codec.Decompress(compress(make([]byte, MaxDecompressedSize)))
+		require.Nil(t, err, codec.Name())
+		require.Len(t, decompressed, MaxDecompressedSize)
+
+		_, err = codec.Decompress(compress(make([]byte, MaxDecompressedSize+1)))
+		require.ErrorIs(t, err, ErrDecompressedSizeExceeded, codec.Name())
+	}
+}
+
+// BenchmarkCodecs compresses the benchmark transactions lists with all codecs, and reports the
+// compressed / uncompressed size ratio. The RLP encoded transactions lists are loaded from the
+// file set by TX_LISTS_FILE environment variable (one hex encoded list per line), so that the
+// codecs can be compared on the real transactions lists, otherwise some random lists are used.
+//
+// go test ./pkg/compression -run ^$ -bench BenchmarkCodecs
+func BenchmarkCodecs(b *testing.B) {
+	txLists := benchmarkTxLists(b)
+
+	var totalBytes int
+	for _, txListBytes := range txLists {
+		totalBytes += len(txListBytes)
+	}
+
+	for _, name := range Names() {
+		codec, err := CodecByName(name)
+		require.Nil(b, err)
+
+		b.Run(name, func(b *testing.B) {
+			var compressedBytes int
+			b.SetBytes(int64(totalBytes))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				compressedBytes = 0
+				for _, txListBytes := range txLists {
+					compressed, err := codec.Compress(txListBytes)
+					require.Nil(b, err)
+					compressedBytes += len(compressed)
+				}
+			}
+			b.ReportMetric(float64(compressedBytes)/float64(totalBytes), "ratio")
+			b.ReportMetric(float64(compressedBytes)/float64(len(txLists)), "compressed-bytes/list")
+		})
+	}
+}
+
+// benchmarkTxLists returns the RLP encoded transactions lists used by benchmarks.
+func benchmarkTxLists(b *testing.B) [][]byte {
+	path := os.Getenv("TX_LISTS_FILE")
+	if path == "" {
+		var txLists [][]byte
+		for _, size := range []int{16, 64, 256, 1024} {
+			txListBytes, err := rlp.EncodeToBytes(testTxList(b, size))
+			require.Nil(b, err)
+			txLists = append(txLists, txListBytes)
+		}
+		return txLists
+	}
+
+	f, err := os.Open(path)
+	require.Nil(b, err)
+	defer f.Close()
+
+	var (
+		txLists [][]byte
+		scanner = bufio.NewScanner(f)
+	)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		txListBytes := common.FromHex(line)
+
+		var txs types.Transactions
+		require.Nil(b, rlp.DecodeBytes(txListBytes, &txs), "invalid transactions list: %s", line)
+		txLists = append(txLists, txListBytes)
+	}
+	require.Nil(b, scanner.Err())
+	require.NotEmpty(b, txLists)
+
+	return txLists
+}
+
+// testTxList creates a transactions list with a mix of ether transfers and ERC20 transfers
+// sent by a few accounts, which is similar to the common L2 blocks.
+func testTxList(t testing.TB, size int) types.Transactions {
+	var (
+		keys   = make([]*ecdsa.PrivateKey, 8)
+		tokens = make([]common.Address, 4)
+		signer = types.LatestSignerForChainID(testChainID)
+		txs    = make(types.Transactions, 0, size)
+	)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.Nil(t, err)
+		keys[i] = key
+	}
+	for i := range tokens {
+		tokens[i] = common.BytesToAddress(crypto.Keccak256([]byte{byte(i)}))
+	}
+
+	for i := 0; i < size; i++ {
+		var (
+			to   = crypto.PubkeyToAddress(keys[(i+1)%len(keys)].PublicKey)
+			data []byte
+			gas  = uint64(21_000)
+		)
+		if i%2 == 1 {
+			data = append(data, testTransferSelector...)
+			data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+			data = append(data, common.LeftPadBytes(big.NewInt(int64(i+1)*1e15).Bytes(), 32)...)
+			to = tokens[i%len(tokens)]
+			gas = 65_000
+		}
+
+		tx, err := types.SignNewTx(keys[i%len(keys)], signer, &types.DynamicFeeTx{
+			ChainID:   testChainID,
+			Nonce:     uint64(i / len(keys)),
+			GasTipCap: big.NewInt(1e7),
+			GasFeeCap: big.NewInt(1e9),
+			Gas:       gas,
+			To:        &to,
+			Value:     big.NewInt(int64(i) * 1e12),
+			Data:      data,
+		})
+		require.Nil(t, err)
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
@@ -3,10 +3,13 @@ package config
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -16,11 +19,36 @@ import (
 type ChainConfig struct {
 	// Ontake switch block (nil = no fork, 0 = already on ontake)
 	ProtocolConfigs *bindings.TaikoDataConfig `json:"protocolConfigs"`
+	// Transactions list compression codec switch blocks, sorted by height
+	TxListCodecForks []*TxListCodecFork `json:"txListCodecForks"`
 }
 
-// NewChainConfig creates a new ChainConfig instance.
-func NewChainConfig(protocolConfigs *bindings.TaikoDataConfig) *ChainConfig {
-	cfg := &ChainConfig{protocolConfigs}
+// TxListCodecFork is a block based fork which switches the compression codec of the
+// transactions lists.
+type TxListCodecFork struct {
+	Height uint64 `json:"height"`
+	Codec  string `json:"codec"`
+
+	codec compression.Codec
+}
+
+// NewChainConfig creates a new ChainConfig instance, it returns an error if any of the given
+// transactions list codec forks uses an unknown codec.
+func NewChainConfig(
+	protocolConfigs *bindings.TaikoDataConfig,
+	txListCodecForks ...*TxListCodecFork,
+) (*ChainConfig, error) {
+	forks := make([]*TxListCodecFork, 0, len(txListCodecForks))
+	for _, fork := range txListCodecForks {
+		codec, err := compression.CodecByName(fork.Codec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transactions list codec fork at block %d: %w", fork.Height, err)
+		}
+		forks = append(forks, &TxListCodecFork{Height: fork.Height, Codec: codec.Name(), codec: codec})
+	}
+	sort.SliceStable(forks, func(i, j int) bool { return forks[i].Height < forks[j].Height })
+
+	cfg := &ChainConfig{ProtocolConfigs: protocolConfigs, TxListCodecForks: forks}
 
 	log.Info("")
 	log.Info(strings.Repeat("-", 153))
@@ -30,7 +58,7 @@ func NewChainConfig(protocolConfigs *bindings.TaikoDataConfig) *ChainConfig {
 	log.Info(strings.Repeat("-", 153))
 	log.Info("")
 
-	return cfg
+	return cfg, nil
 }
 
 // NetworkNames are user friendly names to use in the chain spec banner.
@@ -57,6 +85,9 @@ func (c *ChainConfig) Description() string {
 	// Create a list of forks with a short description of them.
 	banner += "Hard forks (block based):\n"
 	banner += fmt.Sprintf(" - Ontake:                   #%-8v\n", c.ProtocolConfigs.OntakeForkHeight)
+	for _, fork := range c.TxListCodecForks {
+		banner += fmt.Sprintf(" - TxList codec (%-6s):     #%-8v\n", fork.Codec, fork.Height)
+	}
 	banner += "\n"
 
 	return banner
@@ -67,6 +98,49 @@ func (c *ChainConfig) IsOntake(num *big.Int) bool {
 	return isBlockForked(new(big.Int).SetUint64(c.ProtocolConfigs.OntakeForkHeight), num)
 }
 
+// TxListCodec returns the compression codec of the transactions list of the given block, the
+// codec forks only take effect after the ontake fork, and zlib is used before any codec fork.
+func (c *ChainConfig) TxListCodec(num *big.Int) compression.Codec {
+	if c == nil || !c.IsOntake(num) {
+		return compression.Zlib
+	}
+
+	codec := compression.Zlib
+	for _, fork := range c.TxListCodecForks {
+		if !isBlockForked(new(big.Int).SetUint64(fork.Height), num) {
+			break
+		}
+		codec = fork.codec
+	}
+
+	return codec
+}
+
+// ParseTxListCodecForks parses the given `<codec>@<height>` values into transactions list
+// compression codec forks.
+func ParseTxListCodecForks(values []string) ([]*TxListCodecFork, error) {
+	var forks []*TxListCodecFork
+	for _, value := range values {
+		parts := strings.Split(value, "@")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid transactions list codec fork: %s, expected <codec>@<height>", value)
+		}
+
+		codec, err := compression.CodecByName(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		height, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transactions list codec fork height: %s: %w", value, err)
+		}
+
+		forks = append(forks, &TxListCodecFork{Height: height, Codec: codec.Name()})
+	}
+
+	return forks, nil
+}
+
 // isBlockForked returns whether a fork scheduled at block s is active at the
 // given head block.
 func isBlockForked(s, head *big.Int) bool {
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
+)
+
+func TestTxListCodec(t *testing.T) {
+	forks, err := ParseTxListCodecForks([]string{"zstd@300", "brotli@200"})
+	require.Nil(t, err)
+
+	cfg, err := NewChainConfig(&bindings.TaikoDataConfig{OntakeForkHeight: 100}, forks...)
+	require.Nil(t, err)
+	require.Equal(t, uint64(200), cfg.TxListCodecForks[0].Height)
+
+	require.Equal(t, compression.Zlib, cfg.TxListCodec(big.NewInt(99)))
+	require.Equal(t, compression.Zlib, cfg.TxListCodec(big.NewInt(199)))
+	require.Equal(t, compression.Brotli, cfg.TxListCodec(big.NewInt(200)))
+	require.Equal(t, compression.Zstd, cfg.TxListCodec(big.NewInt(300)))
+	require.Equal(t, compression.Zstd, cfg.TxListCodec(big.NewInt(1000)))
+
+	// Codec forks scheduled before ontake fork only take effect after ontake fork.
+	cfg, err = NewChainConfig(&bindings.TaikoDataConfig{OntakeForkHeight: 100}, &TxListCodecFork{Codec: "zstd"})
+	require.Nil(t, err)
+	require.Equal(t, compression.Zlib, cfg.TxListCodec(big.NewInt(99)))
+	require.Equal(t, compression.Zstd, cfg.TxListCodec(big.NewInt(100)))
+
+	// Unknown codecs are rejected when loading the config.
+	_, err = NewChainConfig(&bindings.TaikoDataConfig{}, &TxListCodecFork{Height: 10, Codec: "lz4"})
+	require.ErrorContains(t, err, "unknown compression codec")
+
+	var nilCfg *ChainConfig
+	require.Equal(t, compression.Zlib, nilCfg.TxListCodec(big.NewInt(100)))
+}
+
+func TestParseTxListCodecForks(t *testing.T) {
+	forks, err := ParseTxListCodecForks(nil)
+	require.Nil(t, err)
+	require.Empty(t, forks)
+
+	forks, err = ParseTxListCodecForks([]string{"ZSTD@ 10"})
+	require.Nil(t, err)
+	require.Equal(t, []*TxListCodecFork{{Height: 10, Codec: compression.ZstdName}}, forks)
+
+	_, err = ParseTxListCodecForks([]string{"zstd"})
+	require.ErrorContains(t, err, "expected <codec>@<height>")
+	_, err = ParseTxListCodecForks([]string{"lz4@10"})
+	require.ErrorContains(t, err, "unknown compression codec")
+	_, err = ParseTxListCodecForks([]string{"zstd@-1"})
+	require.ErrorContains(t, err, "invalid transactions list codec fork height")
+}
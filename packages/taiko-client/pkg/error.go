@@ -9,4 +9,12 @@ var (
 	ErrBlobUnused      = errors.New("blob is not used")
 	ErrSidecarNotFound = errors.New("sidecar not found")
 	ErrBeaconNotFound  = errors.New("beacon client not found")
+	ErrNoBlobSource    = errors.New("no blob source available")
+)
+
+// Errors of the blob sidecars whose blob data doesn't match the KZG commitment.
+var (
+	ErrSidecarMalformed          = errors.New("malformed blob sidecar")
+	ErrSidecarCommitmentMismatch = errors.New("blob sidecar commitment mismatch")
+	ErrSidecarInvalidProof       = errors.New("invalid blob sidecar KZG proof")
 )
@@ -2,6 +2,7 @@ package flags
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -9,6 +10,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // InitTxmgrConfigsFromCli initializes the transaction manager configs from the command line flags.
@@ -29,3 +31,22 @@ func InitTxmgrConfigsFromCli(l1Endpoint string, privateKey *ecdsa.PrivateKey, c
 		TxNotInMempoolTimeout:     c.Duration(flags.TxNotInMempoolTimeout.Name),
 	}
 }
+
+// InitRemoteSignerConfigFromCli initializes the remote signer configs from command line flags,
+// returns nil if no remote signer endpoint is set.
+func InitRemoteSignerConfigFromCli(c *cli.Context) (*signer.RemoteSignerConfig, error) {
+	if !c.IsSet(flags.RemoteSignerEndpoint.Name) {
+		return nil, nil
+	}
+
+	address := c.String(flags.RemoteSignerAddress.Name)
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid remote signer address: %s", address)
+	}
+
+	return &signer.RemoteSignerConfig{
+		Endpoint: c.String(flags.RemoteSignerEndpoint.Name),
+		Address:  common.HexToAddress(address),
+		Timeout:  c.Duration(flags.RPCTimeout.Name),
+	}, nil
+}
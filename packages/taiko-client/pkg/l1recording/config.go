@@ -0,0 +1,31 @@
+package l1recording
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+)
+
+// Config contains the configurations to initialize a L1 recorder or replayer.
+type Config struct {
+	File string
+	Addr string
+	// Upstream L1 nodes, only used by the recorder.
+	L1Endpoint       string
+	L1BeaconEndpoint string
+	Timeout          time.Duration
+}
+
+// NewConfigFromCliContext creates a new config instance from
+// the command line inputs.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	return &Config{
+		File:             c.String(flags.L1RecordingFile.Name),
+		Addr:             c.String(flags.L1RecordingAddr.Name),
+		L1Endpoint:       c.String(flags.L1WSEndpoint.Name),
+		L1BeaconEndpoint: c.String(flags.L1BeaconEndpoint.Name),
+		Timeout:          c.Duration(flags.RPCTimeout.Name),
+	}, nil
+}
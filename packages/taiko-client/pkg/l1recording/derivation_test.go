@@ -0,0 +1,405 @@
+package l1recording
+
+import (
+	"context"
+	"crypto/sha256"
+	"flag"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethEth "github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/catalyst"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// derivationFixture is a L1 recording in which two L2 blocks are proposed on top of the Hekla L2
+// genesis, the first one with calldata and the second one with a blob.
+const derivationFixture = "testdata/derivation.json"
+
+var (
+	updateFixtures = flag.Bool("update", false, "regenerate the L1 recordings in testdata")
+
+	derivationL1ChainID = big.NewInt(17000)
+	derivationTaikoL1   = common.HexToAddress("0x79C9109b764609df928d16fC4a91e9081F7e87DB")
+	derivationTaikoL2   = common.HexToAddress("0x1670090000000000000000000000000000010001")
+	derivationRecipient = common.HexToAddress("0x0000000000000000000000000000000000c0ffee")
+	derivationValue     = big.NewInt(params.Ether)
+	// Well-known devnet accounts, the sender is funded in derivationGenesis.
+	derivationProposerKey, _ = crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	derivationSenderKey, _   = crypto.HexToECDSA("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+)
+
+func TestDerivation(t *testing.T) {
+	if *updateFixtures {
+		require.Nil(t, newDerivationRecording(t).Save(derivationFixture))
+	}
+
+	recording, err := LoadRecording(derivationFixture)
+	require.Nil(t, err)
+
+	handler, err := NewReplayHandler(recording)
+	require.Nil(t, err)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	blobServer, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	l2WS, l2Auth, jwtSecret := newTestL2Node(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client, err := rpc.NewClient(ctx, &rpc.ClientConfig{
+		L1Endpoint:       "ws" + strings.TrimPrefix(srv.URL, "http"),
+		L2Endpoint:       l2WS,
+		L2EngineEndpoint: l2Auth,
+		JwtSecret:        jwtSecret,
+		TaikoL1Address:   derivationTaikoL1,
+		TaikoL2Address:   derivationTaikoL2,
+		Timeout:          time.Minute,
+	})
+	require.Nil(t, err)
+
+	driverState, err := state.New(ctx, client)
+	require.Nil(t, err)
+
+	syncer, err := blob.NewSyncer(
+		ctx,
+		client,
+		driverState,
+		beaconsync.NewSyncProgressTracker(client.L2, time.Hour),
+		0,
+		&rpc.BlobDataSourceConfig{BlobServerEndpoints: []*url.URL{blobServer}},
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+	require.Nil(t, syncer.ProcessL1Blocks(ctx))
+
+	// Both proposed blocks are derived, each with an anchor transaction and a transfer.
+	head, err := client.L2.BlockByNumber(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), head.NumberU64())
+
+	for _, id := range []int64{1, 2} {
+		block, err := client.L2.BlockByNumber(ctx, big.NewInt(id))
+		require.Nil(t, err)
+		require.Len(t, block.Transactions(), 2)
+
+		l1Origin, err := client.L2.L1OriginByID(ctx, big.NewInt(id))
+		require.Nil(t, err)
+		require.Equal(t, block.Hash(), l1Origin.L2BlockHash)
+		require.Equal(t, recording.Blocks[id+1].Header.Hash(), l1Origin.L1BlockHash)
+	}
+
+	balance, err := client.L2.BalanceAt(ctx, derivationRecipient, nil)
+	require.Nil(t, err)
+	require.Equal(t, new(big.Int).Mul(derivationValue, common.Big2), balance)
+}
+
+// newTestL2Node starts an in-memory Hekla L2 execution engine, and returns its WebSocket
+// endpoint, authenticated HTTP endpoint and JWT secret.
+func newTestL2Node(t *testing.T) (string, string, string) {
+	jwtPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.Nil(t, os.WriteFile(jwtPath, []byte(common.Hash{0x01}.Hex()), 0o600))
+	jwtSecret, err := jwt.ParseSecretFromFile(jwtPath)
+	require.Nil(t, err)
+
+	stack, err := node.New(&node.Config{
+		HTTPHost:    "127.0.0.1",
+		HTTPModules: []string{"eth", "taiko"},
+		WSHost:      "127.0.0.1",
+		WSModules:   []string{"eth", "taiko"},
+		AuthAddr:    "127.0.0.1",
+		JWTSecret:   jwtPath,
+	})
+	require.Nil(t, err)
+
+	cfg := ethconfig.Defaults
+	cfg.NetworkId = params.HeklaNetworkID.Uint64()
+	cfg.Genesis = derivationGenesis()
+	cfg.SyncMode = ethconfig.FullSync
+
+	backend, err := gethEth.New(stack, &cfg)
+	require.Nil(t, err)
+	require.Nil(t, catalyst.Register(stack, backend))
+	stack.RegisterAPIs([]gethRPC.API{
+		{Namespace: "eth", Service: filters.NewFilterAPI(filters.NewFilterSystem(backend.APIBackend, filters.Config{}))},
+		{Namespace: "taiko", Service: gethEth.NewTaikoAPIBackend(backend), Public: true},
+		{Namespace: "taikoAuth", Service: gethEth.NewTaikoAuthAPIBackend(backend), Authenticated: true},
+	})
+
+	require.Nil(t, stack.Start())
+	t.Cleanup(func() { _ = stack.Close() })
+
+	return stack.WSEndpoint(), stack.HTTPAuthEndpoint(), string(jwtSecret)
+}
+
+// derivationGenesis returns the Hekla L2 genesis, whose TaikoL2 still serves the legacy anchor, with the
+// transfers sender funded.
+func derivationGenesis() *core.Genesis {
+	genesis := core.TaikoGenesisBlock(params.HeklaNetworkID.Uint64())
+	genesis.Alloc[crypto.PubkeyToAddress(derivationSenderKey.PublicKey)] = types.Account{
+		Balance: new(big.Int).Mul(derivationValue, common.Big3),
+	}
+
+	return genesis
+}
+
+// newDerivationRecording creates the L1 recording of the derivation fixture, with the same data a
+// recorder captures from a devnet L1 node when a driver derives the two proposed L2 blocks.
+func newDerivationRecording(t *testing.T) *Recording {
+	var (
+		l2ChainID = params.HeklaNetworkID
+		l2Genesis = derivationGenesis().ToBlock()
+		l1Signer  = types.LatestSignerForChainID(derivationL1ChainID)
+		proposer  = crypto.PubkeyToAddress(derivationProposerKey.PublicKey)
+		recording = &Recording{ChainID: derivationL1ChainID}
+	)
+
+	// L2 transactions lists, each with one transfer.
+	var txLists [][]byte
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx, err := types.SignNewTx(derivationSenderKey, types.LatestSignerForChainID(l2ChainID), &types.DynamicFeeTx{
+			ChainID:   l2ChainID,
+			Nonce:     nonce,
+			GasTipCap: common.Big0,
+			GasFeeCap: big.NewInt(10 * params.GWei),
+			Gas:       21_000,
+			To:        &derivationRecipient,
+			Value:     derivationValue,
+		})
+		require.Nil(t, err)
+		txListBytes, err := rlp.EncodeToBytes(types.Transactions{tx})
+		require.Nil(t, err)
+		txList, err := utils.Compress(txListBytes)
+		require.Nil(t, err)
+		txLists = append(txLists, txList)
+	}
+
+	// The second transactions list is proposed in a blob.
+	var sidecarBlob eth.Blob
+	require.Nil(t, sidecarBlob.FromData(txLists[1]))
+	commitment, err := kzg4844.BlobToCommitment(sidecarBlob.KZGBlob())
+	require.Nil(t, err)
+	blobHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
+	recording.Sidecars = []*RecordedSidecar{{
+		BlobHash:      blobHash,
+		KzgCommitment: hexutil.Encode(commitment[:]),
+		Blob:          hexutil.Encode(sidecarBlob[:]),
+	}}
+
+	// The protocol is deployed in L1 block 0, and the L2 blocks 1 and 2 are proposed in L1 blocks 2 and 3,
+	// each anchored to its parent L1 block.
+	for i := uint64(0); i < 4; i++ {
+		header := &types.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        1_700_000_000 + i*12,
+			Root:        crypto.Keccak256Hash([]byte("root"), []byte{byte(i)}),
+			Difficulty:  common.Big0,
+			GasLimit:    30_000_000,
+			BaseFee:     big.NewInt(params.GWei),
+			UncleHash:   types.EmptyUncleHash,
+			TxHash:      types.EmptyTxsHash,
+			ReceiptHash: types.EmptyReceiptsHash,
+		}
+		if i > 0 {
+			header.ParentHash = recording.Blocks[i-1].Header.Hash()
+		}
+		block := &RecordedBlock{Header: header, Transactions: types.Transactions{}}
+		recording.Blocks = append(recording.Blocks, block)
+
+		var l *types.Log
+		switch i {
+		case 0:
+			l = newEventLog(
+				t,
+				"BlockVerified",
+				[]common.Hash{uint64Topic(0), common.BytesToHash(common.Address{}.Bytes())},
+				l2Genesis.Hash(),
+				l2Genesis.Root(),
+				uint16(0),
+			)
+		case 2, 3:
+			var (
+				blockID = i - 1
+				anchor  = recording.Blocks[i-1].Header
+				meta    = bindings.TaikoDataBlockMetadata{
+					L1Hash:     anchor.Hash(),
+					Difficulty: crypto.Keccak256Hash([]byte("difficulty"), []byte{byte(i)}),
+					Coinbase:   proposer,
+					Id:         blockID,
+					GasLimit:   encoding.GetProtocolConfig(l2ChainID.Uint64()).BlockMaxGasLimit,
+					Timestamp:  header.Time,
+					L1Height:   anchor.Number.Uint64(),
+					Sender:     proposer,
+				}
+			)
+			if blobUsed := blockID == 2; blobUsed {
+				meta.BlobUsed, meta.BlobHash = true, blobHash
+			}
+			block.Transactions = append(
+				block.Transactions,
+				newProposeTx(t, l1Signer, blockID-1, txLists[blockID-1], meta.BlobHash),
+			)
+			header.TxHash = types.DeriveSha(block.Transactions, trie.NewStackTrie(nil))
+			l = newEventLog(
+				t,
+				"BlockProposed",
+				[]common.Hash{uint64Topic(blockID), common.BytesToHash(proposer.Bytes())},
+				big.NewInt(0),
+				meta,
+				[]bindings.TaikoDataEthDeposit{},
+			)
+			l.TxHash = block.Transactions[0].Hash()
+		default:
+			continue
+		}
+		l.BlockNumber, l.BlockHash = i, header.Hash()
+		recording.Logs = append(recording.Logs, *l)
+	}
+
+	// Protocol states after the two L2 blocks are proposed, no block has been verified after the genesis.
+	genesisTime := recording.Blocks[0].Header.Time
+	recording.Calls = []*RecordedCall{
+		newTaikoL1Call(
+			t,
+			"getStateVariables",
+			nil,
+			bindings.TaikoDataSlotA{GenesisTimestamp: genesisTime},
+			bindings.TaikoDataSlotB{NumBlocks: 3},
+		),
+		newTaikoL1Call(
+			t,
+			"getBlock",
+			[]interface{}{uint64(0)},
+			bindings.TaikoDataBlock{
+				LivenessBond:         common.Big0,
+				ProposedAt:           genesisTime,
+				NextTransitionId:     2,
+				VerifiedTransitionId: 1,
+			},
+		),
+		newTaikoL1Call(
+			t,
+			"getTransition",
+			[]interface{}{uint64(0), uint32(1)},
+			bindings.TaikoDataTransitionState{
+				BlockHash:    l2Genesis.Hash(),
+				StateRoot:    l2Genesis.Root(),
+				ValidityBond: common.Big0,
+				ContestBond:  common.Big0,
+				Timestamp:    genesisTime,
+			},
+		),
+		newTaikoL1Call(
+			t,
+			"getLastVerifiedBlock",
+			nil,
+			uint64(0),
+			l2Genesis.Hash(),
+			l2Genesis.Root(),
+			genesisTime,
+		),
+	}
+
+	return recording
+}
+
+// newProposeTx creates a signed TaikoL1.proposeBlock transaction, which carries the given transactions
+// list in its calldata, or in the blob of the given hash if it's not empty.
+func newProposeTx(t *testing.T, signer types.Signer, nonce uint64, txList []byte, blobHash common.Hash) *types.Transaction {
+	proposer := crypto.PubkeyToAddress(derivationProposerKey.PublicKey)
+	blockParams, err := encoding.EncodeBlockParams(&encoding.BlockParams{
+		AssignedProver: proposer,
+		Coinbase:       proposer,
+		HookCalls:      []encoding.HookCall{},
+	})
+	require.Nil(t, err)
+
+	if blobHash != (common.Hash{}) {
+		input, err := encoding.TaikoL1ABI.Pack("proposeBlock", blockParams, []byte{})
+		require.Nil(t, err)
+		return types.MustSignNewTx(derivationProposerKey, signer, &types.BlobTx{
+			ChainID:    uint256.MustFromBig(signer.ChainID()),
+			Nonce:      nonce,
+			GasTipCap:  uint256.NewInt(1),
+			GasFeeCap:  uint256.NewInt(2 * params.GWei),
+			Gas:        1_000_000,
+			To:         derivationTaikoL1,
+			Data:       input,
+			BlobFeeCap: uint256.NewInt(1),
+			BlobHashes: []common.Hash{blobHash},
+		})
+	}
+
+	input, err := encoding.TaikoL1ABI.Pack("proposeBlock", blockParams, txList)
+	require.Nil(t, err)
+	return types.MustSignNewTx(derivationProposerKey, signer, &types.DynamicFeeTx{
+		ChainID:   signer.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2 * params.GWei),
+		Gas:       1_000_000,
+		To:        &derivationTaikoL1,
+		Data:      input,
+	})
+}
+
+// newEventLog creates a TaikoL1 event log with the given indexed topics and non-indexed arguments.
+func newEventLog(t *testing.T, name string, indexed []common.Hash, args ...interface{}) *types.Log {
+	event := encoding.TaikoL1ABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.Nil(t, err)
+
+	return &types.Log{
+		Address: derivationTaikoL1,
+		Topics:  append([]common.Hash{event.ID}, indexed...),
+		Data:    data,
+	}
+}
+
+// newTaikoL1Call creates a recorded TaikoL1 contract call at the latest block.
+func newTaikoL1Call(t *testing.T, method string, args []interface{}, outputs ...interface{}) *RecordedCall {
+	input, err := encoding.TaikoL1ABI.Pack(method, args...)
+	require.Nil(t, err)
+	output, err := encoding.TaikoL1ABI.Methods[method].Outputs.Pack(outputs...)
+	require.Nil(t, err)
+
+	return &RecordedCall{To: derivationTaikoL1, Input: input, Block: "latest", Output: output}
+}
+
+// uint64Topic returns the topic of an indexed uint256 event argument.
+func uint64Topic(n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(n))
+}
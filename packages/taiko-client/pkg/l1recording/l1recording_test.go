@@ -0,0 +1,221 @@
+package l1recording
+
+import (
+	"context"
+	"crypto/sha256"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+var (
+	testContract = common.HexToAddress("0x01")
+	testTopic    = common.Hash{0x01}
+)
+
+func TestReplay(t *testing.T) {
+	recording, tx := testRecording(t)
+	client, srv := newTestReplayClient(t, recording)
+
+	// Headers and blocks.
+	head, err := client.HeaderByNumber(context.Background(), nil)
+	require.Nil(t, err)
+	require.Equal(t, recording.Blocks[2].Header.Hash(), head.Hash())
+
+	header, err := client.HeaderByHash(context.Background(), recording.Blocks[1].Header.Hash())
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), header.Number.Uint64())
+
+	_, err = client.HeaderByNumber(context.Background(), big.NewInt(3))
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	block, err := client.BlockByNumber(context.Background(), common.Big2)
+	require.Nil(t, err)
+	require.Equal(t, tx.Hash(), block.Transactions()[0].Hash())
+
+	_, err = client.BlockByNumber(context.Background(), common.Big1)
+	require.ErrorContains(t, err, "not recorded")
+
+	inBlock, err := client.TransactionInBlock(context.Background(), head.Hash(), 0)
+	require.Nil(t, err)
+	require.Equal(t, tx.Hash(), inBlock.Hash())
+
+	// Logs.
+	logs, err := client.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: common.Big0,
+		Addresses: []common.Address{testContract},
+		Topics:    [][]common.Hash{{testTopic}},
+	})
+	require.Nil(t, err)
+	require.Len(t, logs, 1)
+
+	logs, err = client.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: common.Big0,
+		Topics:    [][]common.Hash{{common.Hash{0x02}}},
+	})
+	require.Nil(t, err)
+	require.Empty(t, logs)
+
+	// Calls are replayed in the recorded order.
+	for _, expected := range []byte{0x01, 0x02, 0x02} {
+		output, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &testContract}, nil)
+		require.Nil(t, err)
+		require.Equal(t, []byte{expected}, output)
+	}
+	_, err = client.CallContract(context.Background(), ethereum.CallMsg{To: &testContract, Data: []byte{0x01}}, nil)
+	require.ErrorContains(t, err, "not recorded")
+
+	// Blob sidecars.
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+	ds, err := rpc.NewBlobDataSource(
+		context.Background(),
+		&rpc.Client{L1: client},
+		&rpc.BlobDataSourceConfig{BlobServerEndpoints: []*url.URL{endpoint}},
+	)
+	require.Nil(t, err)
+
+	sidecar, err := ds.GetBlob(context.Background(), 2, head.Time, tx.BlobHashes()[0])
+	require.Nil(t, err)
+	require.Equal(t, recording.Sidecars[0].Blob, sidecar.Blob)
+}
+
+func TestRecord(t *testing.T) {
+	recording, tx := testRecording(t)
+	upstream, _ := newTestReplayClient(t, recording)
+
+	backend := newRecordBackend(upstream)
+	handler, _, err := newHandler(backend, nil)
+	require.Nil(t, err)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := rpc.NewEthClient(context.Background(), srv.URL, time.Minute)
+	require.Nil(t, err)
+
+	_, err = client.HeaderByNumber(context.Background(), common.Big1)
+	require.Nil(t, err)
+	_, err = client.TransactionInBlock(context.Background(), recording.Blocks[2].Header.Hash(), 0)
+	require.Nil(t, err)
+	_, err = client.FilterLogs(context.Background(), ethereum.FilterQuery{FromBlock: common.Big0})
+	require.Nil(t, err)
+	_, err = client.CallContract(context.Background(), ethereum.CallMsg{To: &testContract}, nil)
+	require.Nil(t, err)
+
+	// Replay the new recording.
+	path := filepath.Join(t.TempDir(), "recording.json")
+	require.Nil(t, backend.snapshot().Save(path))
+	recorded, err := LoadRecording(path)
+	require.Nil(t, err)
+	require.Len(t, recorded.Blocks, 2)
+	require.Len(t, recorded.Logs, 1)
+	require.Len(t, recorded.Calls, 1)
+
+	client, _ = newTestReplayClient(t, recorded)
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	require.Nil(t, err)
+	require.Equal(t, recording.Blocks[2].Header.Hash(), header.Hash())
+
+	inBlock, err := client.TransactionInBlock(context.Background(), header.Hash(), 0)
+	require.Nil(t, err)
+	require.Equal(t, tx.Hash(), inBlock.Hash())
+
+	output, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &testContract}, nil)
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x01}, output)
+}
+
+// newTestReplayClient creates a L1 client connected to a replayer of the given recording over WebSocket.
+func newTestReplayClient(t *testing.T, recording *Recording) (*rpc.EthClient, *httptest.Server) {
+	handler, err := NewReplayHandler(recording)
+	require.Nil(t, err)
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := rpc.NewEthClient(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http"), time.Minute)
+	require.Nil(t, err)
+	t.Cleanup(client.Close)
+
+	return client, srv
+}
+
+// testRecording creates a recording of three L1 blocks, whose last block contains a blob transaction.
+func testRecording(t *testing.T) (*Recording, *types.Transaction) {
+	var blob eth.Blob
+	require.Nil(t, blob.FromData([]byte("taiko")))
+	commitment, err := kzg4844.BlobToCommitment(blob.KZGBlob())
+	require.Nil(t, err)
+	blobHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(common.Big1), &types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		Gas:        21000,
+		GasFeeCap:  uint256.NewInt(1),
+		BlobFeeCap: uint256.NewInt(1),
+		To:         testContract,
+		BlobHashes: []common.Hash{blobHash},
+	})
+	require.Nil(t, err)
+
+	recording := &Recording{ChainID: common.Big1}
+	for i := 0; i < 3; i++ {
+		header := &types.Header{
+			Number:     big.NewInt(int64(i)),
+			Difficulty: common.Big0,
+			UncleHash:  types.EmptyUncleHash,
+			TxHash:     types.EmptyTxsHash,
+		}
+		if i > 0 {
+			header.ParentHash = recording.Blocks[i-1].Header.Hash()
+		}
+		block := &RecordedBlock{Header: header}
+		// The transactions of block 1 are not recorded.
+		if i == 1 {
+			header.TxHash = common.Hash{0x01}
+		}
+		if i == 2 {
+			block.Transactions = types.Transactions{tx}
+			header.TxHash = types.DeriveSha(block.Transactions, trie.NewStackTrie(nil))
+		}
+		recording.Blocks = append(recording.Blocks, block)
+	}
+
+	recording.Logs = []types.Log{{
+		Address:     testContract,
+		Topics:      []common.Hash{testTopic},
+		BlockNumber: 2,
+		BlockHash:   recording.Blocks[2].Header.Hash(),
+		TxHash:      tx.Hash(),
+	}}
+	recording.Calls = []*RecordedCall{
+		{To: testContract, Input: []byte{}, Block: "latest", Output: []byte{0x01}},
+		{To: testContract, Input: []byte{}, Block: "latest", Output: []byte{0x02}},
+	}
+	recording.Sidecars = []*RecordedSidecar{{
+		BlobHash:      blobHash,
+		KzgCommitment: hexutil.Encode(commitment[:]),
+		Blob:          hexutil.Encode(blob[:]),
+	}}
+
+	return recording, tx
+}
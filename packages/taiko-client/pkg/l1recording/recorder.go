@@ -0,0 +1,377 @@
+package l1recording
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+const (
+	// beaconRoute is the route of the L1 beacon API, which is proxied to the upstream beacon node.
+	beaconRoute = "/eth/"
+	// blobSidecarsPath is the path prefix of the beacon API blob sidecars requests.
+	blobSidecarsPath = "/eth/v1/beacon/blob_sidecars/"
+)
+
+// recordBackend is the l1Backend implementation which serves the data of an upstream L1 node,
+// and records all served data.
+type recordBackend struct {
+	l1        *rpc.EthClient
+	recording *Recording
+
+	// Indices of the recorded data, to avoid duplicates.
+	blockIndices  map[common.Hash]int
+	logKeys       map[string]struct{}
+	sidecarHashes map[common.Hash]struct{}
+	mutex         sync.Mutex
+}
+
+// newRecordBackend creates a new recordBackend instance with the given upstream L1 node.
+func newRecordBackend(l1 *rpc.EthClient) *recordBackend {
+	return &recordBackend{
+		l1:            l1,
+		recording:     &Recording{ChainID: l1.ChainID},
+		blockIndices:  make(map[common.Hash]int),
+		logKeys:       make(map[string]struct{}),
+		sidecarHashes: make(map[common.Hash]struct{}),
+	}
+}
+
+// chainID implements the l1Backend interface.
+func (b *recordBackend) chainID() *big.Int {
+	return b.l1.ChainID
+}
+
+// blockNumber implements the l1Backend interface.
+func (b *recordBackend) blockNumber(ctx context.Context) (uint64, error) {
+	return b.l1.BlockNumber(ctx)
+}
+
+// header implements the l1Backend interface.
+func (b *recordBackend) header(ctx context.Context, selector gethRPC.BlockNumberOrHash) (*types.Header, error) {
+	var (
+		header *types.Header
+		err    error
+	)
+	if hash, ok := selector.Hash(); ok {
+		header, err = b.l1.HeaderByHash(ctx, hash)
+	} else {
+		header, err = b.l1.HeaderByNumber(ctx, toBlockNumber(selector))
+	}
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	b.recordBlock(header, nil)
+
+	return header, nil
+}
+
+// block implements the l1Backend interface.
+func (b *recordBackend) block(ctx context.Context, selector gethRPC.BlockNumberOrHash) (*types.Block, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+	if hash, ok := selector.Hash(); ok {
+		block, err = b.l1.BlockByHash(ctx, hash)
+	} else {
+		block, err = b.l1.BlockByNumber(ctx, toBlockNumber(selector))
+	}
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Record an empty list instead of nil, so that the transactions are known to be recorded.
+	txs := block.Transactions()
+	if txs == nil {
+		txs = types.Transactions{}
+	}
+	b.recordBlock(block.Header(), txs)
+
+	return block, nil
+}
+
+// logs implements the l1Backend interface.
+func (b *recordBackend) logs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := b.l1.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, l := range logs {
+		key := fmt.Sprintf("%s:%d", l.BlockHash.Hex(), l.Index)
+		if _, ok := b.logKeys[key]; ok {
+			continue
+		}
+		b.logKeys[key] = struct{}{}
+		b.recording.Logs = append(b.recording.Logs, l)
+	}
+
+	return logs, nil
+}
+
+// call implements the l1Backend interface, the reverted calls are also recorded.
+func (b *recordBackend) call(
+	ctx context.Context,
+	to common.Address,
+	input []byte,
+	selector gethRPC.BlockNumberOrHash,
+) ([]byte, error) {
+	var (
+		msg    = ethereum.CallMsg{To: &to, Data: input}
+		output []byte
+		err    error
+	)
+	if hash, ok := selector.Hash(); ok {
+		output, err = b.l1.CallContractAtHash(ctx, msg, hash)
+	} else {
+		output, err = b.l1.CallContract(ctx, msg, toBlockNumber(selector))
+	}
+
+	call := &RecordedCall{To: to, Input: input, Block: selector.String(), Output: output}
+	if err != nil {
+		// Only record the errors returned by the L1 node, e.g. the reverted calls.
+		var rpcErr gethRPC.Error
+		if !errors.As(err, &rpcErr) {
+			return nil, err
+		}
+		call.Error = err.Error()
+	}
+
+	b.mutex.Lock()
+	b.recording.Calls = append(b.recording.Calls, call)
+	b.mutex.Unlock()
+
+	return output, err
+}
+
+// subscribeNewHead implements the l1Backend interface.
+func (b *recordBackend) subscribeNewHead(
+	ctx context.Context,
+	ch chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	return b.l1.SubscribeNewHead(ctx, ch)
+}
+
+// recordBlock records the given block, the transactions are kept if the block has
+// been recorded with them.
+func (b *recordBackend) recordBlock(header *types.Header, txs types.Transactions) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if index, ok := b.blockIndices[header.Hash()]; ok {
+		if txs != nil {
+			b.recording.Blocks[index].Transactions = txs
+		}
+		return
+	}
+
+	b.blockIndices[header.Hash()] = len(b.recording.Blocks)
+	b.recording.Blocks = append(b.recording.Blocks, &RecordedBlock{Header: header, Transactions: txs})
+}
+
+// recordSidecars records the blob sidecars in the given beacon API response body.
+func (b *recordBackend) recordSidecars(body []byte) error {
+	var response *structs.SidecarsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, sidecar := range response.Data {
+		var (
+			commitment kzg4844.Commitment
+			raw        = common.FromHex(sidecar.KzgCommitment)
+		)
+		if len(raw) != len(commitment) {
+			return fmt.Errorf("invalid KZG commitment length: %d", len(raw))
+		}
+		copy(commitment[:], raw)
+
+		blobHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
+		if _, ok := b.sidecarHashes[blobHash]; ok {
+			continue
+		}
+		b.sidecarHashes[blobHash] = struct{}{}
+		b.recording.Sidecars = append(b.recording.Sidecars, &RecordedSidecar{
+			BlobHash:      blobHash,
+			KzgCommitment: sidecar.KzgCommitment,
+			Blob:          sidecar.Blob,
+		})
+	}
+
+	return nil
+}
+
+// snapshot returns a copy of the current recording.
+func (b *recordBackend) snapshot() *Recording {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return &Recording{
+		ChainID:  b.recording.ChainID,
+		Blocks:   append([]*RecordedBlock{}, b.recording.Blocks...),
+		Logs:     append([]types.Log{}, b.recording.Logs...),
+		Calls:    append([]*RecordedCall{}, b.recording.Calls...),
+		Sidecars: append([]*RecordedSidecar{}, b.recording.Sidecars...),
+	}
+}
+
+// newBeaconProxy creates a reverse proxy to the given upstream beacon node, which records the
+// blob sidecars in the responses.
+func (b *recordBackend) newBeaconProxy(upstream *url.URL) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK || !strings.Contains(resp.Request.URL.Path, blobSidecarsPath) {
+			return nil
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := b.recordSidecars(body); err != nil {
+			log.Warn("Failed to record blob sidecars", "path", resp.Request.URL.Path, "error", err)
+		}
+
+		return nil
+	}
+
+	return proxy
+}
+
+// toBlockNumber converts the given block selector to the block number argument of the L1 client.
+func toBlockNumber(selector gethRPC.BlockNumberOrHash) *big.Int {
+	number, ok := selector.Number()
+	if !ok || number == gethRPC.LatestBlockNumber {
+		return nil
+	}
+
+	return big.NewInt(number.Int64())
+}
+
+// Recorder serves a L1 node and a L1 beacon node to a driver as a proxy, and records all the L1
+// data served, so that the L2 blocks derivation can be replayed offline by a Replayer.
+type Recorder struct {
+	*Config
+	backend   *recordBackend
+	rpcServer *gethRPC.Server
+	server    *http.Server
+}
+
+// InitFromCli initializes the given recorder instance based on the command line flags.
+func (r *Recorder) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return r.InitFromConfig(ctx, cfg)
+}
+
+// InitFromConfig initializes the recorder instance based on the given configurations.
+func (r *Recorder) InitFromConfig(ctx context.Context, cfg *Config) error {
+	l1, err := rpc.NewEthClient(ctx, cfg.L1Endpoint, cfg.Timeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to L1 endpoint: %w", err)
+	}
+
+	r.Config = cfg
+	r.backend = newRecordBackend(l1)
+
+	routes := make(map[string]http.Handler)
+	if cfg.L1BeaconEndpoint != "" {
+		upstream, err := url.Parse(cfg.L1BeaconEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid L1 beacon endpoint: %w", err)
+		}
+		routes[beaconRoute] = r.backend.newBeaconProxy(upstream)
+	}
+
+	handler, rpcServer, err := newHandler(r.backend, routes)
+	if err != nil {
+		return err
+	}
+	r.rpcServer = rpcServer
+	r.server = &http.Server{Addr: cfg.Addr, Handler: handler, ReadHeaderTimeout: time.Minute}
+
+	return nil
+}
+
+// Start starts the recorder instance.
+func (r *Recorder) Start() error {
+	go func() {
+		if err := r.server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Crit("Failed to start L1 recorder", "error", err)
+		}
+	}()
+
+	log.Info("Recording L1 data", "address", r.Addr, "file", r.File)
+
+	return nil
+}
+
+// Close closes the recorder instance, and saves the recording.
+func (r *Recorder) Close(ctx context.Context) {
+	if err := r.server.Shutdown(ctx); err != nil {
+		log.Error("Failed to shut down L1 recorder", "error", err)
+	}
+	r.rpcServer.Stop()
+
+	recording := r.backend.snapshot()
+	if err := recording.Save(r.File); err != nil {
+		log.Error("Failed to save L1 recording", "file", r.File, "error", err)
+		return
+	}
+
+	log.Info(
+		"L1 recording saved",
+		"file", r.File,
+		"blocks", len(recording.Blocks),
+		"logs", len(recording.Logs),
+		"calls", len(recording.Calls),
+		"sidecars", len(recording.Sidecars),
+	)
+}
+
+// Name returns the application name.
+func (r *Recorder) Name() string {
+	return "l1Recorder"
+}
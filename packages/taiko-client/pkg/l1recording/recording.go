@@ -0,0 +1,80 @@
+package l1recording
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Recording is the L1 data served to a driver, which can be captured from a live L1 node and
+// replayed offline, so that the L2 blocks derivation can be reproduced deterministically.
+type Recording struct {
+	ChainID *big.Int `json:"chainId"`
+	// Blocks are in the recorded order, the last block of a height is the canonical one.
+	Blocks   []*RecordedBlock   `json:"blocks"`
+	Logs     []types.Log        `json:"logs"`
+	Calls    []*RecordedCall    `json:"calls"`
+	Sidecars []*RecordedSidecar `json:"sidecars"`
+}
+
+// RecordedBlock is a recorded L1 block, the transactions are only recorded for the blocks whose
+// transactions have been read, e.g. the blocks which contain the `BlockProposed` events.
+type RecordedBlock struct {
+	Header       *types.Header      `json:"header"`
+	Transactions types.Transactions `json:"transactions,omitempty"`
+}
+
+// RecordedCall is a recorded L1 contract call, the calls with the same arguments are replayed
+// in the recorded order.
+type RecordedCall struct {
+	To     common.Address `json:"to"`
+	Input  hexutil.Bytes  `json:"input"`
+	Block  string         `json:"block"`
+	Output hexutil.Bytes  `json:"output,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// RecordedSidecar is a recorded blob sidecar, keyed by its versioned hash.
+type RecordedSidecar struct {
+	BlobHash      common.Hash `json:"blobHash"`
+	KzgCommitment string      `json:"kzgCommitment"`
+	Blob          string      `json:"blob"`
+}
+
+// LoadRecording loads a L1 recording from the given JSON file.
+func LoadRecording(path string) (*Recording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read L1 recording: %w", err)
+	}
+
+	recording := new(Recording)
+	if err := json.Unmarshal(data, recording); err != nil {
+		return nil, fmt.Errorf("failed to decode L1 recording: %w", err)
+	}
+
+	if recording.ChainID == nil {
+		return nil, errors.New("missing chain ID in L1 recording")
+	}
+	if len(recording.Blocks) == 0 {
+		return nil, errors.New("empty L1 recording")
+	}
+
+	return recording, nil
+}
+
+// Save writes the recording to the given JSON file.
+func (r *Recording) Save(path string) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,326 @@
+package l1recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+// blobsRoute is the route of the blob server, which serves the recorded blob sidecars.
+const blobsRoute = "/blobs/"
+
+// replayBackend is the l1Backend implementation which serves the data of a recording.
+type replayBackend struct {
+	recording      *Recording
+	blocksByHash   map[common.Hash]*RecordedBlock
+	blocksByNumber map[uint64]*RecordedBlock
+	latest         *RecordedBlock
+	calls          map[string][]*RecordedCall
+	sidecars       map[common.Hash]*RecordedSidecar
+
+	// Number of the replayed calls of each key.
+	replayedCalls map[string]int
+	mutex         sync.Mutex
+}
+
+// newReplayBackend creates a new replayBackend instance with the given recording.
+func newReplayBackend(recording *Recording) *replayBackend {
+	b := &replayBackend{
+		recording:      recording,
+		blocksByHash:   make(map[common.Hash]*RecordedBlock),
+		blocksByNumber: make(map[uint64]*RecordedBlock),
+		calls:          make(map[string][]*RecordedCall),
+		sidecars:       make(map[common.Hash]*RecordedSidecar),
+		replayedCalls:  make(map[string]int),
+	}
+
+	for _, block := range recording.Blocks {
+		// Keep the transactions if the same block is recorded again without them.
+		if recorded, ok := b.blocksByHash[block.Header.Hash()]; ok && block.Transactions == nil {
+			block = recorded
+		}
+		b.blocksByHash[block.Header.Hash()] = block
+		b.blocksByNumber[block.Header.Number.Uint64()] = block
+		if b.latest == nil || block.Header.Number.Cmp(b.latest.Header.Number) >= 0 {
+			b.latest = block
+		}
+	}
+	for _, call := range recording.Calls {
+		key := callKey(call.To, call.Input, call.Block)
+		b.calls[key] = append(b.calls[key], call)
+	}
+	for _, sidecar := range recording.Sidecars {
+		b.sidecars[sidecar.BlobHash] = sidecar
+	}
+
+	return b
+}
+
+// chainID implements the l1Backend interface.
+func (b *replayBackend) chainID() *big.Int {
+	return b.recording.ChainID
+}
+
+// blockNumber implements the l1Backend interface, the latest recorded block is the chain head.
+func (b *replayBackend) blockNumber(_ context.Context) (uint64, error) {
+	return b.latest.Header.Number.Uint64(), nil
+}
+
+// header implements the l1Backend interface.
+func (b *replayBackend) header(_ context.Context, selector rpc.BlockNumberOrHash) (*types.Header, error) {
+	if block := b.find(selector); block != nil {
+		return block.Header, nil
+	}
+
+	return nil, nil
+}
+
+// block implements the l1Backend interface.
+func (b *replayBackend) block(_ context.Context, selector rpc.BlockNumberOrHash) (*types.Block, error) {
+	block := b.find(selector)
+	if block == nil {
+		return nil, nil
+	}
+	if block.Header.TxHash != types.EmptyTxsHash && len(block.Transactions) == 0 {
+		return nil, fmt.Errorf("transactions of L1 block %d not recorded", block.Header.Number)
+	}
+
+	return types.NewBlockWithHeader(block.Header).WithBody(types.Body{Transactions: block.Transactions}), nil
+}
+
+// logs implements the l1Backend interface, it returns the recorded logs which match the given query.
+func (b *replayBackend) logs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var (
+		from = b.resolve(query.FromBlock)
+		to   = b.resolve(query.ToBlock)
+		logs []types.Log
+	)
+	for _, l := range b.recording.Logs {
+		if query.BlockHash != nil {
+			if l.BlockHash != *query.BlockHash {
+				continue
+			}
+		} else if l.BlockNumber < from || l.BlockNumber > to {
+			continue
+		}
+		// Skip the logs of the reorged blocks.
+		if block, ok := b.blocksByNumber[l.BlockNumber]; ok && block.Header.Hash() != l.BlockHash {
+			continue
+		}
+		if matchLog(&l, query.Addresses, query.Topics) {
+			logs = append(logs, l)
+		}
+	}
+
+	return logs, nil
+}
+
+// call implements the l1Backend interface, the calls with the same arguments are replayed in the
+// recorded order, and the last recorded result is repeated.
+func (b *replayBackend) call(
+	_ context.Context,
+	to common.Address,
+	input []byte,
+	selector rpc.BlockNumberOrHash,
+) ([]byte, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	key := callKey(to, input, selector.String())
+	calls, ok := b.calls[key]
+	if !ok {
+		return nil, fmt.Errorf("L1 contract call not recorded: to %s, block %s, input %x", to, selector.String(), input)
+	}
+
+	call := calls[min(b.replayedCalls[key], len(calls)-1)]
+	b.replayedCalls[key]++
+	if call.Error != "" {
+		return nil, errors.New(call.Error)
+	}
+
+	return call.Output, nil
+}
+
+// subscribeNewHead implements the l1Backend interface, no new head is pushed since the
+// recording is static.
+func (b *replayBackend) subscribeNewHead(
+	_ context.Context,
+	_ chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+// ServeHTTP serves the recorded blob sidecars as a blob server, i.e. `GET /blobs/{versionedHash}`.
+func (b *replayBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sidecar, ok := b.sidecars[common.HexToHash(strings.TrimPrefix(r.URL.Path, blobsRoute))]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"versioned_hash": sidecar.BlobHash.Hex(),
+		"commitment":     sidecar.KzgCommitment,
+		"data":           sidecar.Blob,
+	})
+}
+
+// find returns the selected recorded block, or nil if it's not recorded.
+func (b *replayBackend) find(selector rpc.BlockNumberOrHash) *RecordedBlock {
+	if hash, ok := selector.Hash(); ok {
+		return b.blocksByHash[hash]
+	}
+
+	number, ok := selector.Number()
+	if !ok || number < 0 {
+		return b.latest
+	}
+
+	return b.blocksByNumber[uint64(number)]
+}
+
+// resolve returns the block number of the given filter query boundary.
+func (b *replayBackend) resolve(number *big.Int) uint64 {
+	if number == nil || number.Sign() < 0 {
+		return b.latest.Header.Number.Uint64()
+	}
+
+	return number.Uint64()
+}
+
+// matchLog checks whether the given log matches the given addresses and topics filter.
+func matchLog(l *types.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) != 0 {
+		var found bool
+		for _, address := range addresses {
+			if l.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(l.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		var found bool
+		for _, topic := range sub {
+			if l.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// callKey returns the key of a L1 contract call with the given arguments.
+func callKey(to common.Address, input []byte, block string) string {
+	return fmt.Sprintf("%s:%x:%s", to.Hex(), input, block)
+}
+
+// NewReplayHandler creates a HTTP handler which serves the given recording as a L1 node over
+// both HTTP and WebSocket, and the recorded blob sidecars as a blob server under the root path.
+func NewReplayHandler(recording *Recording) (http.Handler, error) {
+	handler, _, err := newReplayHandler(recording)
+	return handler, err
+}
+
+// newReplayHandler creates a HTTP handler which serves the given recording, and its inner
+// JSON-RPC server.
+func newReplayHandler(recording *Recording) (http.Handler, *rpc.Server, error) {
+	backend := newReplayBackend(recording)
+	return newHandler(backend, map[string]http.Handler{blobsRoute: backend})
+}
+
+// Replayer serves a L1 recording as a L1 node and a blob server, so that a driver can re-derive
+// the recorded L2 blocks offline.
+type Replayer struct {
+	*Config
+	rpcServer *rpc.Server
+	server    *http.Server
+}
+
+// InitFromCli initializes the given replayer instance based on the command line flags.
+func (r *Replayer) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return r.InitFromConfig(ctx, cfg)
+}
+
+// InitFromConfig initializes the replayer instance based on the given configurations.
+func (r *Replayer) InitFromConfig(_ context.Context, cfg *Config) error {
+	recording, err := LoadRecording(cfg.File)
+	if err != nil {
+		return err
+	}
+
+	handler, rpcServer, err := newReplayHandler(recording)
+	if err != nil {
+		return err
+	}
+
+	r.Config = cfg
+	r.rpcServer = rpcServer
+	r.server = &http.Server{Addr: cfg.Addr, Handler: handler, ReadHeaderTimeout: time.Minute}
+
+	return nil
+}
+
+// Start starts the replayer instance.
+func (r *Replayer) Start() error {
+	go func() {
+		if err := r.server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Crit("Failed to start L1 recording replayer", "error", err)
+		}
+	}()
+
+	log.Info("Replaying L1 recording", "file", r.File, "address", r.Addr)
+
+	return nil
+}
+
+// Close closes the replayer instance.
+func (r *Replayer) Close(ctx context.Context) {
+	if err := r.server.Shutdown(ctx); err != nil {
+		log.Error("Failed to shut down L1 recording replayer", "error", err)
+	}
+	r.rpcServer.Stop()
+}
+
+// Name returns the application name.
+func (r *Replayer) Name() string {
+	return "l1Replayer"
+}
@@ -0,0 +1,270 @@
+package l1recording
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// l1Backend is the source of the L1 data served by the l1Service, a block selector without
+// number and hash means the latest block.
+type l1Backend interface {
+	chainID() *big.Int
+	blockNumber(ctx context.Context) (uint64, error)
+	// header returns nil if the header is not found.
+	header(ctx context.Context, selector rpc.BlockNumberOrHash) (*types.Header, error)
+	// block returns nil if the block is not found.
+	block(ctx context.Context, selector rpc.BlockNumberOrHash) (*types.Block, error)
+	logs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	call(ctx context.Context, to common.Address, input []byte, selector rpc.BlockNumberOrHash) ([]byte, error)
+	subscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// callArgs is the part of the `eth_call` arguments which the L1 contract calls use.
+type callArgs struct {
+	To    *common.Address `json:"to"`
+	Input hexutil.Bytes   `json:"input"`
+	Data  hexutil.Bytes   `json:"data"`
+}
+
+// l1Service is the `eth` namespace JSON-RPC service, which serves the L1 methods used by the
+// L2 blocks derivation from the given backend.
+type l1Service struct {
+	backend l1Backend
+}
+
+// ChainId implements the `eth_chainId` method.
+func (s *l1Service) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(s.backend.chainID())
+}
+
+// BlockNumber implements the `eth_blockNumber` method.
+func (s *l1Service) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	number, err := s.backend.blockNumber(ctx)
+	return hexutil.Uint64(number), err
+}
+
+// GetBlockByNumber implements the `eth_getBlockByNumber` method.
+func (s *l1Service) GetBlockByNumber(
+	ctx context.Context,
+	number rpc.BlockNumber,
+	fullTx bool,
+) (map[string]interface{}, error) {
+	return s.getBlock(ctx, rpc.BlockNumberOrHashWithNumber(number), fullTx)
+}
+
+// GetBlockByHash implements the `eth_getBlockByHash` method.
+func (s *l1Service) GetBlockByHash(
+	ctx context.Context,
+	hash common.Hash,
+	fullTx bool,
+) (map[string]interface{}, error) {
+	return s.getBlock(ctx, rpc.BlockNumberOrHashWithHash(hash, false), fullTx)
+}
+
+// GetTransactionByBlockHashAndIndex implements the `eth_getTransactionByBlockHashAndIndex` method.
+func (s *l1Service) GetTransactionByBlockHashAndIndex(
+	ctx context.Context,
+	hash common.Hash,
+	index hexutil.Uint,
+) (map[string]interface{}, error) {
+	block, err := s.backend.block(ctx, rpc.BlockNumberOrHashWithHash(hash, false))
+	if err != nil || block == nil || int(index) >= len(block.Transactions()) {
+		return nil, err
+	}
+
+	return s.marshalTransaction(block, int(index))
+}
+
+// GetLogs implements the `eth_getLogs` method.
+func (s *l1Service) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := s.backend.logs(ctx, ethereum.FilterQuery(crit))
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		logs = []types.Log{}
+	}
+
+	return logs, nil
+}
+
+// Call implements the `eth_call` method.
+func (s *l1Service) Call(ctx context.Context, args callArgs, selector *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if selector == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		selector = &latest
+	}
+
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	}
+	input := args.Input
+	if input == nil {
+		input = args.Data
+	}
+
+	return s.backend.call(ctx, to, input, *selector)
+}
+
+// NewHeads implements the `newHeads` subscription.
+func (s *l1Service) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	var (
+		rpcSub  = notifier.CreateSubscription()
+		headsCh = make(chan *types.Header)
+	)
+	// The subscription context is cancelled once the method returns.
+	sub, err := s.backend.subscribeNewHead(context.Background(), headsCh)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case header := <-headsCh:
+				_ = notifier.Notify(rpcSub.ID, header)
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// Logs implements the `logs` subscription, no log is pushed since the derivation only
+// filters the logs.
+func (s *l1Service) Logs(ctx context.Context, _ filters.FilterCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	return notifier.CreateSubscription(), nil
+}
+
+// getBlock returns the JSON-RPC representation of the selected block.
+func (s *l1Service) getBlock(
+	ctx context.Context,
+	selector rpc.BlockNumberOrHash,
+	fullTx bool,
+) (map[string]interface{}, error) {
+	if !fullTx {
+		header, err := s.backend.header(ctx, selector)
+		if err != nil || header == nil {
+			return nil, err
+		}
+		return marshalHeader(header)
+	}
+
+	block, err := s.backend.block(ctx, selector)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	fields, err := marshalHeader(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]interface{}, 0, len(block.Transactions()))
+	for i := range block.Transactions() {
+		tx, err := s.marshalTransaction(block, i)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	fields["transactions"] = txs
+
+	return fields, nil
+}
+
+// marshalTransaction returns the JSON-RPC representation of the transaction with the given
+// index in the given block.
+func (s *l1Service) marshalTransaction(block *types.Block, index int) (map[string]interface{}, error) {
+	tx := block.Transactions()[index]
+
+	fields, err := toFields(tx)
+	if err != nil {
+		return nil, err
+	}
+	fields["blockHash"] = block.Hash()
+	fields["blockNumber"] = (*hexutil.Big)(block.Number())
+	fields["transactionIndex"] = hexutil.Uint64(index)
+	if from, err := types.Sender(types.LatestSignerForChainID(s.backend.chainID()), tx); err == nil {
+		fields["from"] = from
+	}
+
+	return fields, nil
+}
+
+// marshalHeader returns the JSON-RPC representation of the given header.
+func marshalHeader(header *types.Header) (map[string]interface{}, error) {
+	fields, err := toFields(header)
+	if err != nil {
+		return nil, err
+	}
+	fields["uncles"] = []common.Hash{}
+
+	return fields, nil
+}
+
+// toFields converts the given value to its JSON fields.
+func toFields(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// newHandler creates a HTTP handler which serves the L1 JSON-RPC methods of the given backend
+// over both HTTP and WebSocket, the requests of the given extra routes are served by their handlers.
+func newHandler(backend l1Backend, routes map[string]http.Handler) (http.Handler, *rpc.Server, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &l1Service{backend}); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		mux       = http.NewServeMux()
+		wsHandler = server.WebsocketHandler([]string{"*"})
+	)
+	for route, handler := range routes {
+		mux.Handle(route, handler)
+	}
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+
+	return mux, server, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -82,8 +84,9 @@ func NewBeaconClient(endpoint string, timeout time.Duration) (*BeaconClient, err
 	return &BeaconClient{cli, timeout, uint64(genesisTime), uint64(secondsPerSlot)}, nil
 }
 
-// GetBlobs returns the sidecars for a given slot.
-func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64) ([]*structs.Sidecar, error) {
+// GetBlobs returns the sidecars for a given slot, if any indices are given, only the sidecars
+// with these indices are returned.
+func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64, indices ...uint64) ([]*structs.Sidecar, error) {
 	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
@@ -91,7 +94,17 @@ func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64) ([]*structs.Si
 	if err != nil {
 		return nil, err
 	}
-	resBytes, err := c.Get(ctxWithTimeout, c.BaseURL().Path+fmt.Sprintf(sidecarsRequestURL, slot))
+
+	var opts []client.ReqOption
+	if len(indices) != 0 {
+		query := make(url.Values)
+		for _, index := range indices {
+			query.Add("indices", strconv.FormatUint(index, 10))
+		}
+		opts = append(opts, func(req *http.Request) { req.URL.RawQuery = query.Encode() })
+	}
+
+	resBytes, err := c.Get(ctxWithTimeout, c.BaseURL().Path+fmt.Sprintf(sidecarsRequestURL, slot), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +117,16 @@ func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64) ([]*structs.Si
 	return sidecars.Data, nil
 }
 
+// GenesisTime returns the L1 genesis time.
+func (c *BeaconClient) GenesisTime() uint64 {
+	return c.genesisTime
+}
+
+// SecondsPerSlot returns the L1 seconds per slot.
+func (c *BeaconClient) SecondsPerSlot() uint64 {
+	return c.secondsPerSlot
+}
+
 // timeToSlot returns the slots of the given timestamp.
 func (c *BeaconClient) timeToSlot(timestamp uint64) (uint64, error) {
 	if timestamp < c.genesisTime {
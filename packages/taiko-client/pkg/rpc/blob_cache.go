@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+)
+
+const (
+	blobCacheFileExt = ".blob"
+	// Default maximum number of the cached blobs, about 512 MiB of disk space.
+	defaultBlobCacheMaxEntries = 4096
+)
+
+// blobCache caches the verified blobs on the local disk, keyed by their versioned hashes. Each file
+// contains the KZG commitment, the KZG proof (zero if the source has no proof) and the blob data,
+// the oldest files are removed once the number of the cached blobs exceeds the limit.
+type blobCache struct {
+	dir        string
+	maxEntries int
+	mutex      sync.Mutex
+}
+
+// newBlobCache creates a new blobCache instance in the given directory.
+func newBlobCache(dir string, maxEntries uint64) (*blobCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob cache directory: %w", err)
+	}
+	if maxEntries == 0 {
+		maxEntries = defaultBlobCacheMaxEntries
+	}
+
+	return &blobCache{dir: dir, maxEntries: int(maxEntries)}, nil
+}
+
+// get returns the cached sidecar of the given versioned hash, or nil if it's not cached, the
+// cached sidecar is verified in the same way as the sidecars fetched from the blob sources.
+func (c *blobCache) get(blobHash common.Hash) (*structs.Sidecar, error) {
+	data, err := os.ReadFile(c.path(blobHash))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var (
+		commitment kzg4844.Commitment
+		proof      kzg4844.Proof
+		blob       kzg4844.Blob
+	)
+	if len(data) != len(commitment)+len(proof)+len(blob) {
+		return nil, fmt.Errorf("invalid cached blob length: %d", len(data))
+	}
+	copy(commitment[:], data[:len(commitment)])
+	copy(proof[:], data[len(commitment):len(commitment)+len(proof)])
+	if kzg4844.CalcBlobHashV1(sha256.New(), &commitment) != blobHash {
+		return nil, fmt.Errorf("cached blob commitment mismatch: %s", blobHash)
+	}
+
+	sidecar := &structs.Sidecar{
+		KzgCommitment: hexutil.Encode(commitment[:]),
+		Blob:          hexutil.Encode(data[len(commitment)+len(proof):]),
+	}
+	if proof != (kzg4844.Proof{}) {
+		sidecar.KzgProof = hexutil.Encode(proof[:])
+	}
+	if err := verifySidecar(sidecar); err != nil {
+		return nil, fmt.Errorf("invalid cached blob %s: %w", blobHash, err)
+	}
+
+	return sidecar, nil
+}
+
+// put caches the given verified sidecar.
+func (c *blobCache) put(blobHash common.Hash, sidecar *structs.Sidecar) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var proof kzg4844.Proof
+	copy(proof[:], common.FromHex(sidecar.KzgProof))
+
+	data := append(common.FromHex(sidecar.KzgCommitment), proof[:]...)
+	data = append(data, common.FromHex(sidecar.Blob)...)
+
+	// Write to a temporary file first, so that a partially written file is never read.
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path(blobHash)); err != nil {
+		return err
+	}
+
+	return c.prune()
+}
+
+// prune removes the oldest cached blobs if the number of the cached blobs exceeds the limit.
+func (c *blobCache) prune() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	var files []os.FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), blobCacheFileExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, info)
+	}
+	if len(files) <= c.maxEntries {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime().Before(files[j].ModTime()) })
+	for _, file := range files[:len(files)-c.maxEntries] {
+		if err := os.Remove(filepath.Join(c.dir, file.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// path returns the cache file path of the given versioned hash.
+func (c *blobCache) path(blobHash common.Hash) string {
+	return filepath.Join(c.dir, blobHash.Hex()+blobCacheFileExt)
+}
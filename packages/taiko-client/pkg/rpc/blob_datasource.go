@@ -2,12 +2,17 @@ package rpc
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/url"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/go-resty/resty/v2"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -15,11 +20,43 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg"
 )
 
+// BlobDataSourceConfig contains the configurations of the blob sidecar sources, the L1 beacon client
+// of the RPC client is always used as the first source if it exists.
+type BlobDataSourceConfig struct {
+	// Additional L1 beacon endpoints.
+	BeaconEndpoints     []string
+	BlobServerEndpoints []*url.URL
+	SocialScanEndpoints []*url.URL
+	// Timeout of each blob sidecar request.
+	Timeout time.Duration
+	// Directory to cache the verified blobs, empty means disabled.
+	CacheDir        string
+	CacheMaxEntries uint64
+	// Observer of the blob sidecar requests, can be nil.
+	Observer BlobSourceObserver
+}
+
+// BlobSourceObserver observes the blob sidecar requests of all sources, e.g. to record metrics.
+type BlobSourceObserver interface {
+	ObserveBlobRequest(source string, latency time.Duration, err error)
+	ObserveBlobCache(hit bool)
+}
+
+// blobSource is a source which serves the blob sidecars.
+type blobSource interface {
+	Name() string
+	GetBlobs(ctx context.Context, timestamp uint64, blobHash common.Hash, indices []uint64) ([]*structs.Sidecar, error)
+}
+
+// BlobDataSource fetches the blob sidecars from all the configured sources in parallel, the first
+// verified sidecar is returned and cached.
 type BlobDataSource struct {
-	ctx                context.Context
-	client             *Client
-	blobServerEndpoint *url.URL
-	socialScanEndpoint *url.URL
+	ctx      context.Context
+	client   *Client
+	sources  []blobSource
+	cache    *blobCache
+	timeout  time.Duration
+	observer BlobSourceObserver
 }
 
 type BlobData struct {
@@ -38,18 +75,59 @@ type BlobServerResponse struct {
 	VersionedHash string `json:"versionedHash"`
 }
 
+// blobResult is the blob sidecar request result of a source.
+type blobResult struct {
+	source  string
+	sidecar *structs.Sidecar
+	err     error
+}
+
+// NewBlobDataSource creates a new BlobDataSource instance.
 func NewBlobDataSource(
 	ctx context.Context,
 	client *Client,
-	blobServerEndpoint *url.URL,
-	socialScanEndpoint *url.URL,
-) *BlobDataSource {
-	return &BlobDataSource{
-		ctx:                ctx,
-		client:             client,
-		blobServerEndpoint: blobServerEndpoint,
-		socialScanEndpoint: socialScanEndpoint,
+	cfg *BlobDataSourceConfig,
+) (*BlobDataSource, error) {
+	if cfg == nil {
+		cfg = new(BlobDataSourceConfig)
+	}
+
+	ds := &BlobDataSource{
+		ctx:      ctx,
+		client:   client,
+		timeout:  cfg.Timeout,
+		observer: cfg.Observer,
+	}
+	if ds.timeout == 0 {
+		ds.timeout = defaultTimeout
+	}
+
+	if client.L1Beacon != nil {
+		ds.sources = append(ds.sources, &beaconBlobSource{client.L1Beacon})
+	}
+	for _, endpoint := range cfg.BeaconEndpoints {
+		beaconClient, err := NewBeaconClient(endpoint, ds.timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to L1 beacon endpoint %s: %w", endpoint, err)
+		}
+		ds.sources = append(ds.sources, &beaconBlobSource{beaconClient})
+	}
+	for _, endpoint := range cfg.BlobServerEndpoints {
+		ds.sources = append(ds.sources, &blobServerSource{endpoint: endpoint, route: "/blobs/"})
+	}
+	for _, endpoint := range cfg.SocialScanEndpoints {
+		ds.sources = append(ds.sources, &blobServerSource{endpoint: endpoint, route: "/blob/", socialScan: true})
+	}
+
+	if cfg.CacheDir != "" {
+		cache, err := newBlobCache(cfg.CacheDir, cfg.CacheMaxEntries)
+		if err != nil {
+			return nil, err
+		}
+		ds.cache = cache
 	}
+
+	return ds, nil
 }
 
 // UnmarshalJSON overwrites to parse data based on different json keys
@@ -72,58 +150,214 @@ func (p *BlobServerResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// GetBlobs get blob sidecar by meta
-func (ds *BlobDataSource) GetBlobs(
+// GetBlob returns the verified blob sidecar of the given versioned hash, which is included in the
+// L1 block with the given height and timestamp. All sources are requested in parallel, and the
+// first sidecar whose blob data matches its KZG commitment is returned.
+func (ds *BlobDataSource) GetBlob(
 	ctx context.Context,
+	l1Height uint64,
 	timestamp uint64,
 	blobHash common.Hash,
-) ([]*structs.Sidecar, error) {
-	var (
-		sidecars []*structs.Sidecar
-		err      error
-	)
-	if ds.client.L1Beacon == nil {
-		sidecars, err = nil, pkg.ErrBeaconNotFound
-	} else {
-		sidecars, err = ds.client.L1Beacon.GetBlobs(ctx, timestamp)
+) (*structs.Sidecar, error) {
+	if ds.cache != nil {
+		sidecar, err := ds.cache.get(blobHash)
+		if err != nil {
+			log.Warn("Failed to read cached blob", "blobHash", blobHash, "error", err)
+		}
+		if ds.observer != nil {
+			ds.observer.ObserveBlobCache(sidecar != nil)
+		}
+		if sidecar != nil {
+			return sidecar, nil
+		}
+	}
+
+	if len(ds.sources) == 0 {
+		return nil, pkg.ErrNoBlobSource
 	}
+
+	// Only request the needed blob from the L1 beacon nodes, if its index in the L1 block is known.
+	indices, err := ds.blobIndices(ctx, l1Height, blobHash)
 	if err != nil {
-		if !errors.Is(err, pkg.ErrBeaconNotFound) {
-			log.Info("Failed to get blobs from beacon, try to use blob server.", "error", err.Error())
+		log.Debug("Failed to get blob index, fetch all sidecars instead", "blobHash", blobHash, "error", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan *blobResult, len(ds.sources))
+	for _, source := range ds.sources {
+		go func(source blobSource) {
+			sidecar, err := ds.getBlobFromSource(ctx, source, timestamp, blobHash, indices)
+			results <- &blobResult{source: source.Name(), sidecar: sidecar, err: err}
+		}(source)
+	}
+
+	var errs []error
+	for range ds.sources {
+		result := <-results
+		if result.err != nil {
+			log.Debug("Failed to get blob from source", "source", result.source, "error", result.err)
+			errs = append(errs, fmt.Errorf("%s: %w", result.source, result.err))
+			continue
 		}
-		if ds.blobServerEndpoint == nil && ds.socialScanEndpoint == nil {
-			log.Info("No blob server endpoint set")
-			return nil, err
+
+		if ds.cache != nil {
+			if err := ds.cache.put(blobHash, result.sidecar); err != nil {
+				log.Warn("Failed to cache blob", "blobHash", blobHash, "error", err)
+			}
 		}
-		blobs, err := ds.getBlobFromServer(ctx, blobHash)
+
+		return result.sidecar, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// getBlobFromSource fetches the sidecars from the given source, and returns the verified
+// sidecar of the given versioned hash.
+func (ds *BlobDataSource) getBlobFromSource(
+	ctx context.Context,
+	source blobSource,
+	timestamp uint64,
+	blobHash common.Hash,
+	indices []uint64,
+) (*structs.Sidecar, error) {
+	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, ds.timeout)
+	defer cancel()
+
+	start := time.Now()
+	sidecar, err := func() (*structs.Sidecar, error) {
+		sidecars, err := source.GetBlobs(ctxWithTimeout, timestamp, blobHash, indices)
 		if err != nil {
 			return nil, err
 		}
-		sidecars = make([]*structs.Sidecar, len(blobs.Data))
-		for index, value := range blobs.Data {
-			sidecars[index] = &structs.Sidecar{
-				KzgCommitment: value.KzgCommitment,
-				Blob:          value.Blob,
+
+		for _, sidecar := range sidecars {
+			var commitment kzg4844.Commitment
+			copy(commitment[:], common.FromHex(sidecar.KzgCommitment))
+			if kzg4844.CalcBlobHashV1(sha256.New(), &commitment) != blobHash {
+				continue
+			}
+			// The sidecars may be served by an untrusted source, so always make sure
+			// the blob data matches the commitment before using it.
+			if err := verifySidecar(sidecar); err != nil {
+				log.Error("Tampered blob sidecar", "source", source.Name(), "blobHash", blobHash, "error", err)
+				return nil, err
 			}
+			return sidecar, nil
 		}
+
+		return nil, pkg.ErrSidecarNotFound
+	}()
+
+	// Losing sources are cancelled once a verified sidecar is found, which should not be observed as failures.
+	if ds.observer != nil && !(err != nil && ctx.Err() != nil) {
+		ds.observer.ObserveBlobRequest(source.Name(), time.Since(start), err)
 	}
-	return sidecars, nil
+
+	return sidecar, err
 }
 
-// getBlobFromServer get blob data from server path `/getBlob`.
-func (ds *BlobDataSource) getBlobFromServer(ctx context.Context, blobHash common.Hash) (*BlobDataSeq, error) {
-	var (
-		route      string
-		requestURL string
-		err        error
-	)
-	if ds.socialScanEndpoint != nil {
-		route = "/blob/" + blobHash.String()
-		requestURL, err = url.JoinPath(ds.socialScanEndpoint.String(), route)
-	} else {
-		route = "/blobs/" + blobHash.String()
-		requestURL, err = url.JoinPath(ds.blobServerEndpoint.String(), route)
+// blobIndices returns the index of the blob with the given versioned hash in the given L1 block,
+// which is only needed by the L1 beacon sources.
+func (ds *BlobDataSource) blobIndices(ctx context.Context, l1Height uint64, blobHash common.Hash) ([]uint64, error) {
+	var hasBeaconSource bool
+	for _, source := range ds.sources {
+		if _, ok := source.(*beaconBlobSource); ok {
+			hasBeaconSource = true
+			break
+		}
 	}
+	if !hasBeaconSource || ds.client.L1 == nil {
+		return nil, nil
+	}
+
+	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, ds.timeout)
+	defer cancel()
+
+	block, err := ds.client.L1.BlockByNumber(ctxWithTimeout, new(big.Int).SetUint64(l1Height))
+	if err != nil {
+		return nil, err
+	}
+
+	var index uint64
+	for _, tx := range block.Transactions() {
+		if tx.Type() != types.BlobTxType {
+			continue
+		}
+		for _, hash := range tx.BlobHashes() {
+			if hash == blobHash {
+				return []uint64{index}, nil
+			}
+			index++
+		}
+	}
+
+	return nil, fmt.Errorf("blob %s not found in L1 block %d", blobHash, l1Height)
+}
+
+// beaconBlobSource fetches the blob sidecars from a L1 beacon node.
+type beaconBlobSource struct {
+	*BeaconClient
+}
+
+// Name implements the blobSource interface.
+func (s *beaconBlobSource) Name() string {
+	return "beacon:" + s.BaseURL().Host
+}
+
+// GetBlobs implements the blobSource interface.
+func (s *beaconBlobSource) GetBlobs(
+	ctx context.Context,
+	timestamp uint64,
+	_ common.Hash,
+	indices []uint64,
+) ([]*structs.Sidecar, error) {
+	return s.BeaconClient.GetBlobs(ctx, timestamp, indices...)
+}
+
+// blobServerSource fetches the blob sidecars from a blob storage server.
+type blobServerSource struct {
+	endpoint   *url.URL
+	route      string
+	socialScan bool
+}
+
+// Name implements the blobSource interface.
+func (s *blobServerSource) Name() string {
+	if s.socialScan {
+		return "socialScan:" + s.endpoint.Host
+	}
+	return "blobServer:" + s.endpoint.Host
+}
+
+// GetBlobs implements the blobSource interface.
+func (s *blobServerSource) GetBlobs(
+	ctx context.Context,
+	_ uint64,
+	blobHash common.Hash,
+	_ []uint64,
+) ([]*structs.Sidecar, error) {
+	blobs, err := s.getBlobFromServer(ctx, blobHash)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*structs.Sidecar, len(blobs.Data))
+	for index, value := range blobs.Data {
+		sidecars[index] = &structs.Sidecar{
+			KzgCommitment: value.KzgCommitment,
+			Blob:          value.Blob,
+		}
+	}
+
+	return sidecars, nil
+}
+
+// getBlobFromServer get blob data from server path `/blobs` or `/blob`.
+func (s *blobServerSource) getBlobFromServer(ctx context.Context, blobHash common.Hash) (*BlobDataSeq, error) {
+	requestURL, err := url.JoinPath(s.endpoint.String(), s.route+blobHash.String())
 	if err != nil {
 		return nil, err
 	}
@@ -153,3 +387,53 @@ func (ds *BlobDataSource) getBlobFromServer(ctx context.Context, blobHash common
 			},
 		}}, nil
 }
+
+// verifySidecar checks that the blob data of the given sidecar matches its KZG commitment. If the
+// sidecar has a KZG proof (i.e. fetched from a L1 beacon node), the proof is verified, otherwise the
+// commitment is recomputed from the blob data.
+func verifySidecar(sidecar *structs.Sidecar) error {
+	var (
+		blob       kzg4844.Blob
+		commitment kzg4844.Commitment
+		proof      kzg4844.Proof
+	)
+
+	blobBytes := common.FromHex(sidecar.Blob)
+	if len(blobBytes) != len(blob) {
+		return fmt.Errorf("%w: invalid blob length %d", pkg.ErrSidecarMalformed, len(blobBytes))
+	}
+	commitmentBytes := common.FromHex(sidecar.KzgCommitment)
+	if len(commitmentBytes) != len(commitment) {
+		return fmt.Errorf("%w: invalid commitment length %d", pkg.ErrSidecarMalformed, len(commitmentBytes))
+	}
+	copy(blob[:], blobBytes)
+	copy(commitment[:], commitmentBytes)
+
+	if sidecar.KzgProof != "" {
+		proofBytes := common.FromHex(sidecar.KzgProof)
+		if len(proofBytes) != len(proof) {
+			return fmt.Errorf("%w: invalid proof length %d", pkg.ErrSidecarMalformed, len(proofBytes))
+		}
+		copy(proof[:], proofBytes)
+
+		if err := kzg4844.VerifyBlobProof(&blob, commitment, proof); err != nil {
+			return fmt.Errorf("%w: %w", pkg.ErrSidecarInvalidProof, err)
+		}
+		return nil
+	}
+
+	computed, err := kzg4844.BlobToCommitment(&blob)
+	if err != nil {
+		return fmt.Errorf("%w: %w", pkg.ErrSidecarMalformed, err)
+	}
+	if computed != commitment {
+		return fmt.Errorf(
+			"%w: expected %s, computed %s",
+			pkg.ErrSidecarCommitmentMismatch,
+			common.Bytes2Hex(commitment[:]),
+			common.Bytes2Hex(computed[:]),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg"
+)
+
+// testBlobSource is a blob source which serves the given sidecars after the given delay.
+type testBlobSource struct {
+	name     string
+	delay    time.Duration
+	sidecars []*structs.Sidecar
+	err      error
+}
+
+func (s *testBlobSource) Name() string { return s.name }
+
+func (s *testBlobSource) GetBlobs(
+	ctx context.Context,
+	_ uint64,
+	_ common.Hash,
+	_ []uint64,
+) ([]*structs.Sidecar, error) {
+	select {
+	case <-time.After(s.delay):
+		return s.sidecars, s.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// testBlobSourceObserver records the observed blob sidecar requests.
+type testBlobSourceObserver struct {
+	mutex     sync.Mutex
+	requests  map[string]error
+	cacheHits int
+}
+
+func (o *testBlobSourceObserver) ObserveBlobRequest(source string, _ time.Duration, err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.requests[source] = err
+}
+
+func (o *testBlobSourceObserver) ObserveBlobCache(hit bool) {
+	if hit {
+		o.cacheHits++
+	}
+}
+
+func TestVerifySidecar(t *testing.T) {
+	sidecar, _ := testSidecar(t, "taiko")
+
+	// With the KZG proof.
+	require.Nil(t, verifySidecar(sidecar))
+
+	// Without the KZG proof, e.g. fetched from a blob server.
+	require.Nil(t, verifySidecar(&structs.Sidecar{Blob: sidecar.Blob, KzgCommitment: sidecar.KzgCommitment}))
+
+	// Tampered blob data.
+	tampered, _ := testSidecar(t, "tampered")
+	require.ErrorIs(t, verifySidecar(&structs.Sidecar{
+		Blob:          tampered.Blob,
+		KzgCommitment: sidecar.KzgCommitment,
+		KzgProof:      sidecar.KzgProof,
+	}), pkg.ErrSidecarInvalidProof)
+	require.ErrorIs(t, verifySidecar(&structs.Sidecar{
+		Blob:          tampered.Blob,
+		KzgCommitment: sidecar.KzgCommitment,
+	}), pkg.ErrSidecarCommitmentMismatch)
+
+	// Malformed sidecars.
+	for _, malformed := range []*structs.Sidecar{
+		{Blob: sidecar.Blob[:100], KzgCommitment: sidecar.KzgCommitment},
+		{Blob: sidecar.Blob, KzgCommitment: sidecar.KzgCommitment[:20]},
+		{Blob: sidecar.Blob, KzgCommitment: sidecar.KzgCommitment, KzgProof: sidecar.KzgProof[:20]},
+		{Blob: hexutil.Encode(common.MaxHash[:]) + sidecar.Blob[66:], KzgCommitment: sidecar.KzgCommitment},
+	} {
+		require.ErrorIs(t, verifySidecar(malformed), pkg.ErrSidecarMalformed)
+	}
+}
+
+func TestBlobDataSourceGetBlob(t *testing.T) {
+	var (
+		sidecar, blobHash = testSidecar(t, "taiko")
+		other, _          = testSidecar(t, "other")
+		observer          = &testBlobSourceObserver{requests: make(map[string]error)}
+	)
+	ds := &BlobDataSource{
+		client:   &Client{},
+		timeout:  time.Minute,
+		observer: observer,
+		sources: []blobSource{
+			&testBlobSource{name: "failed", err: errors.New("test error")},
+			&testBlobSource{name: "unrelated", sidecars: []*structs.Sidecar{other}},
+			&testBlobSource{name: "tampered", sidecars: []*structs.Sidecar{{
+				Blob:          other.Blob,
+				KzgCommitment: sidecar.KzgCommitment,
+				KzgProof:      sidecar.KzgProof,
+			}}},
+			&testBlobSource{name: "valid", delay: 100 * time.Millisecond, sidecars: []*structs.Sidecar{other, sidecar}},
+			&testBlobSource{name: "slow", delay: time.Hour, sidecars: []*structs.Sidecar{sidecar}},
+		},
+	}
+
+	result, err := ds.GetBlob(context.Background(), 0, 0, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, sidecar, result)
+
+	require.Len(t, observer.requests, 4)
+	require.Nil(t, observer.requests["valid"])
+	require.ErrorIs(t, observer.requests["unrelated"], pkg.ErrSidecarNotFound)
+	require.ErrorIs(t, observer.requests["tampered"], pkg.ErrSidecarInvalidProof)
+	require.NotContains(t, observer.requests, "slow")
+
+	// All sources failed.
+	ds.sources = ds.sources[:3]
+	_, err = ds.GetBlob(context.Background(), 0, 0, blobHash)
+	require.ErrorIs(t, err, pkg.ErrSidecarInvalidProof)
+	require.ErrorIs(t, err, pkg.ErrSidecarNotFound)
+
+	ds.sources = nil
+	_, err = ds.GetBlob(context.Background(), 0, 0, blobHash)
+	require.ErrorIs(t, err, pkg.ErrNoBlobSource)
+}
+
+func TestBlobDataSourceCache(t *testing.T) {
+	var (
+		sidecar, blobHash = testSidecar(t, "taiko")
+		other, otherHash  = testSidecar(t, "other")
+		observer          = &testBlobSourceObserver{requests: make(map[string]error)}
+	)
+	cache, err := newBlobCache(t.TempDir(), 1)
+	require.Nil(t, err)
+
+	ds := &BlobDataSource{
+		client:   &Client{},
+		timeout:  time.Minute,
+		cache:    cache,
+		observer: observer,
+		sources:  []blobSource{&testBlobSource{name: "valid", sidecars: []*structs.Sidecar{sidecar, other}}},
+	}
+
+	result, err := ds.GetBlob(context.Background(), 0, 0, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, sidecar, result)
+	require.Zero(t, observer.cacheHits)
+
+	// Served from the cache.
+	ds.sources = nil
+	result, err = ds.GetBlob(context.Background(), 0, 0, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, sidecar, result)
+	require.Equal(t, 1, observer.cacheHits)
+
+	// The cached blob data is verified against the cached commitment and proof.
+	data, err := os.ReadFile(cache.path(blobHash))
+	require.Nil(t, err)
+	data[len(data)-1] ^= 0xff
+	require.Nil(t, os.WriteFile(cache.path(blobHash), data, 0o600))
+	_, err = cache.get(blobHash)
+	require.ErrorIs(t, err, pkg.ErrSidecarInvalidProof)
+
+	// The sidecars without KZG proof are verified by their commitments.
+	require.Nil(t, cache.put(blobHash, &structs.Sidecar{Blob: sidecar.Blob, KzgCommitment: sidecar.KzgCommitment}))
+	cached, err := cache.get(blobHash)
+	require.Nil(t, err)
+	require.Empty(t, cached.KzgProof)
+	require.Nil(t, verifySidecar(cached))
+
+	// The oldest blob is pruned.
+	require.Nil(t, cache.put(otherHash, other))
+	cached, err = cache.get(blobHash)
+	require.Nil(t, err)
+	require.Nil(t, cached)
+	cached, err = cache.get(otherHash)
+	require.Nil(t, err)
+	require.NotNil(t, cached)
+
+	// Corrupted cache file.
+	require.Nil(t, os.WriteFile(cache.path(blobHash), []byte{0x01}, 0o600))
+	_, err = cache.get(blobHash)
+	require.NotNil(t, err)
+}
+
+func TestBlobServerSource(t *testing.T) {
+	sidecar, blobHash := testSidecar(t, "taiko")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/blobs/"+blobHash.String() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"commitment":"` + sidecar.KzgCommitment + `","data":"` + sidecar.Blob +
+			`","versioned_hash":"` + blobHash.String() + `"}`))
+	}))
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	source := &blobServerSource{endpoint: endpoint, route: "/blobs/"}
+	sidecars, err := source.GetBlobs(context.Background(), 0, blobHash, nil)
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, sidecar.Blob, sidecars[0].Blob)
+	require.Equal(t, sidecar.KzgCommitment, sidecars[0].KzgCommitment)
+
+	_, err = source.GetBlobs(context.Background(), 0, common.Hash{}, nil)
+	require.NotNil(t, err)
+}
+
+// testSidecar creates a blob sidecar with a KZG proof which contains the given data.
+func testSidecar(t *testing.T, data string) (*structs.Sidecar, common.Hash) {
+	var blob eth.Blob
+	require.Nil(t, blob.FromData([]byte(data)))
+
+	commitment, err := kzg4844.BlobToCommitment(blob.KZGBlob())
+	require.Nil(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob.KZGBlob(), commitment)
+	require.Nil(t, err)
+
+	return &structs.Sidecar{
+		Blob:          hexutil.Encode(blob[:]),
+		KzgCommitment: hexutil.Encode(commitment[:]),
+		KzgProof:      hexutil.Encode(proof[:]),
+	}, kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
+}
@@ -55,6 +55,11 @@ type ClientConfig struct {
 	L2EngineEndpoint              string
 	JwtSecret                     string
 	Timeout                       time.Duration
+	// Additional L1 endpoints, if set, L1 reads are only trusted when at least
+	// L1QuorumThreshold endpoints (including L1Endpoint) agree on the result.
+	L1QuorumEndpoints []string
+	L1QuorumThreshold uint64
+	L1QuorumObserver  QuorumObserver
 }
 
 // NewClient initializes all RPC clients used by Taiko client software.
@@ -77,6 +82,13 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 			return err
 		}
 
+		if len(cfg.L1QuorumEndpoints) != 0 {
+			if l1Client.quorum, err = dialL1Quorum(ctxWithTimeout, l1Client, cfg); err != nil {
+				log.Error("Failed to connect to L1 quorum endpoints, retrying", "err", err)
+				return err
+			}
+		}
+
 		if l2Client, err = NewEthClient(ctxWithTimeout, cfg.L2Endpoint, cfg.Timeout); err != nil {
 			log.Error("Failed to connect to L2 endpoint, retrying", "endpoint", cfg.L2Endpoint, "err", err)
 			return err
@@ -33,6 +33,8 @@ type EthClient struct {
 	*ethClient
 
 	timeout time.Duration
+	// If set, HeaderByNumber, FilterLogs and CallContract are served by the L1 quorum.
+	quorum *l1Quorum
 }
 
 // NewEthClient creates a new EthClient instance.
@@ -113,6 +115,10 @@ func (c *EthClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.
 // HeaderByNumber returns a block header from the current canonical chain. If number is
 // nil, the latest known header is returned.
 func (c *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if c.quorum != nil {
+		return c.quorum.headerByNumber(ctx, number)
+	}
+
 	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
@@ -294,12 +300,25 @@ func (c *EthClient) CallContract(
 	msg ethereum.CallMsg,
 	blockNumber *big.Int,
 ) ([]byte, error) {
+	if c.quorum != nil {
+		return c.quorum.callContract(ctx, msg, blockNumber)
+	}
+
 	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
 	return c.ethClient.CallContract(ctxWithTimeout, msg, blockNumber)
 }
 
+// FilterLogs executes a filter query.
+func (c *EthClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if c.quorum != nil {
+		return c.quorum.filterLogs(ctx, q)
+	}
+
+	return c.ethClient.FilterLogs(ctx, q)
+}
+
 // CallContractAtHash is almost the same as CallContract except that it selects
 // the block by block hash instead of block height.
 func (c *EthClient) CallContractAtHash(
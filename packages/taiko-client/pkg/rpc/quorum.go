@@ -0,0 +1,342 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	errQuorumNotReached = errors.New("L1 quorum not reached")
+	errInvalidThreshold = errors.New("invalid L1 quorum threshold")
+)
+
+// QuorumObserver observes the L1 quorum reads, e.g. to record metrics.
+type QuorumObserver interface {
+	// ObserveQuorumProviderError is called when a provider fails to serve a read.
+	ObserveQuorumProviderError(method string, provider string)
+	// ObserveQuorumDisagreement is called when a provider returns a different result from the quorum.
+	ObserveQuorumDisagreement(method string, provider string)
+	// ObserveQuorumNotReached is called when not enough providers agree on a read.
+	ObserveQuorumNotReached(method string)
+}
+
+// quorumProvider is one of the L1 providers of a quorum.
+type quorumProvider struct {
+	name   string
+	client *ethClient
+}
+
+// l1Quorum fans the L1 reads out to all providers, and only returns the result which at least
+// `threshold` providers agree on. Failed providers are skipped, so that the reads fail over to the
+// other providers as long as the quorum can still be reached.
+type l1Quorum struct {
+	providers []*quorumProvider
+	threshold int
+	timeout   time.Duration
+	observer  QuorumObserver
+}
+
+// quorumResponse is the response of a provider.
+type quorumResponse[T any] struct {
+	provider string
+	value    T
+	key      common.Hash
+	err      error
+}
+
+// newL1Quorum creates a new l1Quorum instance with the given clients, 0 threshold means
+// the majority of the clients.
+func newL1Quorum(
+	clients []*EthClient,
+	endpoints []string,
+	threshold uint64,
+	observer QuorumObserver,
+) (*l1Quorum, error) {
+	if threshold == 0 {
+		threshold = uint64(len(clients)/2 + 1)
+	}
+	if threshold > uint64(len(clients)) {
+		return nil, fmt.Errorf("%w: %d, providers: %d", errInvalidThreshold, threshold, len(clients))
+	}
+
+	q := &l1Quorum{threshold: int(threshold), timeout: clients[0].timeout, observer: observer}
+	for i, client := range clients {
+		if client.ChainID.Cmp(clients[0].ChainID) != 0 {
+			return nil, fmt.Errorf("L1 provider %d chain ID mismatch: %d != %d", i, client.ChainID, clients[0].ChainID)
+		}
+		q.providers = append(q.providers, &quorumProvider{name: providerName(i, endpoints[i]), client: client.ethClient})
+	}
+
+	return q, nil
+}
+
+// dialL1Quorum connects to the L1 quorum endpoints, and creates a new l1Quorum instance
+// together with the given primary L1 client.
+func dialL1Quorum(ctx context.Context, l1Client *EthClient, cfg *ClientConfig) (*l1Quorum, error) {
+	clients := []*EthClient{l1Client}
+	for _, endpoint := range cfg.L1QuorumEndpoints {
+		client, err := NewEthClient(ctx, endpoint, cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", providerName(len(clients), endpoint), err)
+		}
+		clients = append(clients, client)
+	}
+
+	return newL1Quorum(
+		clients,
+		append([]string{cfg.L1Endpoint}, cfg.L1QuorumEndpoints...),
+		cfg.L1QuorumThreshold,
+		cfg.L1QuorumObserver,
+	)
+}
+
+// headerByNumber returns the header which the quorum agrees on. If number is nil, the latest
+// header which at least `threshold` providers have reached is returned.
+func (q *l1Quorum) headerByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		latest, err := q.latestBlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		number = latest
+	}
+
+	return quorumCall(
+		ctx,
+		q,
+		"HeaderByNumber",
+		func(ctx context.Context, client *ethClient) (*types.Header, error) {
+			return client.HeaderByNumber(ctx, number)
+		},
+		func(header *types.Header) (common.Hash, error) { return header.Hash(), nil },
+	)
+}
+
+// filterLogs returns the logs which the quorum agrees on. If the query's block range is open
+// ended, it is resolved to the latest block which at least `threshold` providers have reached.
+func (q *l1Quorum) filterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if query.BlockHash == nil && (query.FromBlock == nil || query.ToBlock == nil) {
+		latest, err := q.latestBlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if query.FromBlock == nil {
+			query.FromBlock = latest
+		}
+		if query.ToBlock == nil {
+			query.ToBlock = latest
+		}
+	}
+
+	return quorumCall(
+		ctx,
+		q,
+		"FilterLogs",
+		func(ctx context.Context, client *ethClient) ([]types.Log, error) {
+			return client.FilterLogs(ctx, query)
+		},
+		logsKey,
+	)
+}
+
+// callContract returns the contract call result which the quorum agrees on. If blockNumber is nil,
+// the call is executed at the latest block which at least `threshold` providers have reached.
+func (q *l1Quorum) callContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber == nil {
+		latest, err := q.latestBlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blockNumber = latest
+	}
+
+	return quorumCall(
+		ctx,
+		q,
+		"CallContract",
+		func(ctx context.Context, client *ethClient) ([]byte, error) {
+			return client.CallContract(ctx, msg, blockNumber)
+		},
+		func(result []byte) (common.Hash, error) { return crypto.Keccak256Hash(result), nil },
+	)
+}
+
+// latestBlockNumber returns the highest block number which at least `threshold` providers have reached,
+// so that the lagging providers won't break the quorum of the latest header.
+func (q *l1Quorum) latestBlockNumber(ctx context.Context) (*big.Int, error) {
+	responses := make(chan *quorumResponse[uint64], len(q.providers))
+	for _, provider := range q.providers {
+		go func(provider *quorumProvider) {
+			ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, q.timeout)
+			defer cancel()
+
+			number, err := provider.client.BlockNumber(ctxWithTimeout)
+			responses <- &quorumResponse[uint64]{provider: provider.name, value: number, err: err}
+		}(provider)
+	}
+
+	var (
+		numbers []uint64
+		errs    []error
+	)
+	for range q.providers {
+		response := <-responses
+		if response.err != nil {
+			q.observeProviderError("BlockNumber", response.provider, response.err)
+			errs = append(errs, fmt.Errorf("%s: %w", response.provider, response.err))
+			continue
+		}
+		numbers = append(numbers, response.value)
+	}
+	if len(numbers) < q.threshold {
+		q.observeNotReached("BlockNumber")
+		return nil, fmt.Errorf("%w: %w", errQuorumNotReached, errors.Join(errs...))
+	}
+
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] > numbers[j] })
+	return new(big.Int).SetUint64(numbers[q.threshold-1]), nil
+}
+
+// quorumCall fans the given call out to all providers, and returns the first result which at
+// least `threshold` providers agree on, the results are compared by the given key function.
+// The remaining calls are cancelled once the quorum is reached.
+func quorumCall[T any](
+	ctx context.Context,
+	q *l1Quorum,
+	method string,
+	call func(context.Context, *ethClient) (T, error),
+	key func(T) (common.Hash, error),
+) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan *quorumResponse[T], len(q.providers))
+	for _, provider := range q.providers {
+		go func(provider *quorumProvider) {
+			ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, q.timeout)
+			defer cancel()
+
+			response := &quorumResponse[T]{provider: provider.name}
+			if response.value, response.err = call(ctxWithTimeout, provider.client); response.err == nil {
+				response.key, response.err = key(response.value)
+			}
+			responses <- response
+		}(provider)
+	}
+
+	var (
+		groups   = make(map[common.Hash][]*quorumResponse[T])
+		errs     []error
+		notFound int
+		zero     T
+	)
+	for range q.providers {
+		response := <-responses
+		if response.err != nil {
+			// Not found is a valid result, which the callers may check.
+			if response.err.Error() == ethereum.NotFound.Error() {
+				if notFound++; notFound >= q.threshold {
+					return zero, ethereum.NotFound
+				}
+				continue
+			}
+			q.observeProviderError(method, response.provider, response.err)
+			errs = append(errs, fmt.Errorf("%s: %w", response.provider, response.err))
+			continue
+		}
+
+		groups[response.key] = append(groups[response.key], response)
+		if len(groups[response.key]) < q.threshold {
+			continue
+		}
+
+		// Quorum reached, report the providers which disagree with it.
+		for key, group := range groups {
+			if key == response.key {
+				continue
+			}
+			for _, disagreed := range group {
+				log.Warn("L1 provider disagrees with the quorum", "method", method, "provider", disagreed.provider)
+				if q.observer != nil {
+					q.observer.ObserveQuorumDisagreement(method, disagreed.provider)
+				}
+			}
+		}
+
+		return response.value, nil
+	}
+
+	q.observeNotReached(method)
+	if len(groups) > 1 {
+		errs = append(errs, fmt.Errorf("%d different results", len(groups)))
+	}
+
+	return zero, fmt.Errorf("%w: %s, %w", errQuorumNotReached, method, errors.Join(errs...))
+}
+
+// observeProviderError observes the given provider error, the cancelled calls
+// after the quorum is reached are ignored.
+func (q *l1Quorum) observeProviderError(method string, provider string, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	log.Debug("Failed to read from L1 provider", "method", method, "provider", provider, "error", err)
+	if q.observer != nil {
+		q.observer.ObserveQuorumProviderError(method, provider)
+	}
+}
+
+// observeNotReached observes the reads whose quorum is not reached.
+func (q *l1Quorum) observeNotReached(method string) {
+	log.Warn("L1 quorum not reached", "method", method, "threshold", q.threshold)
+	if q.observer != nil {
+		q.observer.ObserveQuorumNotReached(method)
+	}
+}
+
+// logsKey returns the key of the given logs, which covers all the log fields returned by providers.
+func logsKey(logs []types.Log) (common.Hash, error) {
+	fields := make([]interface{}, 0, len(logs))
+	for _, l := range logs {
+		fields = append(fields, []interface{}{
+			l.Address,
+			l.Topics,
+			l.Data,
+			l.BlockNumber,
+			l.BlockHash,
+			l.TxHash,
+			uint64(l.TxIndex),
+			uint64(l.Index),
+			l.Removed,
+		})
+	}
+
+	encoded, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// providerName returns the name of the given provider used in logs and metrics, which
+// doesn't contain the credentials in the endpoint URL.
+func providerName(index int, endpoint string) string {
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return fmt.Sprintf("provider-%d", index)
+}
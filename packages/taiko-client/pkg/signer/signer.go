@@ -0,0 +1,70 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs the transactions and data of an L1 account, whose private key may not live in the
+// current process.
+type Signer interface {
+	// Address returns the address of the signing account.
+	Address() common.Address
+	// SignData signs the keccak256 hash of the given data, the signature is in the
+	// [R || S || V] format where V is 0 or 1.
+	SignData(ctx context.Context, data []byte) ([]byte, error)
+	// SignTransaction signs the given transaction for the given chain.
+	SignTransaction(ctx context.Context, chainID *big.Int, tx *types.Transaction) (*types.Transaction, error)
+}
+
+// RemoteSignerConfig contains the configurations of a remote signer.
+type RemoteSignerConfig struct {
+	Endpoint string
+	Address  common.Address
+	Timeout  time.Duration
+}
+
+// New creates a new signer, a remote signer will be used if the remote signer config is given,
+// otherwise the given private key will be used to sign locally.
+func New(ctx context.Context, privKey *ecdsa.PrivateKey, remote *RemoteSignerConfig) (Signer, error) {
+	if remote != nil {
+		return NewWeb3Signer(ctx, remote)
+	}
+
+	return NewLocalSigner(privKey), nil
+}
+
+// LocalSigner is a signer which signs with a private key held in the current process.
+type LocalSigner struct {
+	privKey *ecdsa.PrivateKey
+}
+
+// NewLocalSigner creates a new local signer with the given private key.
+func NewLocalSigner(privKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{privKey: privKey}
+}
+
+// Address implements the Signer interface.
+func (s *LocalSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.privKey.PublicKey)
+}
+
+// SignData implements the Signer interface.
+func (s *LocalSigner) SignData(_ context.Context, data []byte) ([]byte, error) {
+	return crypto.Sign(crypto.Keccak256(data), s.privKey)
+}
+
+// SignTransaction implements the Signer interface.
+func (s *LocalSigner) SignTransaction(
+	_ context.Context,
+	chainID *big.Int,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.privKey)
+}
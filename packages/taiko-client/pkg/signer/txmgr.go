@@ -0,0 +1,49 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NewTxManager creates a new transaction manager, whose transactions are signed by the given signer.
+func NewTxManager(
+	name string,
+	l log.Logger,
+	m metrics.TxMetricer,
+	cfg txmgr.CLIConfig,
+	signer Signer,
+) (*txmgr.SimpleTxManager, error) {
+	if local, ok := signer.(*LocalSigner); ok {
+		cfg.PrivateKey = common.Bytes2Hex(crypto.FromECDSA(local.privKey))
+		return txmgr.NewSimpleTxManager(name, l, m, cfg)
+	}
+
+	// The transaction manager config requires a local key, so we use a throwaway key to
+	// create the config, and then replace its signer.
+	throwawayKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	cfg.PrivateKey = common.Bytes2Hex(crypto.FromECDSA(throwawayKey))
+
+	conf, err := txmgr.NewConfig(cfg, l)
+	if err != nil {
+		return nil, err
+	}
+	conf.From = signer.Address()
+	conf.Signer = func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if from != signer.Address() {
+			return nil, fmt.Errorf("attempting to sign for %s, expected %s", from, signer.Address())
+		}
+		return signer.SignTransaction(ctx, conf.ChainID, tx)
+	}
+
+	return txmgr.NewSimpleTxManagerFromConfig(name, l, m, conf)
+}
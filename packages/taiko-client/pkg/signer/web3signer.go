@@ -0,0 +1,191 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// web3SignerPublicKeysPath is the Web3Signer API path which lists the managed eth1 public keys.
+	web3SignerPublicKeysPath = "/api/v1/eth1/publicKeys"
+	// web3SignerSignPath is the Web3Signer API path which signs the keccak256 hash of the given data.
+	web3SignerSignPath = "/api/v1/eth1/sign/"
+)
+
+var (
+	errSignerMismatch      = errors.New("signature not signed by the remote signer account")
+	errTransactionMismatch = errors.New("signed transaction differs from the requested one")
+)
+
+// Web3Signer is a signer backed by a remote Web3Signer compatible service, the data are signed
+// through its HTTP API, and the transactions are signed through its `eth_signTransaction`
+// JSON-RPC method.
+type Web3Signer struct {
+	address   common.Address
+	publicKey string
+	http      *resty.Client
+	rpc       *rpc.Client
+}
+
+// NewWeb3Signer creates a new remote signer with the given configurations, and checks whether
+// the configured account is managed by the remote signer.
+func NewWeb3Signer(ctx context.Context, cfg *RemoteSignerConfig) (*Web3Signer, error) {
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	s := &Web3Signer{
+		address: cfg.Address,
+		http:    resty.New().SetBaseURL(endpoint).SetTimeout(cfg.Timeout),
+	}
+
+	resp, err := s.http.R().SetContext(ctx).Get(web3SignerPublicKeysPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch remote signer public keys: %w", err)
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("failed to fetch remote signer public keys, status: %d", resp.StatusCode())
+	}
+
+	var publicKeys []string
+	if err := json.Unmarshal(resp.Body(), &publicKeys); err != nil {
+		return nil, fmt.Errorf("invalid remote signer public keys: %w", err)
+	}
+	for _, publicKey := range publicKeys {
+		address, err := publicKeyToAddress(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		if address == cfg.Address {
+			s.publicKey = publicKey
+			break
+		}
+	}
+	if s.publicKey == "" {
+		return nil, fmt.Errorf("account %s not managed by the remote signer", cfg.Address)
+	}
+
+	if s.rpc, err = rpc.DialOptions(
+		ctx,
+		endpoint,
+		rpc.WithHTTPClient(&http.Client{Timeout: cfg.Timeout}),
+	); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Address implements the Signer interface.
+func (s *Web3Signer) Address() common.Address {
+	return s.address
+}
+
+// SignData implements the Signer interface.
+func (s *Web3Signer) SignData(ctx context.Context, data []byte) ([]byte, error) {
+	resp, err := s.http.R().
+		SetContext(ctx).
+		SetBody(map[string]string{"data": hexutil.Encode(data)}).
+		Post(web3SignerSignPath + s.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf(
+			"failed to sign data by the remote signer, status: %d, body: %s",
+			resp.StatusCode(),
+			resp.Body(),
+		)
+	}
+
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(resp.String()), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid remote signer signature length: %d", len(sig))
+	}
+	// The remote signer returns the legacy V value, i.e. 27 or 28.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(*pubKey) != s.address {
+		return nil, errSignerMismatch
+	}
+
+	return sig, nil
+}
+
+// SignTransaction implements the Signer interface, the blob sidecar is not sent to the remote
+// signer, and attached to the signed transaction again.
+func (s *Web3Signer) SignTransaction(
+	ctx context.Context,
+	chainID *big.Int,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	var (
+		sidecar = tx.BlobTxSidecar()
+		args    = opsigner.NewTransactionArgsFromTransaction(chainID, &s.address, tx.WithoutBlobTxSidecar())
+		result  hexutil.Bytes
+	)
+	if err := s.rpc.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("eth_signTransaction failed: %w", err)
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(result); err != nil {
+		return nil, err
+	}
+	// Make sure the remote signer signed exactly the requested transaction, and with the
+	// configured account.
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errTransactionMismatch
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.address {
+		return nil, errSignerMismatch
+	}
+	if sidecar != nil {
+		signed = signed.WithBlobTxSidecar(sidecar)
+	}
+
+	return signed, nil
+}
+
+// publicKeyToAddress converts the given hex encoded public key, with or without the 0x04 prefix,
+// to its address.
+func publicKeyToAddress(publicKey string) (common.Address, error) {
+	raw, err := hexutil.Decode(publicKey)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid remote signer public key %s: %w", publicKey, err)
+	}
+	if len(raw) == 64 {
+		raw = append([]byte{0x04}, raw...)
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(raw)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid remote signer public key %s: %w", publicKey, err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
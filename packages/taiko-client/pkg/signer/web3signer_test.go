@@ -0,0 +1,245 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainID = big.NewInt(167)
+
+// stubService implements the `eth_signTransaction` and `eth_chainId` JSON-RPC methods of the stub
+// remote signer.
+type stubService struct {
+	key *ecdsa.PrivateKey
+	// tamper makes the stub sign a transaction with a bumped nonce instead of the requested one.
+	tamper bool
+}
+
+func (s *stubService) SignTransaction(args opsigner.TransactionArgs) (hexutil.Bytes, error) {
+	if s.tamper {
+		*args.Nonce++
+	}
+	txData, err := args.ToTransactionData()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignNewTx(s.key, types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.MarshalBinary()
+}
+
+func (s *stubService) ChainId() *hexutil.Big { // nolint: revive, stylecheck
+	return (*hexutil.Big)(testChainID)
+}
+
+// newStubWeb3Signer starts a local Web3Signer compatible stub, which manages the given keys.
+func newStubWeb3Signer(t *testing.T, keys ...*ecdsa.PrivateKey) *httptest.Server {
+	return newStubWeb3SignerWithService(t, &stubService{key: keys[0]}, keys...)
+}
+
+// newStubWeb3SignerWithService starts a local Web3Signer compatible stub, which manages the given
+// keys and serves the given JSON-RPC service.
+func newStubWeb3SignerWithService(t *testing.T, service *stubService, keys ...*ecdsa.PrivateKey) *httptest.Server {
+	rpcServer := rpc.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(web3SignerPublicKeysPath, func(w http.ResponseWriter, _ *http.Request) {
+		publicKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			publicKeys = append(publicKeys, hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)[1:]))
+		}
+		require.Nil(t, json.NewEncoder(w).Encode(publicKeys))
+	})
+	mux.HandleFunc(web3SignerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		var key *ecdsa.PrivateKey
+		for _, k := range keys {
+			if hexutil.Encode(crypto.FromECDSAPub(&k.PublicKey)[1:]) == strings.TrimPrefix(r.URL.Path, web3SignerSignPath) {
+				key = k
+			}
+		}
+		if key == nil {
+			http.Error(w, "unknown public key", http.StatusNotFound)
+			return
+		}
+
+		var body struct {
+			Data hexutil.Bytes `json:"data"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		sig, err := crypto.Sign(crypto.Keccak256(body.Data), key)
+		require.Nil(t, err)
+		sig[crypto.RecoveryIDOffset] += 27
+
+		_, err = w.Write([]byte(hexutil.Encode(sig)))
+		require.Nil(t, err)
+	})
+	mux.Handle("/", rpcServer)
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		srv.Close()
+		rpcServer.Stop()
+	})
+
+	return srv
+}
+
+func newTestTx(t *testing.T) *types.Transaction {
+	to := common.HexToAddress("0x1670000000000000000000000000000000010001")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(3),
+	})
+}
+
+func TestLocalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	s, err := New(context.Background(), key, nil)
+	require.Nil(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+
+	data := []byte("test")
+	sig, err := s.SignData(context.Background(), data)
+	require.Nil(t, err)
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	require.Nil(t, err)
+	require.Equal(t, s.Address(), crypto.PubkeyToAddress(*pubKey))
+
+	tx, err := s.SignTransaction(context.Background(), testChainID, newTestTx(t))
+	require.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), tx)
+	require.Nil(t, err)
+	require.Equal(t, s.Address(), sender)
+}
+
+func TestWeb3Signer(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	srv := newStubWeb3Signer(t, key)
+
+	s, err := New(context.Background(), nil, &RemoteSignerConfig{
+		Endpoint: srv.URL,
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		Timeout:  time.Second,
+	})
+	require.Nil(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+
+	// The remote signatures should be the same as the local ones.
+	data := []byte("test")
+	sig, err := s.SignData(context.Background(), data)
+	require.Nil(t, err)
+	localSig, err := NewLocalSigner(key).SignData(context.Background(), data)
+	require.Nil(t, err)
+	require.Equal(t, localSig, sig)
+
+	tx, err := s.SignTransaction(context.Background(), testChainID, newTestTx(t))
+	require.Nil(t, err)
+	localTx, err := NewLocalSigner(key).SignTransaction(context.Background(), testChainID, newTestTx(t))
+	require.Nil(t, err)
+	require.Equal(t, localTx.Hash(), tx.Hash())
+}
+
+func TestWeb3SignerAccountNotManaged(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	srv := newStubWeb3Signer(t, key)
+
+	_, err = NewWeb3Signer(context.Background(), &RemoteSignerConfig{
+		Endpoint: srv.URL,
+		Address:  common.HexToAddress("0x1670000000000000000000000000000000010001"),
+		Timeout:  time.Second,
+	})
+	require.ErrorContains(t, err, "not managed by the remote signer")
+}
+
+func TestWeb3SignerMismatch(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	anotherKey, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	// The stub signs all transactions with the first key.
+	srv := newStubWeb3Signer(t, key, anotherKey)
+
+	s, err := NewWeb3Signer(context.Background(), &RemoteSignerConfig{
+		Endpoint: srv.URL,
+		Address:  crypto.PubkeyToAddress(anotherKey.PublicKey),
+		Timeout:  time.Second,
+	})
+	require.Nil(t, err)
+
+	_, err = s.SignData(context.Background(), []byte("test"))
+	require.Nil(t, err)
+
+	_, err = s.SignTransaction(context.Background(), testChainID, newTestTx(t))
+	require.ErrorIs(t, err, errSignerMismatch)
+}
+
+func TestWeb3SignerTransactionMismatch(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	srv := newStubWeb3SignerWithService(t, &stubService{key: key, tamper: true}, key)
+
+	s, err := NewWeb3Signer(context.Background(), &RemoteSignerConfig{
+		Endpoint: srv.URL,
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		Timeout:  time.Second,
+	})
+	require.Nil(t, err)
+
+	// The transaction is signed by the configured account, but is not the requested one.
+	_, err = s.SignTransaction(context.Background(), testChainID, newTestTx(t))
+	require.ErrorIs(t, err, errTransactionMismatch)
+}
+
+func TestNewTxManagerWithRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	srv := newStubWeb3Signer(t, key)
+
+	s, err := NewWeb3Signer(context.Background(), &RemoteSignerConfig{
+		Endpoint: srv.URL,
+		Address:  crypto.PubkeyToAddress(key.PublicKey),
+		Timeout:  time.Second,
+	})
+	require.Nil(t, err)
+
+	cfg := txmgr.NewCLIConfig(srv.URL, txmgr.DefaultBatcherFlagValues)
+	cfg.NetworkTimeout = time.Second
+
+	mgr, err := NewTxManager("test", log.Root(), new(metrics.NoopTxMetrics), cfg, s)
+	require.Nil(t, err)
+	defer mgr.Close()
+
+	require.Equal(t, s.Address(), mgr.From())
+}
@@ -0,0 +1,96 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/miner"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// chainBackend is the set of the L1 / L2 chain queries used by a proposing operation, so
+// that the proposing operation can also be driven by recorded data in simulations.
+type chainBackend interface {
+	WaitTillL2ExecutionEngineSynced(ctx context.Context) error
+	GetPoolContent(
+		ctx context.Context,
+		beneficiary common.Address,
+		blockMaxGasLimit uint32,
+		maxBytesPerTxList uint64,
+		locals []common.Address,
+		maxTransactionsLists uint64,
+		minTip uint64,
+		chainConfig *config.ChainConfig,
+	) ([]*miner.PreBuiltTxList, error)
+	L2ChainID() *big.Int
+	L2HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	ProposedBlocks(ctx context.Context) (uint64, error)
+	BlockProposer(ctx context.Context, blockID uint64) (common.Address, error)
+	CheckProverBalance(ctx context.Context, prover common.Address, bond *big.Int) (bool, error)
+	L1SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	L1BlobBaseFee(ctx context.Context) (*big.Int, error)
+	L1EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+}
+
+// rpcBackend is the chainBackend implementation backed by the L1 / L2 RPC clients.
+type rpcBackend struct {
+	*rpc.Client
+	taikoL1Address common.Address
+}
+
+// L2ChainID implements the chainBackend interface.
+func (b *rpcBackend) L2ChainID() *big.Int {
+	return b.L2.ChainID
+}
+
+// L2HeaderByNumber implements the chainBackend interface.
+func (b *rpcBackend) L2HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return b.L2.HeaderByNumber(ctx, number)
+}
+
+// ProposedBlocks implements the chainBackend interface.
+func (b *rpcBackend) ProposedBlocks(ctx context.Context) (uint64, error) {
+	state, err := rpc.GetProtocolStateVariables(b.TaikoL1, &bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	return state.B.NumBlocks, nil
+}
+
+// BlockProposer implements the chainBackend interface, the assigned prover of a block proposed
+// after ontake fork is its proposer.
+func (b *rpcBackend) BlockProposer(ctx context.Context, blockID uint64) (common.Address, error) {
+	blockInfo, err := b.GetL2BlockInfoV2(ctx, new(big.Int).SetUint64(blockID))
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return blockInfo.AssignedProver, nil
+}
+
+// CheckProverBalance implements the chainBackend interface.
+func (b *rpcBackend) CheckProverBalance(ctx context.Context, prover common.Address, bond *big.Int) (bool, error) {
+	return rpc.CheckProverBalance(ctx, b.Client, prover, b.taikoL1Address, bond)
+}
+
+// L1SuggestGasPrice implements the chainBackend interface.
+func (b *rpcBackend) L1SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return b.L1.SuggestGasPrice(ctx)
+}
+
+// L1BlobBaseFee implements the chainBackend interface.
+func (b *rpcBackend) L1BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	return b.L1.BlobBaseFee(ctx)
+}
+
+// L1EstimateGas implements the chainBackend interface.
+func (b *rpcBackend) L1EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return b.L1.EstimateGas(ctx, msg)
+}
@@ -2,6 +2,7 @@ package proposer
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -14,9 +15,13 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 )
@@ -25,6 +30,7 @@ import (
 type Config struct {
 	*rpc.ClientConfig
 	L1ProposerPrivKey          *ecdsa.PrivateKey
+	RemoteSigner               *signer.RemoteSignerConfig
 	L2SuggestedFeeRecipient    common.Address
 	ExtraData                  string
 	ProposeInterval            time.Duration
@@ -46,6 +52,11 @@ type Config struct {
 	GasNeededForProvingBlock   uint64
 	PriceFluctuationModifier   uint64
 	OffChainCosts              *big.Int
+	TxListCodec                compression.Codec
+	TxListCodecForks           []*config.TxListCodecFork
+	LookaheadProposers         []common.Address
+	LookaheadSlotsPerProposer  uint64
+	LookaheadWhitelistAddress  common.Address
 }
 
 // NewConfigFromCliContext initializes a Config instance from
@@ -56,9 +67,16 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid JWT secret file: %w", err)
 	}
 
-	l1ProposerPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProposerPrivKey.Name)))
+	remoteSigner, err := pkgFlags.InitRemoteSignerConfigFromCli(c)
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		return nil, err
+	}
+
+	var l1ProposerPrivKey *ecdsa.PrivateKey
+	if remoteSigner == nil {
+		if l1ProposerPrivKey, err = crypto.ToECDSA(common.FromHex(c.String(flags.L1ProposerPrivKey.Name))); err != nil {
+			return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		}
 	}
 
 	l2SuggestedFeeRecipient := c.String(flags.L2SuggestedFeeRecipient.Name)
@@ -66,14 +84,9 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid L2 suggested fee recipient address: %s", l2SuggestedFeeRecipient)
 	}
 
-	var localAddresses []common.Address
-	if c.IsSet(flags.TxPoolLocals.Name) {
-		for _, account := range strings.Split(c.String(flags.TxPoolLocals.Name), ",") {
-			if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
-				return nil, fmt.Errorf("invalid account in --txpool.locals: %s", trimmed)
-			}
-			localAddresses = append(localAddresses, common.HexToAddress(account))
-		}
+	localAddresses, err := parseLocalAddresses(c)
+	if err != nil {
+		return nil, err
 	}
 
 	minTip, err := utils.GWeiToWei(c.Float64(flags.MinTip.Name))
@@ -81,9 +94,9 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
-	maxProposedTxListsPerEpoch := c.Uint64(flags.MaxProposedTxListsPerEpoch.Name)
-	if maxProposedTxListsPerEpoch > 2 {
-		return nil, fmt.Errorf("max proposed tx lists per epoch should not exceed 2, got: %d", maxProposedTxListsPerEpoch)
+	maxProposedTxListsPerEpoch, err := parseMaxProposedTxListsPerEpoch(c)
+	if err != nil {
+		return nil, err
 	}
 
 	checkProfitability := c.Bool(flags.CheckProfitability.Name)
@@ -91,13 +104,30 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	gasNeededForProvingBlock := c.Uint64(flags.GasNeededForProvingBlock.Name)
 	priceFluctuationModifier := c.Uint64(flags.PriceFluctuationModifier.Name)
 
-	offChainCosts, ok := new(big.Int).SetString(c.String(flags.OffChainCosts.Name), 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid off-chain costs: %s", c.String(flags.OffChainCosts.Name))
+	offChainCosts, err := parseOffChainCosts(c)
+	if err != nil {
+		return nil, err
 	}
 
-	if offChainCosts.Cmp(abi.MaxUint256) == 1 {
-		return nil, fmt.Errorf("off-chain costs value larger than max uint256")
+	txListCodec, txListCodecForks, err := parseTxListCodecs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	lookaheadProposers, err := parseLookaheadProposers(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var lookaheadWhitelistAddress common.Address
+	if c.IsSet(flags.LookaheadWhitelistAddress.Name) {
+		if len(lookaheadProposers) > 0 {
+			return nil, errors.New("--lookahead.proposers and --lookahead.whitelist can not be set at the same time")
+		}
+		if !common.IsHexAddress(c.String(flags.LookaheadWhitelistAddress.Name)) {
+			return nil, fmt.Errorf("invalid --lookahead.whitelist: %s", c.String(flags.LookaheadWhitelistAddress.Name))
+		}
+		lookaheadWhitelistAddress = common.HexToAddress(c.String(flags.LookaheadWhitelistAddress.Name))
 	}
 
 	return &Config{
@@ -107,14 +137,19 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
 			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
 			L2EngineEndpoint:  c.String(flags.L2AuthEndpoint.Name),
+			L1BeaconEndpoint:  c.String(flags.L1BeaconEndpoint.Name),
 			JwtSecret:         string(jwtSecret),
 			TaikoTokenAddress: common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 			Timeout:           c.Duration(flags.RPCTimeout.Name),
 			ProverSetAddress:  common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
 			InboxAddress:      common.HexToAddress(c.String(flags.InboxAddress.Name)),
 			BridgeAddress:     common.HexToAddress(c.String(flags.BridgeAddress.Name)),
+			L1QuorumEndpoints: c.StringSlice(flags.L1QuorumEndpoints.Name),
+			L1QuorumThreshold: c.Uint64(flags.L1QuorumThreshold.Name),
+			L1QuorumObserver:  metrics.L1QuorumObserver,
 		},
 		L1ProposerPrivKey:          l1ProposerPrivKey,
+		RemoteSigner:               remoteSigner,
 		L2SuggestedFeeRecipient:    common.HexToAddress(l2SuggestedFeeRecipient),
 		ExtraData:                  c.String(flags.ExtraData.Name),
 		ProposeInterval:            c.Duration(flags.ProposeInterval.Name),
@@ -139,10 +174,139 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			l1ProposerPrivKey,
 			c,
 		),
-		CheckProfitability:       checkProfitability,
-		AllowEmptyBlocks:         allowEmptyBlocks,
-		GasNeededForProvingBlock: gasNeededForProvingBlock,
-		PriceFluctuationModifier: priceFluctuationModifier,
-		OffChainCosts:            offChainCosts,
+		CheckProfitability:        checkProfitability,
+		AllowEmptyBlocks:          allowEmptyBlocks,
+		GasNeededForProvingBlock:  gasNeededForProvingBlock,
+		PriceFluctuationModifier:  priceFluctuationModifier,
+		OffChainCosts:             offChainCosts,
+		TxListCodec:               txListCodec,
+		TxListCodecForks:          txListCodecForks,
+		LookaheadProposers:        lookaheadProposers,
+		LookaheadSlotsPerProposer: c.Uint64(flags.LookaheadSlotsPerProposer.Name),
+		LookaheadWhitelistAddress: lookaheadWhitelistAddress,
 	}, nil
 }
+
+// NewSimulationConfigFromCliContext initializes a Config instance for the proposer simulation from
+// command line flags, only the proposing policies are needed, since nothing will be sent to L1.
+func NewSimulationConfigFromCliContext(c *cli.Context) (*Config, error) {
+	localAddresses, err := parseLocalAddresses(c)
+	if err != nil {
+		return nil, err
+	}
+
+	minTip, err := utils.GWeiToWei(c.Float64(flags.MinTip.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	maxProposedTxListsPerEpoch, err := parseMaxProposedTxListsPerEpoch(c)
+	if err != nil {
+		return nil, err
+	}
+
+	offChainCosts, err := parseOffChainCosts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	txListCodec, txListCodecForks, err := parseTxListCodecs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		ClientConfig: &rpc.ClientConfig{
+			ProverSetAddress: common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
+		},
+		ExtraData:                  c.String(flags.ExtraData.Name),
+		LocalAddresses:             localAddresses,
+		LocalAddressesOnly:         c.Bool(flags.TxPoolLocalsOnly.Name),
+		MinGasUsed:                 c.Uint64(flags.MinGasUsed.Name),
+		MinTxListBytes:             c.Uint64(flags.MinTxListBytes.Name),
+		MinTip:                     minTip.Uint64(),
+		MinProposingInternal:       c.Duration(flags.MinProposingInternal.Name),
+		MaxProposedTxListsPerEpoch: maxProposedTxListsPerEpoch,
+		AllowZeroInterval:          c.Uint64(flags.AllowZeroInterval.Name),
+		ProposeBlockTxGasLimit:     c.Uint64(flags.TxGasLimit.Name),
+		BlobAllowed:                c.Bool(flags.BlobAllowed.Name),
+		CheckProfitability:         c.Bool(flags.CheckProfitability.Name),
+		AllowEmptyBlocks:           c.Bool(flags.AllowEmptyBlocks.Name),
+		GasNeededForProvingBlock:   c.Uint64(flags.GasNeededForProvingBlock.Name),
+		PriceFluctuationModifier:   c.Uint64(flags.PriceFluctuationModifier.Name),
+		OffChainCosts:              offChainCosts,
+		TxListCodec:                txListCodec,
+		TxListCodecForks:           txListCodecForks,
+	}, nil
+}
+
+// parseLocalAddresses parses the `--txpool.locals` flag.
+func parseLocalAddresses(c *cli.Context) ([]common.Address, error) {
+	var localAddresses []common.Address
+	if c.IsSet(flags.TxPoolLocals.Name) {
+		for _, account := range strings.Split(c.String(flags.TxPoolLocals.Name), ",") {
+			if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
+				return nil, fmt.Errorf("invalid account in --txpool.locals: %s", trimmed)
+			}
+			localAddresses = append(localAddresses, common.HexToAddress(account))
+		}
+	}
+
+	return localAddresses, nil
+}
+
+// parseLookaheadProposers parses the `--lookahead.proposers` flag.
+func parseLookaheadProposers(c *cli.Context) ([]common.Address, error) {
+	var proposers []common.Address
+	for _, account := range c.StringSlice(flags.LookaheadProposers.Name) {
+		if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
+			return nil, fmt.Errorf("invalid account in --lookahead.proposers: %s", trimmed)
+		}
+		proposers = append(proposers, common.HexToAddress(strings.TrimSpace(account)))
+	}
+
+	return proposers, nil
+}
+
+// parseMaxProposedTxListsPerEpoch parses the `--txPool.maxTxListsPerEpoch` flag.
+func parseMaxProposedTxListsPerEpoch(c *cli.Context) (uint64, error) {
+	maxProposedTxListsPerEpoch := c.Uint64(flags.MaxProposedTxListsPerEpoch.Name)
+	if maxProposedTxListsPerEpoch > 2 {
+		return 0, fmt.Errorf("max proposed tx lists per epoch should not exceed 2, got: %d", maxProposedTxListsPerEpoch)
+	}
+
+	return maxProposedTxListsPerEpoch, nil
+}
+
+// parseOffChainCosts parses the `--offChainCosts` flag.
+func parseOffChainCosts(c *cli.Context) (*big.Int, error) {
+	offChainCosts, ok := new(big.Int).SetString(c.String(flags.OffChainCosts.Name), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid off-chain costs: %s", c.String(flags.OffChainCosts.Name))
+	}
+
+	if offChainCosts.Cmp(abi.MaxUint256) == 1 {
+		return nil, fmt.Errorf("off-chain costs value larger than max uint256")
+	}
+
+	return offChainCosts, nil
+}
+
+// parseTxListCodecs parses the `--txList.codec` and `--txList.codecForks` flags.
+func parseTxListCodecs(c *cli.Context) (compression.Codec, []*config.TxListCodecFork, error) {
+	var txListCodec compression.Codec
+	if c.IsSet(flags.TxListCodec.Name) {
+		codec, err := compression.CodecByName(c.String(flags.TxListCodec.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		txListCodec = codec
+	}
+
+	txListCodecForks, err := config.ParseTxListCodecForks(c.StringSlice(flags.TxListCodecForks.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txListCodec, txListCodecForks, nil
+}
@@ -0,0 +1,146 @@
+package lookahead
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxLookaheadSlots is the maximum number of the L1 slots to look ahead, when searching for the next
+// slot assigned to the current proposer.
+const maxLookaheadSlots = 64
+
+// Lookahead returns the proposers assigned to the L1 slots.
+type Lookahead interface {
+	// ProposerOf returns the proposer assigned to the given L1 slot.
+	ProposerOf(ctx context.Context, slot uint64) (common.Address, error)
+	// ProposersOf returns the proposers assigned to the L1 slots from `from` to `to` inclusive.
+	ProposersOf(ctx context.Context, from, to uint64) ([]common.Address, error)
+}
+
+// RotationLookahead assigns the L1 slots to the configured proposers in turn, each proposer is
+// assigned the given number of consecutive slots.
+type RotationLookahead struct {
+	proposers        []common.Address
+	slotsPerProposer uint64
+}
+
+// NewRotationLookahead creates a new RotationLookahead instance.
+func NewRotationLookahead(proposers []common.Address, slotsPerProposer uint64) (*RotationLookahead, error) {
+	if len(proposers) == 0 {
+		return nil, errors.New("empty lookahead proposers")
+	}
+	if slotsPerProposer == 0 {
+		return nil, errors.New("lookahead slots per proposer should be greater than zero")
+	}
+
+	return &RotationLookahead{proposers: proposers, slotsPerProposer: slotsPerProposer}, nil
+}
+
+// ProposerOf implements the Lookahead interface.
+func (l *RotationLookahead) ProposerOf(_ context.Context, slot uint64) (common.Address, error) {
+	return l.proposers[(slot/l.slotsPerProposer)%uint64(len(l.proposers))], nil
+}
+
+// ProposersOf implements the Lookahead interface.
+func (l *RotationLookahead) ProposersOf(ctx context.Context, from, to uint64) ([]common.Address, error) {
+	var proposers []common.Address
+	for slot := from; slot <= to; slot++ {
+		proposer, err := l.ProposerOf(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		proposers = append(proposers, proposer)
+	}
+
+	return proposers, nil
+}
+
+// Scheduler decides when the given proposer is allowed to propose, based on the L1 slots
+// assigned by the lookahead.
+type Scheduler struct {
+	lookahead      Lookahead
+	proposer       common.Address
+	genesisTime    uint64
+	secondsPerSlot uint64
+}
+
+// NewScheduler creates a new Scheduler instance, the L1 slots are calculated from the given
+// L1 genesis time and seconds per slot.
+func NewScheduler(
+	lookahead Lookahead,
+	proposer common.Address,
+	genesisTime uint64,
+	secondsPerSlot uint64,
+) *Scheduler {
+	return &Scheduler{
+		lookahead:      lookahead,
+		proposer:       proposer,
+		genesisTime:    genesisTime,
+		secondsPerSlot: max(secondsPerSlot, 1),
+	}
+}
+
+// IsAssigned checks whether a proposing transaction sent at the given time targets an L1 slot
+// assigned to the current proposer, i.e. whether the L1 slot after the one at that time, in which
+// the transaction can be included at the earliest, is assigned.
+func (s *Scheduler) IsAssigned(ctx context.Context, sentAt time.Time) (bool, error) {
+	proposer, err := s.lookahead.ProposerOf(ctx, s.inclusionSlot(sentAt))
+	if err != nil {
+		return false, err
+	}
+
+	return proposer == s.proposer, nil
+}
+
+// NextProposingDelay returns the delay before the next proposing operation, the given interval is
+// used if a transaction sent at that time targets an L1 slot assigned to the current proposer,
+// otherwise it waits until the start of the L1 slot before the next assigned one. The proposers of
+// all the checked slots are fetched from the lookahead at once.
+func (s *Scheduler) NextProposingDelay(
+	ctx context.Context,
+	now time.Time,
+	interval time.Duration,
+) (time.Duration, error) {
+	var (
+		target       = s.inclusionSlot(now)
+		intervalSlot = max(s.inclusionSlot(now.Add(interval)), target)
+	)
+	proposers, err := s.lookahead.ProposersOf(ctx, target, max(intervalSlot, target+maxLookaheadSlots))
+	if err != nil {
+		return 0, err
+	}
+	if proposers[intervalSlot-target] == s.proposer {
+		return interval, nil
+	}
+
+	for i, proposer := range proposers[:maxLookaheadSlots+1] {
+		if proposer == s.proposer {
+			return max(s.slotStart(target+uint64(i)-1).Sub(now), 0), nil
+		}
+	}
+
+	// No slot is assigned in the lookahead window, check again at the end of the window.
+	return s.slotStart(target + maxLookaheadSlots).Sub(now), nil
+}
+
+// inclusionSlot returns the earliest L1 slot in which a transaction sent at the given time can
+// be included.
+func (s *Scheduler) inclusionSlot(sentAt time.Time) uint64 {
+	return s.slotAt(sentAt) + 1
+}
+
+// slotAt returns the L1 slot at the given time.
+func (s *Scheduler) slotAt(t time.Time) uint64 {
+	if t.Unix() < int64(s.genesisTime) {
+		return 0
+	}
+	return (uint64(t.Unix()) - s.genesisTime) / s.secondsPerSlot
+}
+
+// slotStart returns the start time of the given L1 slot.
+func (s *Scheduler) slotStart(slot uint64) time.Time {
+	return time.Unix(int64(s.genesisTime+slot*s.secondsPerSlot), 0)
+}
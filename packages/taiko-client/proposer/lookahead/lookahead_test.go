@@ -0,0 +1,98 @@
+package lookahead
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testProposerA = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	testProposerB = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	testGenesis   = uint64(1_700_000_000)
+)
+
+func slotTime(slot uint64) time.Time {
+	return time.Unix(int64(testGenesis+slot*12), 0)
+}
+
+func TestNewRotationLookahead(t *testing.T) {
+	_, err := NewRotationLookahead(nil, 1)
+	require.ErrorContains(t, err, "empty lookahead proposers")
+
+	_, err = NewRotationLookahead([]common.Address{testProposerA}, 0)
+	require.ErrorContains(t, err, "greater than zero")
+}
+
+func TestRotationLookaheadProposerOf(t *testing.T) {
+	l, err := NewRotationLookahead([]common.Address{testProposerA, testProposerB}, 2)
+	require.Nil(t, err)
+
+	for slot, expected := range []common.Address{
+		testProposerA, testProposerA, testProposerB, testProposerB, testProposerA,
+	} {
+		proposer, err := l.ProposerOf(context.Background(), uint64(slot))
+		require.Nil(t, err)
+		require.Equal(t, expected, proposer)
+	}
+}
+
+func TestSchedulerIsAssigned(t *testing.T) {
+	l, err := NewRotationLookahead([]common.Address{testProposerA, testProposerB}, 2)
+	require.Nil(t, err)
+	s := NewScheduler(l, testProposerB, testGenesis, 12)
+
+	// The transactions sent during a slot target the next slot.
+	for _, c := range []struct {
+		sentAt   time.Time
+		assigned bool
+	}{
+		{slotTime(0), false},
+		{slotTime(1).Add(11 * time.Second), true},
+		{slotTime(2).Add(6 * time.Second), true},
+		{slotTime(3), false},
+	} {
+		assigned, err := s.IsAssigned(context.Background(), c.sentAt)
+		require.Nil(t, err)
+		require.Equal(t, c.assigned, assigned)
+	}
+}
+
+func TestSchedulerNextProposingDelay(t *testing.T) {
+	l, err := NewRotationLookahead([]common.Address{testProposerA, testProposerB}, 2)
+	require.Nil(t, err)
+	s := NewScheduler(l, testProposerB, testGenesis, 12)
+
+	// The interval ends in the slot before an assigned slot.
+	delay, err := s.NextProposingDelay(context.Background(), slotTime(1), 12*time.Second)
+	require.Nil(t, err)
+	require.Equal(t, 12*time.Second, delay)
+
+	// The interval ends after the last assigned slot, wait until the slot before the next assigned one.
+	delay, err = s.NextProposingDelay(context.Background(), slotTime(3).Add(6*time.Second), 12*time.Second)
+	require.Nil(t, err)
+	require.Equal(t, 2*12*time.Second-6*time.Second, delay)
+
+	// The slot before an assigned slot starts before the interval ends.
+	delay, err = s.NextProposingDelay(context.Background(), slotTime(0).Add(6*time.Second), 40*time.Second)
+	require.Nil(t, err)
+	require.Equal(t, 6*time.Second, delay)
+
+	// The current slot is already the one before an assigned slot.
+	delay, err = s.NextProposingDelay(context.Background(), slotTime(1).Add(6*time.Second), 40*time.Second)
+	require.Nil(t, err)
+	require.Zero(t, delay)
+}
+
+func TestSchedulerNextProposingDelayNoAssignedSlot(t *testing.T) {
+	l, err := NewRotationLookahead([]common.Address{testProposerA}, 1)
+	require.Nil(t, err)
+	s := NewScheduler(l, testProposerB, testGenesis, 12)
+
+	delay, err := s.NextProposingDelay(context.Background(), slotTime(0), 12*time.Second)
+	require.Nil(t, err)
+	require.Equal(t, (maxLookaheadSlots+1)*12*time.Second, delay)
+}
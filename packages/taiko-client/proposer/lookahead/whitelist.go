@@ -0,0 +1,185 @@
+package lookahead
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// slotsPerEpoch is the number of the L1 slots in an epoch.
+const slotsPerEpoch = 32
+
+// preconfWhitelistABI is the ABI of the PreconfWhitelist contract methods read by the lookahead,
+// the PreconfWhitelist contract is deployed separately from the protocol contracts.
+const preconfWhitelistABI = `[
+	{"type":"function","name":"getOperatorForCurrentEpoch","inputs":[],"outputs":[{"name":"","type":"address"}],
+	"stateMutability":"view"},
+	{"type":"function","name":"getOperatorForNextEpoch","inputs":[],"outputs":[{"name":"","type":"address"}],
+	"stateMutability":"view"}
+]`
+
+// operatorsCaller reads the operators assigned to the current and next epochs, it is implemented
+// by WhitelistCaller.
+type operatorsCaller interface {
+	GetOperatorForCurrentEpoch(opts *bind.CallOpts) (common.Address, error)
+	GetOperatorForNextEpoch(opts *bind.CallOpts) (common.Address, error)
+}
+
+// WhitelistCaller reads the epoch operators from a PreconfWhitelist contract.
+type WhitelistCaller struct {
+	contract *bind.BoundContract
+}
+
+// NewWhitelistCaller creates a new WhitelistCaller instance for the PreconfWhitelist contract at
+// the given address.
+func NewWhitelistCaller(address common.Address, caller bind.ContractCaller) (*WhitelistCaller, error) {
+	parsed, err := abi.JSON(strings.NewReader(preconfWhitelistABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return &WhitelistCaller{contract: bind.NewBoundContract(address, parsed, caller, nil, nil)}, nil
+}
+
+// GetOperatorForCurrentEpoch returns the operator assigned to the current epoch.
+func (c *WhitelistCaller) GetOperatorForCurrentEpoch(opts *bind.CallOpts) (common.Address, error) {
+	return c.operator(opts, "getOperatorForCurrentEpoch")
+}
+
+// GetOperatorForNextEpoch returns the operator assigned to the next epoch.
+func (c *WhitelistCaller) GetOperatorForNextEpoch(opts *bind.CallOpts) (common.Address, error) {
+	return c.operator(opts, "getOperatorForNextEpoch")
+}
+
+// operator calls the given operator getter of the contract.
+func (c *WhitelistCaller) operator(opts *bind.CallOpts, method string) (common.Address, error) {
+	var out []interface{}
+	if err := c.contract.Call(opts, &out, method); err != nil {
+		return common.Address{}, err
+	}
+
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// headerFetcher fetches the L1 block headers.
+type headerFetcher interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// WhitelistLookahead assigns all the L1 slots of an epoch to the operator selected by the
+// PreconfWhitelist contract for that epoch, only the operators of the current and next epochs are
+// known on chain.
+type WhitelistLookahead struct {
+	whitelist      operatorsCaller
+	l1             headerFetcher
+	genesisTime    uint64
+	secondsPerSlot uint64
+
+	// The operators of the cached epoch and the epoch after it.
+	mutex           sync.Mutex
+	cachedEpoch     *uint64
+	currentOperator common.Address
+	nextOperator    common.Address
+}
+
+// NewWhitelistLookahead creates a new WhitelistLookahead instance, the epochs are calculated from
+// the given L1 genesis time and seconds per slot.
+func NewWhitelistLookahead(
+	whitelist operatorsCaller,
+	l1 headerFetcher,
+	genesisTime uint64,
+	secondsPerSlot uint64,
+) *WhitelistLookahead {
+	return &WhitelistLookahead{
+		whitelist:      whitelist,
+		l1:             l1,
+		genesisTime:    genesisTime,
+		secondsPerSlot: max(secondsPerSlot, 1),
+	}
+}
+
+// ProposerOf implements the Lookahead interface, the slots after the next epoch are not assigned
+// to any proposer yet.
+func (l *WhitelistLookahead) ProposerOf(ctx context.Context, slot uint64) (common.Address, error) {
+	proposers, err := l.ProposersOf(ctx, slot, slot)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return proposers[0], nil
+}
+
+// ProposersOf implements the Lookahead interface, the L1 head is fetched once for all the given
+// slots.
+func (l *WhitelistLookahead) ProposersOf(ctx context.Context, from, to uint64) ([]common.Address, error) {
+	head, err := l.l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+
+	headEpoch := l.epochAt(head.Time)
+	current, next, err := l.operators(ctx, head, headEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposers []common.Address
+	for slot := from; slot <= to; slot++ {
+		switch epoch := slot / slotsPerEpoch; {
+		case epoch == headEpoch:
+			proposers = append(proposers, current)
+		case epoch == headEpoch+1:
+			proposers = append(proposers, next)
+		case epoch < headEpoch:
+			return nil, fmt.Errorf("L1 slot %d is before the current epoch %d", slot, headEpoch)
+		default:
+			proposers = append(proposers, common.Address{})
+		}
+	}
+
+	return proposers, nil
+}
+
+// operators returns the operators of the given epoch of the L1 head and the epoch after it, they
+// are fetched once per epoch.
+func (l *WhitelistLookahead) operators(
+	ctx context.Context,
+	head *types.Header,
+	epoch uint64,
+) (common.Address, common.Address, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.cachedEpoch != nil && *l.cachedEpoch == epoch {
+		return l.currentOperator, l.nextOperator, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	current, err := l.whitelist.GetOperatorForCurrentEpoch(opts)
+	if err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to fetch current epoch operator: %w", err)
+	}
+	next, err := l.whitelist.GetOperatorForNextEpoch(opts)
+	if err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to fetch next epoch operator: %w", err)
+	}
+
+	l.cachedEpoch, l.currentOperator, l.nextOperator = &epoch, current, next
+
+	return current, next, nil
+}
+
+// epochAt returns the L1 epoch at the given timestamp.
+func (l *WhitelistLookahead) epochAt(timestamp uint64) uint64 {
+	if timestamp < l.genesisTime {
+		return 0
+	}
+	return (timestamp - l.genesisTime) / l.secondsPerSlot / slotsPerEpoch
+}
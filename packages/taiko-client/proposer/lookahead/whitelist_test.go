@@ -0,0 +1,156 @@
+package lookahead
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWhitelist is a PreconfWhitelist contract, whose operators change every epoch of the L1 head.
+type stubWhitelist struct {
+	chain *stubChain
+	calls int
+}
+
+func (w *stubWhitelist) GetOperatorForCurrentEpoch(opts *bind.CallOpts) (common.Address, error) {
+	w.calls++
+	return w.operatorOf(opts.BlockNumber, 0), nil
+}
+
+func (w *stubWhitelist) GetOperatorForNextEpoch(opts *bind.CallOpts) (common.Address, error) {
+	w.calls++
+	return w.operatorOf(opts.BlockNumber, 1), nil
+}
+
+// operatorOf alternates the operators of the epochs, starting from the given epoch offset of the
+// given L1 block.
+func (w *stubWhitelist) operatorOf(number *big.Int, offset uint64) common.Address {
+	epoch := (w.chain.headers[number.Uint64()].Time-testGenesis)/12/slotsPerEpoch + offset
+	if epoch%2 == 0 {
+		return testProposerA
+	}
+	return testProposerB
+}
+
+// stubChain is a L1 chain with a header in each slot.
+type stubChain struct {
+	headers     []*types.Header
+	headFetches int
+}
+
+func (c *stubChain) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	c.headFetches++
+	return c.headers[len(c.headers)-1], nil
+}
+
+func (c *stubChain) mine() {
+	number := uint64(len(c.headers))
+	c.headers = append(c.headers, &types.Header{
+		Number: new(big.Int).SetUint64(number),
+		Time:   testGenesis + number*12,
+	})
+}
+
+// stubContractCaller returns the given address from all the contract calls.
+type stubContractCaller struct {
+	result common.Address
+	calls  []string
+}
+
+func (c *stubContractCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (c *stubContractCaller) CallContract(
+	_ context.Context,
+	msg ethereum.CallMsg,
+	_ *big.Int,
+) ([]byte, error) {
+	c.calls = append(c.calls, common.Bytes2Hex(msg.Data))
+	return common.LeftPadBytes(c.result.Bytes(), 32), nil
+}
+
+func TestWhitelistCaller(t *testing.T) {
+	caller := &stubContractCaller{result: testProposerA}
+	whitelist, err := NewWhitelistCaller(common.HexToAddress("0x01"), caller)
+	require.Nil(t, err)
+
+	operator, err := whitelist.GetOperatorForCurrentEpoch(&bind.CallOpts{})
+	require.Nil(t, err)
+	require.Equal(t, testProposerA, operator)
+
+	operator, err = whitelist.GetOperatorForNextEpoch(&bind.CallOpts{})
+	require.Nil(t, err)
+	require.Equal(t, testProposerA, operator)
+
+	require.Equal(t, []string{
+		common.Bytes2Hex(crypto.Keccak256([]byte("getOperatorForCurrentEpoch()"))[:4]),
+		common.Bytes2Hex(crypto.Keccak256([]byte("getOperatorForNextEpoch()"))[:4]),
+	}, caller.calls)
+}
+
+func TestWhitelistLookaheadProposerOf(t *testing.T) {
+	chain := new(stubChain)
+	chain.mine()
+	whitelist := &stubWhitelist{chain: chain}
+	l := NewWhitelistLookahead(whitelist, chain, testGenesis, 12)
+
+	for slot, expected := range map[uint64]common.Address{
+		0:                   testProposerA,
+		slotsPerEpoch - 1:   testProposerA,
+		slotsPerEpoch:       testProposerB,
+		2*slotsPerEpoch - 1: testProposerB,
+		// Not known on chain yet.
+		2 * slotsPerEpoch: {},
+	} {
+		proposer, err := l.ProposerOf(context.Background(), slot)
+		require.Nil(t, err)
+		require.Equal(t, expected, proposer)
+	}
+	// The operators are fetched once per epoch.
+	require.Equal(t, 2, whitelist.calls)
+
+	// Move to the next epoch.
+	for i := 0; i < slotsPerEpoch; i++ {
+		chain.mine()
+	}
+
+	proposer, err := l.ProposerOf(context.Background(), 2*slotsPerEpoch)
+	require.Nil(t, err)
+	require.Equal(t, testProposerA, proposer)
+	require.Equal(t, 4, whitelist.calls)
+
+	_, err = l.ProposerOf(context.Background(), 0)
+	require.ErrorContains(t, err, "before the current epoch")
+}
+
+func TestSchedulerWithWhitelistLookahead(t *testing.T) {
+	chain := new(stubChain)
+	chain.mine()
+	l := NewWhitelistLookahead(&stubWhitelist{chain: chain}, chain, testGenesis, 12)
+	s := NewScheduler(l, testProposerB, testGenesis, 12)
+
+	// The last slot of the epoch targets the first slot of the next epoch.
+	assigned, err := s.IsAssigned(context.Background(), slotTime(slotsPerEpoch-2))
+	require.Nil(t, err)
+	require.False(t, assigned)
+
+	assigned, err = s.IsAssigned(context.Background(), slotTime(slotsPerEpoch-1))
+	require.Nil(t, err)
+	require.True(t, assigned)
+
+	// The L1 head is fetched once for the whole lookahead window.
+	chain.headFetches = 0
+	delay, err := s.NextProposingDelay(context.Background(), slotTime(1), 12*time.Second)
+	require.Nil(t, err)
+	require.Equal(t, slotTime(slotsPerEpoch-1).Sub(slotTime(1)), delay)
+	require.Equal(t, 1, chain.headFetches)
+}
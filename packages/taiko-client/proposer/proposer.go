@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -15,10 +16,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -30,23 +29,34 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/lookahead"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
 )
 
+// errProposingPreempted is returned when the proposer backs off from a proposing operation, to
+// let the assigned proposer of the current L1 slot propose.
+var errProposingPreempted = errors.New("proposing preempted")
+
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
 type Proposer struct {
 	// configurations
 	*Config
 
 	// RPC clients
-	rpc *rpc.Client
+	rpc   *rpc.Client
+	chain chainBackend
 
 	// Private keys and account addresses
 	proposerAddress common.Address
+	signer          signer.Signer
 
 	proposingTimer *time.Timer
+	// L1 slots scheduler for multi-proposer setups, nil means proposing in every L1 slot
+	slotScheduler *lookahead.Scheduler
 
 	// Transaction builders
 	txCallDataBuilder builder.ProposeBlockTransactionBuilder
@@ -60,6 +70,7 @@ type Proposer struct {
 
 	lastProposedAt time.Time
 	totalEpochs    uint64
+	clock          func() time.Time
 
 	txmgrSelector *utils.TxMgrSelector
 
@@ -93,7 +104,6 @@ func (p *Proposer) InitFromConfig(
 	txMgr *txmgr.SimpleTxManager,
 	privateTxMgr *txmgr.SimpleTxManager,
 ) (err error) {
-	p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
 	p.ctx = ctx
 	p.Config = cfg
 	p.lastProposedAt = time.Now()
@@ -105,6 +115,7 @@ func (p *Proposer) InitFromConfig(
 	if p.rpc, err = rpc.NewClient(p.ctx, cfg.ClientConfig); err != nil {
 		return fmt.Errorf("initialize rpc clients error: %w", err)
 	}
+	p.chain = &rpcBackend{Client: p.rpc, taikoL1Address: cfg.TaikoL1Address}
 
 	// Check L1 RPC connection
 	blockNum, err := p.rpc.L1.BlockNumber(context.Background())
@@ -113,28 +124,36 @@ func (p *Proposer) InitFromConfig(
 	}
 	log.Info("Successfully connected to L1 RPC", "currentBlock", blockNum)
 
+	// L1 proposer signer
+	if p.signer, err = signer.New(p.ctx, cfg.L1ProposerPrivKey, cfg.RemoteSigner); err != nil {
+		return fmt.Errorf("initialize L1 proposer signer error: %w", err)
+	}
+	p.proposerAddress = p.signer.Address()
+
 	// Protocol configs
 	p.protocolConfigs = encoding.GetProtocolConfig(p.rpc.L2.ChainID.Uint64())
 
 	log.Info("Protocol configs", "configs", p.protocolConfigs)
 
 	if txMgr == nil {
-		if txMgr, err = txmgr.NewSimpleTxManager(
+		if txMgr, err = signer.NewTxManager(
 			"proposer",
 			log.Root(),
 			&metrics.TxMgrMetrics,
 			*cfg.TxmgrConfigs,
+			p.signer,
 		); err != nil {
 			return err
 		}
 	}
 
 	if privateTxMgr == nil && cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
-		if privateTxMgr, err = txmgr.NewSimpleTxManager(
+		if privateTxMgr, err = signer.NewTxManager(
 			"privateMempoolProposer",
 			log.Root(),
 			&metrics.TxMgrMetrics,
 			*cfg.PrivateTxmgrConfigs,
+			p.signer,
 		); err != nil {
 			return err
 		}
@@ -142,29 +161,36 @@ func (p *Proposer) InitFromConfig(
 
 	p.txmgrSelector = utils.NewTxMgrSelector(txMgr, privateTxMgr, nil)
 
-	chainConfig := config.NewChainConfig(p.protocolConfigs)
-	p.chainConfig = chainConfig
+	if len(cfg.LookaheadProposers) > 0 || cfg.LookaheadWhitelistAddress != rpc.ZeroAddress {
+		if p.slotScheduler, err = p.newSlotScheduler(); err != nil {
+			return fmt.Errorf("initialize L1 slots scheduler error: %w", err)
+		}
+	}
+
+	if p.chainConfig, err = config.NewChainConfig(p.protocolConfigs, cfg.TxListCodecForks...); err != nil {
+		return err
+	}
 
 	p.txCallDataBuilder = builder.NewCalldataTransactionBuilder(
 		p.rpc,
-		p.L1ProposerPrivKey,
+		p.signer,
 		cfg.L2SuggestedFeeRecipient,
 		cfg.TaikoL1Address,
 		cfg.ProverSetAddress,
 		cfg.ProposeBlockTxGasLimit,
 		cfg.ExtraData,
-		chainConfig,
+		p.chainConfig,
 	)
 	if cfg.BlobAllowed {
 		p.txBlobBuilder = builder.NewBlobTransactionBuilder(
 			p.rpc,
-			p.L1ProposerPrivKey,
+			p.signer,
 			cfg.TaikoL1Address,
 			cfg.ProverSetAddress,
 			cfg.L2SuggestedFeeRecipient,
 			cfg.ProposeBlockTxGasLimit,
 			cfg.ExtraData,
-			chainConfig,
+			p.chainConfig,
 		)
 		p.defaultTxBuilder = p.txBlobBuilder
 	} else {
@@ -244,6 +270,11 @@ func (p *Proposer) eventLoop() {
 
 			// Attempt a proposing operation
 			if err := p.ProposeOp(p.ctx); err != nil {
+				if errors.Is(err, errProposingPreempted) {
+					log.Info("Backing off from proposing", "reason", err)
+					metrics.ProposerPreemptedCounter.Add(1)
+					continue
+				}
 				log.Error("Proposing operation error", "error", err)
 				continue
 			}
@@ -363,7 +394,7 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 	}
 
 	// Fetch the pool content.
-	preBuiltTxList, err := p.rpc.GetPoolContent(
+	preBuiltTxList, err := p.chain.GetPoolContent(
 		p.ctx,
 		p.proposerAddress,
 		p.protocolConfigs.BlockMaxGasLimit,
@@ -383,7 +414,7 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 
 	if !p.initDone || p.forceProposeOnce {
 		log.Debug("Initializing proposer or force proposing once")
-		lastL2Header, err := p.rpc.L2.HeaderByNumber(p.ctx, nil)
+		lastL2Header, err := p.chain.L2HeaderByNumber(p.ctx, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get last L2 header: %w", err)
 		}
@@ -425,7 +456,7 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 	if p.LocalAddressesOnly {
 		var (
 			localTxsLists []types.Transactions
-			signer        = types.LatestSignerForChainID(p.rpc.L2.ChainID)
+			signer        = types.LatestSignerForChainID(p.chain.L2ChainID())
 		)
 		for _, txs := range txLists {
 			var filtered types.Transactions
@@ -458,11 +489,23 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 // from L2 execution engine's tx pool, splitting them by proposing constraints,
 // and then proposing them to TaikoL1 contract.
 func (p *Proposer) ProposeOp(ctx context.Context) error {
+	// Only propose when the transaction targets an L1 slot assigned to the current proposer.
+	if p.slotScheduler != nil {
+		assigned, err := p.slotScheduler.IsAssigned(ctx, p.now())
+		if err != nil {
+			return fmt.Errorf("failed to check the assigned L1 slot: %w", err)
+		}
+		if !assigned {
+			log.Debug("Target L1 slot is not assigned to the proposer")
+			return nil
+		}
+	}
+
 	// Check if it's time to propose unfiltered pool content.
-	filterPoolContent := time.Now().Before(p.lastProposedAt.Add(p.MinProposingInternal))
+	filterPoolContent := p.now().Before(p.lastProposedAt.Add(p.MinProposingInternal))
 
 	// Wait until L2 execution engine is synced at first.
-	if err := p.rpc.WaitTillL2ExecutionEngineSynced(ctx); err != nil {
+	if err := p.chain.WaitTillL2ExecutionEngineSynced(ctx); err != nil {
 		return fmt.Errorf("failed to wait until L2 execution engine synced: %w", err)
 	}
 
@@ -503,13 +546,13 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 // ProposeTxLists proposes the given transactions lists to TaikoL1 smart contract.
 func (p *Proposer) ProposeTxLists(ctx context.Context, txLists []types.Transactions) error {
 	// Check if the current L2 chain is after ontake fork.
-	state, err := rpc.GetProtocolStateVariables(p.rpc.TaikoL1, &bind.CallOpts{Context: ctx})
+	numBlocks, err := p.chain.ProposedBlocks(ctx)
 	if err != nil {
 		return err
 	}
 
 	// If the current L2 chain is before ontake fork, propose the transactions lists one by one.
-	if !p.chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)) {
+	if !p.chainConfig.IsOntake(new(big.Int).SetUint64(numBlocks)) {
 		g, gCtx := errgroup.WithContext(ctx)
 		for _, txs := range p.getTxListsToPropose(txLists) {
 			nonce, err := p.rpc.L1.PendingNonceAt(ctx, p.proposerAddress)
@@ -524,7 +567,7 @@ func (p *Proposer) ProposeTxLists(ctx context.Context, txLists []types.Transacti
 				if err := p.ProposeTxListLegacy(gCtx, txs); err != nil {
 					return err
 				}
-				p.lastProposedAt = time.Now()
+				p.lastProposedAt = p.now()
 				return nil
 			})
 
@@ -540,7 +583,7 @@ func (p *Proposer) ProposeTxLists(ctx context.Context, txLists []types.Transacti
 	if err := p.ProposeTxListOntake(ctx, txLists); err != nil {
 		return err
 	}
-	p.lastProposedAt = time.Now()
+	p.lastProposedAt = p.now()
 	return nil
 }
 
@@ -570,13 +613,7 @@ func (p *Proposer) ProposeTxListLegacy(
 		proverAddress = p.Config.ClientConfig.ProverSetAddress
 	}
 
-	ok, err := rpc.CheckProverBalance(
-		ctx,
-		p.rpc,
-		proverAddress,
-		p.TaikoL1Address,
-		p.protocolConfigs.LivenessBond,
-	)
+	ok, err := p.chain.CheckProverBalance(ctx, proverAddress, p.protocolConfigs.LivenessBond)
 
 	if err != nil {
 		log.Warn("Failed to check prover balance", "error", err)
@@ -619,7 +656,12 @@ func (p *Proposer) ProposeTxListOntake(
 		return err
 	}
 
-	txListsBytesArray, totalTxs, err := p.compressTxLists(txLists)
+	firstBlockID, err := p.chain.ProposedBlocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	txListsBytesArray, totalTxs, err := p.compressTxLists(txLists, firstBlockID)
 	if err != nil {
 		return err
 	}
@@ -630,11 +672,9 @@ func (p *Proposer) ProposeTxListOntake(
 		proverAddress = p.Config.ClientConfig.ProverSetAddress
 	}
 
-	ok, err := rpc.CheckProverBalance(
+	ok, err := p.chain.CheckProverBalance(
 		ctx,
-		p.rpc,
 		proverAddress,
-		p.TaikoL1Address,
 		new(big.Int).Mul(p.protocolConfigs.LivenessBond, new(big.Int).SetUint64(uint64(len(txLists)))),
 	)
 
@@ -674,6 +714,10 @@ func (p *Proposer) ProposeTxListOntake(
 		}
 	}
 
+	if err := p.checkPreempted(ctx, firstBlockID, 0, proverAddress); err != nil {
+		return err
+	}
+
 	err = RetryOnError(
 		func() error {
 			return p.sendTx(ctx, txCandidate)
@@ -682,6 +726,11 @@ func (p *Proposer) ProposeTxListOntake(
 		3,
 		1*time.Second)
 	if err != nil {
+		// Another proposer's transaction may have landed first.
+		preemptedErr := p.checkPreempted(ctx, firstBlockID, uint64(len(txListsBytesArray)), proverAddress)
+		if errors.Is(preemptedErr, errProposingPreempted) {
+			return preemptedErr
+		}
 		return err
 	}
 	p.initDone = true
@@ -777,21 +826,22 @@ func (p *Proposer) chooseCheaperTransaction(
 	return txCallData, calldataTxCost, nil
 }
 
-// compressTxLists compresses transaction lists and returns compressed bytes array and transaction counts
-func (p *Proposer) compressTxLists(txLists []types.Transactions) ([][]byte, int, error) {
+// compressTxLists compresses transaction lists and returns compressed bytes array and transaction counts,
+// the given transaction lists will be proposed as the blocks starting from firstBlockID.
+func (p *Proposer) compressTxLists(txLists []types.Transactions, firstBlockID uint64) ([][]byte, int, error) {
 	var (
 		txListsBytesArray [][]byte
 		txNums            []int
 		totalTxs          int
 	)
 
-	for _, txs := range txLists {
+	for i, txs := range txLists {
 		txListBytes, err := rlp.EncodeToBytes(txs)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to encode transactions: %w", err)
 		}
 
-		compressedTxListBytes, err := utils.Compress(txListBytes)
+		compressedTxListBytes, err := p.txListCodec(firstBlockID + uint64(i)).Compress(txListBytes)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -806,6 +856,16 @@ func (p *Proposer) compressTxLists(txLists []types.Transactions) ([][]byte, int,
 	return txListsBytesArray, totalTxs, nil
 }
 
+// txListCodec returns the compression codec of the transactions list of the given block, the codec
+// set by `--txList.codec` flag takes precedence over the chain config codec forks.
+func (p *Proposer) txListCodec(blockID uint64) compression.Codec {
+	if p.TxListCodec != nil {
+		return p.TxListCodec
+	}
+
+	return p.chainConfig.TxListCodec(new(big.Int).SetUint64(blockID))
+}
+
 // updateProposingTicker updates the internal proposing timer.
 func (p *Proposer) updateProposingTicker() {
 	if p.proposingTimer != nil {
@@ -821,9 +881,100 @@ func (p *Proposer) updateProposingTicker() {
 		duration = time.Duration(randomSeconds) * time.Second
 	}
 
+	if p.slotScheduler != nil {
+		delay, err := p.slotScheduler.NextProposingDelay(p.ctx, p.now(), duration)
+		if err != nil {
+			log.Warn("Failed to get the next assigned L1 slot", "error", err)
+		} else {
+			duration = delay
+		}
+	}
+
 	p.proposingTimer = time.NewTimer(duration)
 }
 
+// newSlotScheduler creates a new L1 slots scheduler, based on the configured PreconfWhitelist
+// contract, or the configured lookahead proposers.
+func (p *Proposer) newSlotScheduler() (*lookahead.Scheduler, error) {
+	if p.rpc.L1Beacon == nil {
+		return nil, errors.New("L1 beacon endpoint is required for the lookahead")
+	}
+
+	var (
+		l              lookahead.Lookahead
+		genesisTime    = p.rpc.L1Beacon.GenesisTime()
+		secondsPerSlot = p.rpc.L1Beacon.SecondsPerSlot()
+	)
+	if p.LookaheadWhitelistAddress != rpc.ZeroAddress {
+		whitelist, err := lookahead.NewWhitelistCaller(p.LookaheadWhitelistAddress, p.rpc.L1)
+		if err != nil {
+			return nil, err
+		}
+		l = lookahead.NewWhitelistLookahead(whitelist, p.rpc.L1, genesisTime, secondsPerSlot)
+	} else {
+		if !slices.Contains(p.LookaheadProposers, p.proposerAddress) {
+			return nil, fmt.Errorf("proposer %s is not one of the lookahead proposers", p.proposerAddress)
+		}
+
+		rotation, err := lookahead.NewRotationLookahead(p.LookaheadProposers, p.LookaheadSlotsPerProposer)
+		if err != nil {
+			return nil, err
+		}
+		l = rotation
+	}
+
+	return lookahead.NewScheduler(l, p.proposerAddress, genesisTime, secondsPerSlot), nil
+}
+
+// checkPreempted checks whether the proposer should back off from the ongoing proposing operation,
+// which starts at the given number of proposed blocks, since the L1 slot targeted by a transaction
+// sent now is not assigned to it, or another proposer has proposed in the meantime. sentBlocks is
+// the number of blocks in the batch already sent by the given prover address, which may have
+// landed, so they are not counted as proposed by other proposers.
+func (p *Proposer) checkPreempted(
+	ctx context.Context,
+	numBlocks uint64,
+	sentBlocks uint64,
+	proverAddress common.Address,
+) error {
+	if p.slotScheduler == nil {
+		return nil
+	}
+
+	assigned, err := p.slotScheduler.IsAssigned(ctx, p.now())
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return fmt.Errorf("%w: target L1 slot is not assigned", errProposingPreempted)
+	}
+
+	proposedBlocks, err := p.chain.ProposedBlocks(ctx)
+	if err != nil {
+		return err
+	}
+	if proposedBlocks <= numBlocks {
+		return nil
+	}
+
+	others := proposedBlocks - numBlocks
+	for id := numBlocks; id < proposedBlocks && sentBlocks > 0; id++ {
+		proposer, err := p.chain.BlockProposer(ctx, id)
+		if err != nil {
+			return err
+		}
+		if proposer == proverAddress {
+			others--
+			sentBlocks--
+		}
+	}
+	if others != 0 {
+		return fmt.Errorf("%w: %d blocks proposed by other proposers", errProposingPreempted, others)
+	}
+
+	return nil
+}
+
 // sendTx is the internal function to send a transaction with a selected tx manager.
 func (p *Proposer) sendTx(ctx context.Context, txCandidate *txmgr.TxCandidate) error {
 	txMgr, isPrivate := p.txmgrSelector.Select()
@@ -846,6 +997,14 @@ func (p *Proposer) sendTx(ctx context.Context, txCandidate *txmgr.TxCandidate) e
 	return nil
 }
 
+// now returns the current time of the proposer's clock.
+func (p *Proposer) now() time.Time {
+	if p.clock != nil {
+		return p.clock()
+	}
+	return time.Now()
+}
+
 // Name returns the application name.
 func (p *Proposer) Name() string {
 	return "proposer"
@@ -868,7 +1027,7 @@ func (p *Proposer) isProfitable(totalTransactionFees *big.Int, proposingCosts *b
 
 func (p *Proposer) calculateTotalL2TransactionsFees(txLists []types.Transactions) (*big.Int, error) {
 	totalFeesCollected := new(big.Int)
-	previousHeader, err := p.rpc.L2.HeaderByNumber(p.ctx, nil)
+	previousHeader, err := p.chain.L2HeaderByNumber(p.ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -920,7 +1079,7 @@ func (p *Proposer) getPercentageFromBaseFeeToTheProposer(num *big.Int) *big.Int
 
 func (p *Proposer) getBlobTxCost(txCandidate *txmgr.TxCandidate) (*big.Int, error) {
 	// Get current blob base fee
-	blobBaseFee, err := p.rpc.L1.BlobBaseFee(p.ctx)
+	blobBaseFee, err := p.chain.L1BlobBaseFee(p.ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -939,7 +1098,7 @@ func (p *Proposer) getBlobTxCost(txCandidate *txmgr.TxCandidate) (*big.Int, erro
 func (p *Proposer) getTransactionCost(txCandidate *txmgr.TxCandidate, blobBaseFee *big.Int) (*big.Int, error) {
 	log.Debug("getTransactionCost", "blobBaseFee", blobBaseFee)
 	// Get the current L1 gas price
-	gasPrice, err := p.rpc.L1.SuggestGasPrice(p.ctx)
+	gasPrice, err := p.chain.L1SuggestGasPrice(p.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("getTransactionCost: failed to get gas price: %w", err)
 	}
@@ -969,7 +1128,7 @@ func (p *Proposer) getTransactionCost(txCandidate *txmgr.TxCandidate, blobBaseFe
 		}
 	}
 
-	estimatedGasUsage, err := p.rpc.L1.EstimateGas(p.ctx, msg)
+	estimatedGasUsage, err := p.chain.L1EstimateGas(p.ctx, msg)
 	if err != nil {
 		return nil, fmt.Errorf("getTransactionCost: failed to estimate gas: %w", err)
 	}
@@ -1028,7 +1187,7 @@ func (p *Proposer) estimateTotalCosts(proposingCosts *big.Int) (*big.Int, error)
 		"offChainCosts", p.OffChainCosts,
 	)
 
-	l1GasPrice, err := p.rpc.L1.SuggestGasPrice(p.ctx)
+	l1GasPrice, err := p.chain.L1SuggestGasPrice(p.ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
@@ -27,9 +28,9 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
-	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/lookahead"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
 )
 
@@ -54,6 +55,7 @@ func (s *ProposerTestSuite) SetupTest() {
 		0,
 		nil,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
@@ -129,13 +131,13 @@ func (s *ProposerTestSuite) TestProposeTxLists() {
 
 	txBuilder := builder.NewBlobTransactionBuilder(
 		p.rpc,
-		p.L1ProposerPrivKey,
+		p.signer,
 		cfg.TaikoL1Address,
 		cfg.ProverSetAddress,
 		cfg.L2SuggestedFeeRecipient,
 		cfg.ProposeBlockTxGasLimit,
 		cfg.ExtraData,
-		config.NewChainConfig(s.p.protocolConfigs),
+		s.p.chainConfig,
 	)
 
 	emptyTxListBytes, err := rlp.EncodeToBytes(types.Transactions{})
@@ -641,3 +643,53 @@ func (s *ProposerTestSuite) TestBridgeMessageMonitoring() {
 		s.Equal(0, remainingMsgs, "Pending messages should be cleared after proposing")
 	})
 }
+
+// stubProposedBlocks is a chainBackend with the given proposers of the proposed blocks.
+type stubProposedBlocks struct {
+	chainBackend
+	proposers []common.Address
+}
+
+func (b *stubProposedBlocks) ProposedBlocks(_ context.Context) (uint64, error) {
+	return uint64(len(b.proposers)), nil
+}
+
+func (b *stubProposedBlocks) BlockProposer(_ context.Context, blockID uint64) (common.Address, error) {
+	return b.proposers[blockID], nil
+}
+
+func TestCheckPreempted(t *testing.T) {
+	var (
+		us    = common.HexToAddress("0x01")
+		other = common.HexToAddress("0x02")
+	)
+	rotation, err := lookahead.NewRotationLookahead([]common.Address{us}, 1)
+	require.Nil(t, err)
+
+	p := &Proposer{slotScheduler: lookahead.NewScheduler(rotation, us, 0, 12)}
+	for _, tc := range []struct {
+		name       string
+		proposers  []common.Address
+		numBlocks  uint64
+		sentBlocks uint64
+		preempted  bool
+	}{
+		{"noNewBlocks", []common.Address{other}, 1, 0, false},
+		{"fewerBlocks", []common.Address{other}, 2, 0, false},
+		{"blocksBeforeSending", []common.Address{other, us}, 1, 0, true},
+		{"ownBatch", []common.Address{other, us, us}, 1, 2, false},
+		{"ownBatchAfterOthers", []common.Address{other, other, us, us}, 1, 2, true},
+		{"otherBatch", []common.Address{other, other}, 1, 1, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p.chain = &stubProposedBlocks{proposers: tc.proposers}
+
+			err := p.checkPreempted(context.Background(), tc.numBlocks, tc.sentBlocks, us)
+			if tc.preempted {
+				require.ErrorIs(t, err, errProposingPreempted)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,462 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+)
+
+// SimulationRecording is a recorded sequence of L2 execution engine's transaction pool snapshots
+// and L1 fees, which can be replayed through the proposer to tune the proposing policies offline.
+type SimulationRecording struct {
+	ChainID *big.Int `json:"chainId"`
+	// ProposeExecutionGas is the L1 gas used by a proposing transaction besides its intrinsic gas.
+	ProposeExecutionGas uint64            `json:"proposeExecutionGas"`
+	Steps               []*SimulationStep `json:"steps"`
+}
+
+// SimulationStep is a single proposing epoch of a simulation recording, a step without L2 head
+// inherits the L2 head of its previous step.
+type SimulationStep struct {
+	Timestamp     uint64                  `json:"timestamp"`
+	L1BaseFee     *big.Int                `json:"l1BaseFee"`
+	L1GasTipCap   *big.Int                `json:"l1GasTipCap"`
+	L1BlobBaseFee *big.Int                `json:"l1BlobBaseFee"`
+	L2Head        *SimulationL2Head       `json:"l2Head"`
+	PoolContent   []*miner.PreBuiltTxList `json:"poolContent"`
+}
+
+// SimulationL2Head is the recorded L2 chain head of a simulation step.
+type SimulationL2Head struct {
+	Number   uint64   `json:"number"`
+	GasUsed  uint64   `json:"gasUsed"`
+	GasLimit uint64   `json:"gasLimit"`
+	BaseFee  *big.Int `json:"baseFee"`
+}
+
+// SimulationProposal is a proposing transaction which would have been sent in a simulation step.
+type SimulationProposal struct {
+	Step       int      `json:"step"`
+	Timestamp  uint64   `json:"timestamp"`
+	Blocks     int      `json:"blocks"`
+	Txs        []int    `json:"txs"`
+	Blobs      int      `json:"blobs"`
+	L1Cost     *big.Int `json:"l1Cost"`
+	TotalCosts *big.Int `json:"totalCosts"`
+	Revenue    *big.Int `json:"revenue"`
+	Profit     *big.Int `json:"profit"`
+}
+
+// SimulationStepError is an error returned by the proposing operation of a simulation step.
+type SimulationStepError struct {
+	Step  int    `json:"step"`
+	Error string `json:"error"`
+}
+
+// SimulationReport is the result of a proposer simulation.
+type SimulationReport struct {
+	Steps          int                    `json:"steps"`
+	ProposedBlocks int                    `json:"proposedBlocks"`
+	ProposedTxs    int                    `json:"proposedTxs"`
+	TotalCosts     *big.Int               `json:"totalCosts"`
+	TotalRevenue   *big.Int               `json:"totalRevenue"`
+	Proposals      []*SimulationProposal  `json:"proposals"`
+	Errors         []*SimulationStepError `json:"errors,omitempty"`
+}
+
+// LoadSimulationRecording loads a simulation recording from the given JSON file.
+func LoadSimulationRecording(path string) (*SimulationRecording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read simulation recording: %w", err)
+	}
+
+	recording := new(SimulationRecording)
+	if err := json.Unmarshal(data, recording); err != nil {
+		return nil, fmt.Errorf("failed to decode simulation recording: %w", err)
+	}
+
+	if err := recording.validate(); err != nil {
+		return nil, err
+	}
+
+	return recording, nil
+}
+
+// validate checks the recording, and fills the omitted fields of its steps.
+func (r *SimulationRecording) validate() error {
+	if r.ChainID == nil {
+		return errors.New("missing chain ID in simulation recording")
+	}
+	if len(r.Steps) == 0 {
+		return errors.New("empty simulation recording")
+	}
+
+	for i, step := range r.Steps {
+		if step.L2Head == nil {
+			if i == 0 {
+				return errors.New("missing L2 head in the first simulation step")
+			}
+			step.L2Head = r.Steps[i-1].L2Head
+		}
+		if step.L2Head.GasLimit < 2 || step.L2Head.BaseFee == nil {
+			return fmt.Errorf("invalid L2 head in simulation step %d", i)
+		}
+		if i > 0 && step.Timestamp < r.Steps[i-1].Timestamp {
+			return fmt.Errorf("simulation step %d is earlier than its previous step", i)
+		}
+		if step.L1BaseFee == nil {
+			return fmt.Errorf("missing L1 base fee in simulation step %d", i)
+		}
+		if step.L1GasTipCap == nil {
+			step.L1GasTipCap = common.Big0
+		}
+		if step.L1BlobBaseFee == nil {
+			step.L1BlobBaseFee = common.Big1
+		}
+	}
+
+	return nil
+}
+
+// Simulate replays the given recording through the proposing operation of a proposer with the
+// given configurations, every recorded step is a proposing epoch. The proposing transactions are
+// recorded by a fake transaction manager instead of being sent, and are reported with their
+// estimated costs and revenue.
+func Simulate(ctx context.Context, cfg *Config, recording *SimulationRecording) (*SimulationReport, error) {
+	if err := recording.validate(); err != nil {
+		return nil, err
+	}
+
+	p, txMgr, backend, err := newSimulationProposer(ctx, cfg, recording)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SimulationReport{
+		Steps:        len(recording.Steps),
+		TotalCosts:   new(big.Int),
+		TotalRevenue: new(big.Int),
+	}
+	for i, step := range recording.Steps {
+		backend.step = step
+		p.totalEpochs++
+
+		if err := p.ProposeOp(ctx); err != nil {
+			log.Warn("Simulated proposing operation error", "step", i, "error", err)
+			report.Errors = append(report.Errors, &SimulationStepError{Step: i, Error: err.Error()})
+		}
+
+		for _, candidate := range txMgr.takeCandidates() {
+			proposal, err := p.simulationProposal(i, step, &candidate)
+			if err != nil {
+				return nil, fmt.Errorf("failed to report simulation step %d: %w", i, err)
+			}
+
+			log.Info(
+				"Simulated proposing transaction",
+				"step", proposal.Step,
+				"blocks", proposal.Blocks,
+				"txs", proposal.Txs,
+				"blobs", proposal.Blobs,
+				"l1Cost", utils.WeiToGWei(proposal.L1Cost),
+				"revenue", utils.WeiToGWei(proposal.Revenue),
+				"profit", utils.WeiToGWei(proposal.Profit),
+			)
+
+			report.Proposals = append(report.Proposals, proposal)
+			report.ProposedBlocks += proposal.Blocks
+			for _, txs := range proposal.Txs {
+				report.ProposedTxs += txs
+			}
+			report.TotalCosts.Add(report.TotalCosts, proposal.TotalCosts)
+			report.TotalRevenue.Add(report.TotalRevenue, proposal.Revenue)
+		}
+	}
+
+	return report, nil
+}
+
+// SimulateFromCli runs a proposer simulation based on the command line flags, and writes the
+// report to the report file, or stdout if it's not set.
+func SimulateFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewSimulationConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	recording, err := LoadSimulationRecording(c.String(flags.SimulationRecording.Name))
+	if err != nil {
+		return err
+	}
+
+	report, err := Simulate(ctx, cfg, recording)
+	if err != nil {
+		return err
+	}
+
+	log.Info(
+		"Proposer simulation finished",
+		"steps", report.Steps,
+		"proposals", len(report.Proposals),
+		"blocks", report.ProposedBlocks,
+		"txs", report.ProposedTxs,
+		"totalCosts", utils.WeiToGWei(report.TotalCosts),
+		"totalRevenue", utils.WeiToGWei(report.TotalRevenue),
+		"errors", len(report.Errors),
+	)
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if path := c.String(flags.SimulationReport.Name); path != "" {
+		return os.WriteFile(path, data, 0o600)
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, string(data))
+	return err
+}
+
+// newSimulationProposer creates a proposer which is backed by the given recording and a fake
+// transaction manager.
+func newSimulationProposer(
+	ctx context.Context,
+	cfg *Config,
+	recording *SimulationRecording,
+) (*Proposer, *simulationTxMgr, *simulationBackend, error) {
+	if cfg.ClientConfig == nil {
+		cfg.ClientConfig = new(rpc.ClientConfig)
+	}
+
+	proposerPrivKey := cfg.L1ProposerPrivKey
+	if proposerPrivKey == nil {
+		var err error
+		if proposerPrivKey, err = crypto.GenerateKey(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	protocolConfigs := encoding.GetProtocolConfig(recording.ChainID.Uint64())
+	chainConfig, err := config.NewChainConfig(protocolConfigs, cfg.TxListCodecForks...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		backend = &simulationBackend{recording: recording, chainConfig: chainConfig}
+		txMgr   = &simulationTxMgr{from: crypto.PubkeyToAddress(proposerPrivKey.PublicKey), backend: backend}
+		startAt = time.Unix(int64(recording.Steps[0].Timestamp), 0)
+	)
+
+	p := &Proposer{
+		Config:             cfg,
+		chain:              backend,
+		proposerAddress:    txMgr.from,
+		protocolConfigs:    protocolConfigs,
+		chainConfig:        chainConfig,
+		lastProposedAt:     startAt,
+		txmgrSelector:      utils.NewTxManagerSelector(txMgr, nil, nil),
+		ctx:                ctx,
+		checkProfitability: cfg.CheckProfitability,
+		allowEmptyBlocks:   cfg.AllowEmptyBlocks,
+		clock: func() time.Time {
+			if backend.step == nil {
+				return startAt
+			}
+			return time.Unix(int64(backend.step.Timestamp), 0)
+		},
+	}
+
+	p.txCallDataBuilder, p.txBlobBuilder = newSimulationTxBuilders(
+		cfg,
+		signer.NewLocalSigner(proposerPrivKey),
+		chainConfig,
+	)
+	p.defaultTxBuilder = p.txCallDataBuilder
+	if p.txBlobBuilder != nil {
+		p.defaultTxBuilder = p.txBlobBuilder
+	}
+
+	return p, txMgr, backend, nil
+}
+
+// newSimulationTxBuilders creates the transaction builders without RPC client for simulations.
+func newSimulationTxBuilders(
+	cfg *Config,
+	proposerSigner signer.Signer,
+	chainConfig *config.ChainConfig,
+) (builder.ProposeBlockTransactionBuilder, builder.ProposeBlockTransactionBuilder) {
+	callDataBuilder := builder.NewCalldataTransactionBuilder(
+		nil,
+		proposerSigner,
+		cfg.L2SuggestedFeeRecipient,
+		cfg.TaikoL1Address,
+		cfg.ProverSetAddress,
+		cfg.ProposeBlockTxGasLimit,
+		cfg.ExtraData,
+		chainConfig,
+	)
+	if !cfg.BlobAllowed {
+		return callDataBuilder, nil
+	}
+
+	return callDataBuilder, builder.NewBlobTransactionBuilder(
+		nil,
+		proposerSigner,
+		cfg.TaikoL1Address,
+		cfg.ProverSetAddress,
+		cfg.L2SuggestedFeeRecipient,
+		cfg.ProposeBlockTxGasLimit,
+		cfg.ExtraData,
+		chainConfig,
+	)
+}
+
+// simulationProposal estimates the costs and revenue of the given recorded proposing transaction
+// in the same way as the profitability check of the proposer.
+func (p *Proposer) simulationProposal(
+	step int,
+	simulationStep *SimulationStep,
+	candidate *txmgr.TxCandidate,
+) (*SimulationProposal, error) {
+	firstBlockID, err := p.chain.ProposedBlocks(p.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txLists, err := decodeProposedTxLists(candidate, func(i int) compression.Codec {
+		return p.txListCodec(firstBlockID + uint64(i))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, err := p.calculateTotalL2TransactionsFees(txLists)
+	if err != nil {
+		return nil, err
+	}
+
+	var l1Cost *big.Int
+	if len(candidate.Blobs) != 0 {
+		l1Cost, err = p.getBlobTxCost(candidate)
+	} else {
+		l1Cost, err = p.getTransactionCost(candidate, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	totalCosts, err := p.estimateTotalCosts(l1Cost)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &SimulationProposal{
+		Step:       step,
+		Timestamp:  simulationStep.Timestamp,
+		Blocks:     len(txLists),
+		Blobs:      len(candidate.Blobs),
+		L1Cost:     l1Cost,
+		TotalCosts: totalCosts,
+		Revenue:    revenue,
+		Profit:     new(big.Int).Sub(revenue, totalCosts),
+	}
+	for _, txs := range txLists {
+		proposal.Txs = append(proposal.Txs, len(txs))
+	}
+
+	return proposal, nil
+}
+
+// decodeProposedTxLists decodes the transactions lists proposed by the given
+// TaikoL1.proposeBlocksV2 / ProverSet.proposeBlocksV2 transaction candidate, the i-th
+// transactions list is decompressed by the codec returned by codecOf(i).
+func decodeProposedTxLists(
+	candidate *txmgr.TxCandidate,
+	codecOf func(i int) compression.Codec,
+) ([]types.Transactions, error) {
+	if len(candidate.TxData) < 4 {
+		return nil, errors.New("invalid proposing transaction data")
+	}
+
+	method, err := encoding.TaikoL1ABI.MethodById(candidate.TxData[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(candidate.TxData[4:])
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != 2 {
+		return nil, fmt.Errorf("unexpected proposing transaction method: %s", method.Name)
+	}
+	paramsArray, ok := args[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected proposing transaction method: %s", method.Name)
+	}
+	txListBytesArray, ok := args[1].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected proposing transaction method: %s", method.Name)
+	}
+
+	var blobsData [][]byte
+	for _, blob := range candidate.Blobs {
+		data, err := blob.ToData()
+		if err != nil {
+			return nil, err
+		}
+		blobsData = append(blobsData, data)
+	}
+
+	txLists := make([]types.Transactions, 0, len(txListBytesArray))
+	for i, txListBytes := range txListBytesArray {
+		if len(blobsData) != 0 {
+			params, err := encoding.DecodeBlockParamsOntake(paramsArray[i])
+			if err != nil {
+				return nil, err
+			}
+			if int(params.BlobIndex) >= len(blobsData) ||
+				int(params.BlobTxListOffset+params.BlobTxListLength) > len(blobsData[params.BlobIndex]) {
+				return nil, fmt.Errorf("invalid blob slot of the transactions list %d", i)
+			}
+			txListBytes = blobsData[params.BlobIndex][params.BlobTxListOffset : params.BlobTxListOffset+params.BlobTxListLength]
+		}
+
+		decompressed, err := codecOf(i).Decompress(txListBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		var txs types.Transactions
+		if err := rlp.DecodeBytes(decompressed, &txs); err != nil {
+			return nil, err
+		}
+		txLists = append(txLists, txs)
+	}
+
+	return txLists, nil
+}
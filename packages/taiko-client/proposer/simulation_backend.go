@@ -0,0 +1,252 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+)
+
+var errNoSimulationStep = errors.New("no simulation step in progress")
+
+// simulationBackend is the chainBackend implementation which serves the recorded L2 transaction
+// pool snapshots and L1 fees of the current simulation step.
+type simulationBackend struct {
+	recording   *SimulationRecording
+	chainConfig *config.ChainConfig
+	step        *SimulationStep
+}
+
+// WaitTillL2ExecutionEngineSynced implements the chainBackend interface.
+func (b *simulationBackend) WaitTillL2ExecutionEngineSynced(_ context.Context) error {
+	return nil
+}
+
+// GetPoolContent implements the chainBackend interface, it applies the given tip and transactions
+// lists number limits to the recorded snapshot.
+func (b *simulationBackend) GetPoolContent(
+	_ context.Context,
+	_ common.Address,
+	_ uint32,
+	_ uint64,
+	_ []common.Address,
+	maxTransactionsLists uint64,
+	minTip uint64,
+	_ *config.ChainConfig,
+) ([]*miner.PreBuiltTxList, error) {
+	if b.step == nil {
+		return nil, errNoSimulationStep
+	}
+
+	var poolContent []*miner.PreBuiltTxList
+	for _, txList := range b.step.PoolContent {
+		if uint64(len(poolContent)) >= maxTransactionsLists {
+			break
+		}
+
+		filtered, err := filterByMinTip(txList, b.step.L2Head.BaseFee, minTip)
+		if err != nil {
+			return nil, err
+		}
+		if len(filtered.TxList) != 0 {
+			poolContent = append(poolContent, filtered)
+		}
+	}
+
+	return poolContent, nil
+}
+
+// L2ChainID implements the chainBackend interface.
+func (b *simulationBackend) L2ChainID() *big.Int {
+	return b.recording.ChainID
+}
+
+// L2HeaderByNumber implements the chainBackend interface, only the recorded L2 head is available.
+func (b *simulationBackend) L2HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if b.step == nil {
+		return nil, errNoSimulationStep
+	}
+	if number != nil && number.Uint64() != b.step.L2Head.Number {
+		return nil, ethereum.NotFound
+	}
+
+	return &types.Header{
+		Number:   new(big.Int).SetUint64(b.step.L2Head.Number),
+		GasUsed:  b.step.L2Head.GasUsed,
+		GasLimit: b.step.L2Head.GasLimit,
+		BaseFee:  b.step.L2Head.BaseFee,
+		Time:     b.step.Timestamp,
+	}, nil
+}
+
+// ProposedBlocks implements the chainBackend interface, the simulation only supports the chain
+// after ontake fork.
+func (b *simulationBackend) ProposedBlocks(_ context.Context) (uint64, error) {
+	return b.chainConfig.ProtocolConfigs.OntakeForkHeight, nil
+}
+
+// BlockProposer implements the chainBackend interface, no block is proposed in simulations.
+func (b *simulationBackend) BlockProposer(_ context.Context, _ uint64) (common.Address, error) {
+	return common.Address{}, ethereum.NotFound
+}
+
+// CheckProverBalance implements the chainBackend interface.
+func (b *simulationBackend) CheckProverBalance(_ context.Context, _ common.Address, _ *big.Int) (bool, error) {
+	return true, nil
+}
+
+// L1SuggestGasPrice implements the chainBackend interface.
+func (b *simulationBackend) L1SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	if b.step == nil {
+		return nil, errNoSimulationStep
+	}
+
+	return new(big.Int).Add(b.step.L1BaseFee, b.step.L1GasTipCap), nil
+}
+
+// L1BlobBaseFee implements the chainBackend interface.
+func (b *simulationBackend) L1BlobBaseFee(_ context.Context) (*big.Int, error) {
+	if b.step == nil {
+		return nil, errNoSimulationStep
+	}
+
+	return b.step.L1BlobBaseFee, nil
+}
+
+// L1EstimateGas implements the chainBackend interface, the estimated gas is the intrinsic gas of
+// the given call data, plus the recorded execution gas of a proposing transaction.
+func (b *simulationBackend) L1EstimateGas(_ context.Context, msg ethereum.CallMsg) (uint64, error) {
+	gas := params.TxGas + b.recording.ProposeExecutionGas
+	for _, byt := range msg.Data {
+		if byt == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return gas, nil
+}
+
+// filterByMinTip removes the transactions whose effective tip is lower than the given minimum tip
+// from the given transactions list, the estimated gas used and bytes length of the list will be
+// recalculated if any transaction is removed.
+func filterByMinTip(txList *miner.PreBuiltTxList, baseFee *big.Int, minTip uint64) (*miner.PreBuiltTxList, error) {
+	if minTip == 0 {
+		return txList, nil
+	}
+
+	var (
+		filtered = &miner.PreBuiltTxList{}
+		tip      = new(big.Int).SetUint64(minTip)
+	)
+	for _, tx := range txList.TxList {
+		effectiveTip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil || effectiveTip.Cmp(tip) < 0 {
+			continue
+		}
+		filtered.TxList = append(filtered.TxList, tx)
+		filtered.EstimatedGasUsed += tx.Gas()
+	}
+
+	if len(filtered.TxList) == len(txList.TxList) {
+		return txList, nil
+	}
+
+	txListBytes, err := rlp.EncodeToBytes(filtered.TxList)
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := utils.Compress(txListBytes)
+	if err != nil {
+		return nil, err
+	}
+	filtered.BytesLength = uint64(len(compressed))
+
+	return filtered, nil
+}
+
+// simulationTxMgr is a txmgr.TxManager implementation which records the sent transaction
+// candidates instead of sending them to L1.
+type simulationTxMgr struct {
+	from       common.Address
+	backend    *simulationBackend
+	candidates []txmgr.TxCandidate
+	sent       uint64
+	mu         sync.Mutex
+}
+
+// Send implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) Send(_ context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.candidates = append(m.candidates, candidate)
+	m.sent++
+
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		TxHash: crypto.Keccak256Hash(m.from.Bytes(), new(big.Int).SetUint64(m.sent).Bytes()),
+	}, nil
+}
+
+// SendAsync implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) SendAsync(ctx context.Context, candidate txmgr.TxCandidate, ch chan txmgr.SendResponse) {
+	receipt, err := m.Send(ctx, candidate)
+	ch <- txmgr.SendResponse{Receipt: receipt, Err: err}
+}
+
+// From implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) From() common.Address {
+	return m.from
+}
+
+// BlockNumber implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) BlockNumber(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+// API implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) API() gethRPC.API {
+	return gethRPC.API{}
+}
+
+// Close implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) Close() {}
+
+// IsClosed implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) IsClosed() bool {
+	return false
+}
+
+// SuggestGasPriceCaps implements the txmgr.TxManager interface.
+func (m *simulationTxMgr) SuggestGasPriceCaps(_ context.Context) (*big.Int, *big.Int, *big.Int, error) {
+	if m.backend.step == nil {
+		return nil, nil, nil, errNoSimulationStep
+	}
+
+	return m.backend.step.L1GasTipCap, m.backend.step.L1BaseFee, m.backend.step.L1BlobBaseFee, nil
+}
+
+// takeCandidates returns and clears the recorded transaction candidates.
+func (m *simulationTxMgr) takeCandidates() []txmgr.TxCandidate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	candidates := m.candidates
+	m.candidates = nil
+	return candidates
+}
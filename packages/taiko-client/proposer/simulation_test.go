@@ -0,0 +1,161 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/compression"
+)
+
+func newTestRecording(t *testing.T, steps int) *SimulationRecording {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var (
+		chainID   = big.NewInt(167001)
+		signer    = types.LatestSignerForChainID(chainID)
+		recording = &SimulationRecording{ChainID: chainID, ProposeExecutionGas: 100_000}
+		to        = common.HexToAddress("0x1")
+	)
+	for i := 0; i < steps; i++ {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(int64(i+1) * 1_000_000_000),
+			GasFeeCap: big.NewInt(100_000_000_000),
+			Gas:       21_000,
+			To:        &to,
+		})
+		require.Nil(t, err)
+
+		recording.Steps = append(recording.Steps, &SimulationStep{
+			Timestamp: uint64(1_000 + i*12),
+			L1BaseFee: big.NewInt(1_000_000_000),
+			L2Head:    &SimulationL2Head{Number: 100, GasUsed: 1_000_000, GasLimit: 2_000_000, BaseFee: common.Big1},
+			PoolContent: []*miner.PreBuiltTxList{
+				{TxList: types.Transactions{tx}, EstimatedGasUsed: tx.Gas(), BytesLength: 100},
+			},
+		})
+	}
+
+	return recording
+}
+
+func newTestSimulationConfig() *Config {
+	return &Config{
+		MaxProposedTxListsPerEpoch: 1,
+		AllowEmptyBlocks:           true,
+		OffChainCosts:              common.Big0,
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	report, err := Simulate(context.Background(), newTestSimulationConfig(), newTestRecording(t, 3))
+	require.Nil(t, err)
+	require.Empty(t, report.Errors)
+	require.Equal(t, 3, report.Steps)
+	require.Len(t, report.Proposals, 3)
+	require.Equal(t, 3, report.ProposedBlocks)
+	require.Equal(t, 3, report.ProposedTxs)
+
+	for i, proposal := range report.Proposals {
+		require.Equal(t, i, proposal.Step)
+		require.Equal(t, []int{1}, proposal.Txs)
+		require.Zero(t, proposal.Blobs)
+		require.Equal(t, 1, proposal.L1Cost.Sign())
+		// The revenue is the tip of the only transaction.
+		require.Equal(t, big.NewInt(int64(i+1)*1_000_000_000*21_000), proposal.Revenue)
+		require.Equal(t, new(big.Int).Sub(proposal.Revenue, proposal.TotalCosts), proposal.Profit)
+	}
+}
+
+func TestSimulateMinGasUsed(t *testing.T) {
+	cfg := newTestSimulationConfig()
+	cfg.MinGasUsed = 1_000_000
+	cfg.MinTxListBytes = 1_000
+	cfg.MinProposingInternal = 30 * time.Second
+
+	report, err := Simulate(context.Background(), cfg, newTestRecording(t, 4))
+	require.Nil(t, err)
+
+	// Small transactions lists are only proposed once the minimum proposing interval is reached.
+	require.Len(t, report.Proposals, 1)
+	require.Equal(t, 3, report.Proposals[0].Step)
+}
+
+func TestSimulateProfitability(t *testing.T) {
+	cfg := newTestSimulationConfig()
+	cfg.CheckProfitability = true
+	cfg.GasNeededForProvingBlock = 1_000_000
+
+	recording := newTestRecording(t, 3)
+	// Proving costs exceed the fees unless the L1 gas price drops.
+	recording.Steps[2].L1BaseFee = big.NewInt(1)
+
+	report, err := Simulate(context.Background(), cfg, recording)
+	require.Nil(t, err)
+	require.Len(t, report.Proposals, 1)
+	require.Equal(t, 2, report.Proposals[0].Step)
+	require.Equal(t, 1, report.Proposals[0].Profit.Sign())
+}
+
+func TestSimulateBlob(t *testing.T) {
+	cfg := newTestSimulationConfig()
+	cfg.BlobAllowed = true
+	cfg.MaxProposedTxListsPerEpoch = 2
+
+	recording := newTestRecording(t, 2)
+	recording.Steps[0].PoolContent = append(recording.Steps[0].PoolContent, recording.Steps[1].PoolContent...)
+	recording.Steps = recording.Steps[:1]
+
+	report, err := Simulate(context.Background(), cfg, recording)
+	require.Nil(t, err)
+	require.Len(t, report.Proposals, 1)
+	// Both transactions lists are packed into a single blob.
+	require.Equal(t, 1, report.Proposals[0].Blobs)
+	require.Equal(t, []int{1, 1}, report.Proposals[0].Txs)
+}
+
+func TestSimulateTxListCodec(t *testing.T) {
+	for _, codec := range []compression.Codec{compression.Brotli, compression.Zstd} {
+		cfg := newTestSimulationConfig()
+		cfg.BlobAllowed = true
+		cfg.TxListCodec = codec
+
+		report, err := Simulate(context.Background(), cfg, newTestRecording(t, 1))
+		require.Nil(t, err, codec.Name())
+		require.Len(t, report.Proposals, 1)
+		require.Equal(t, []int{1}, report.Proposals[0].Txs)
+	}
+}
+
+func TestLoadSimulationRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	require.Nil(t, os.WriteFile(path, []byte(`{"chainId":167001,"steps":[]}`), 0o600))
+	_, err := LoadSimulationRecording(path)
+	require.ErrorContains(t, err, "empty simulation recording")
+
+	require.Nil(t, os.WriteFile(path, []byte(`{
+		"chainId": 167001,
+		"steps": [
+			{"timestamp": 1, "l1BaseFee": 10, "l2Head": {"number": 1, "gasLimit": 100, "baseFee": 1}},
+			{"timestamp": 2, "l1BaseFee": 10}
+		]
+	}`), 0o600))
+	recording, err := LoadSimulationRecording(path)
+	require.Nil(t, err)
+	require.Len(t, recording.Steps, 2)
+	require.Equal(t, recording.Steps[0].L2Head, recording.Steps[1].L2Head)
+	require.Zero(t, recording.Steps[1].L1GasTipCap.Sign())
+}
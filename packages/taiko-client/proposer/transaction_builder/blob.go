@@ -2,29 +2,27 @@ package builder
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // BlobTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
 // bytes saved in blob.
 type BlobTransactionBuilder struct {
 	rpc                     *rpc.Client
-	proposerPrivateKey      *ecdsa.PrivateKey
+	signer                  signer.Signer
 	taikoL1Address          common.Address
 	proverSetAddress        common.Address
 	l2SuggestedFeeRecipient common.Address
@@ -36,7 +34,7 @@ type BlobTransactionBuilder struct {
 // NewBlobTransactionBuilder creates a new BlobTransactionBuilder instance based on giving configurations.
 func NewBlobTransactionBuilder(
 	rpc *rpc.Client,
-	proposerPrivateKey *ecdsa.PrivateKey,
+	signer signer.Signer,
 	taikoL1Address common.Address,
 	proverSetAddress common.Address,
 	l2SuggestedFeeRecipient common.Address,
@@ -46,7 +44,7 @@ func NewBlobTransactionBuilder(
 ) *BlobTransactionBuilder {
 	return &BlobTransactionBuilder{
 		rpc,
-		proposerPrivateKey,
+		signer,
 		taikoL1Address,
 		proverSetAddress,
 		l2SuggestedFeeRecipient,
@@ -62,13 +60,13 @@ func (b *BlobTransactionBuilder) BuildLegacy(
 	includeParentMetaHash bool,
 	txListBytes []byte,
 ) (*txmgr.TxCandidate, error) {
-	// Check if the current L2 chain is after ontake fork.
-	state, err := rpc.GetProtocolStateVariables(b.rpc.TaikoL1, &bind.CallOpts{Context: ctx})
+	// Check if the current L2 chain is before ontake fork.
+	ontake, err := isOntake(ctx, b.rpc, b.chainConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if b.chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)) {
+	if ontake {
 		return nil, fmt.Errorf("legacy transaction builder is not supported after ontake fork")
 	}
 
@@ -83,7 +81,9 @@ func (b *BlobTransactionBuilder) BuildLegacy(
 	}
 	blobHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
 
-	signature, err := crypto.Sign(blobHash[:], b.proposerPrivateKey)
+	// The signature is deprecated and ignored by the protocol, so here we just sign the keccak256 hash of
+	// the blob hash, to be compatible with the remote signers.
+	signature, err := b.signer.SignData(ctx, blobHash[:])
 	if err != nil {
 		return nil, err
 	}
@@ -147,12 +147,12 @@ func (b *BlobTransactionBuilder) BuildOntake(
 ) (*txmgr.TxCandidate, error) {
 	log.Debug("Building blob tx ontake")
 	// Check if the current L2 chain is after ontake fork.
-	state, err := rpc.GetProtocolStateVariables(b.rpc.TaikoL1, &bind.CallOpts{Context: ctx})
+	ontake, err := isOntake(ctx, b.rpc, b.chainConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if !b.chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)) {
+	if !ontake {
 		return nil, fmt.Errorf("ontake transaction builder is not supported before ontake fork")
 	}
 
@@ -160,29 +160,29 @@ func (b *BlobTransactionBuilder) BuildOntake(
 	var (
 		to                 = &b.taikoL1Address
 		data               []byte
-		blobs              []*eth.Blob
 		encodedParamsArray [][]byte
 	)
 	if b.proverSetAddress != rpc.ZeroAddress {
 		to = &b.proverSetAddress
 	}
 
-	for i := range txListBytesArray {
-		var blob = &eth.Blob{}
-		if err := blob.FromData(txListBytesArray[i]); err != nil {
-			return nil, err
-		}
+	// Pack the transactions lists tightly into blobs.
+	blobs, slots, err := packTxLists(txListBytesArray)
+	if err != nil {
+		return nil, err
+	}
 
-		blobs = append(blobs, blob)
+	log.Debug("Packed transactions lists into blobs", "txLists", len(txListBytesArray), "blobs", len(blobs))
 
+	for _, slot := range slots {
 		encodedParams, err := encoding.EncodeBlockParamsOntake(&encoding.BlockParamsV2{
 			Coinbase:         b.l2SuggestedFeeRecipient,
 			ParentMetaHash:   [32]byte{},
 			AnchorBlockId:    0,
 			Timestamp:        0,
-			BlobTxListOffset: 0,
-			BlobTxListLength: uint32(len(txListBytesArray[i])),
-			BlobIndex:        uint8(i),
+			BlobTxListOffset: slot.Offset,
+			BlobTxListLength: slot.Length,
+			BlobIndex:        slot.BlobIndex,
 		})
 		if err != nil {
 			return nil, err
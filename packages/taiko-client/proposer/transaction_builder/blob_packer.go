@@ -0,0 +1,80 @@
+package builder
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxBlobsPerTransaction is the maximum number of blobs which can be attached to a single
+// EIP-4844 transaction.
+const maxBlobsPerTransaction = 6
+
+// blobSlot is the position of a compressed transactions list in the blobs of a proposing transaction.
+type blobSlot struct {
+	BlobIndex uint8
+	Offset    uint32
+	Length    uint32
+}
+
+// packTxLists packs the given compressed transactions lists into as few blobs as possible. Since
+// every L2 block can only reference a single blob, each transactions list must fit in one blob,
+// the lists are placed by the first-fit decreasing strategy, so the large lists are spread across
+// multiple blobs while the small lists fill up the remaining blob space. The returned slots are in
+// the same order as the given lists.
+func packTxLists(txListBytesArray [][]byte) ([]*eth.Blob, []*blobSlot, error) {
+	var (
+		order    = make([]int, len(txListBytesArray))
+		slots    = make([]*blobSlot, len(txListBytesArray))
+		blobData [][]byte
+	)
+	for i, txListBytes := range txListBytesArray {
+		if len(txListBytes) > eth.MaxBlobDataSize {
+			return nil, nil, fmt.Errorf(
+				"transactions list %d is too large to fit in a blob: %d > %d",
+				i,
+				len(txListBytes),
+				eth.MaxBlobDataSize,
+			)
+		}
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(txListBytesArray[order[i]]) > len(txListBytesArray[order[j]])
+	})
+
+	for _, i := range order {
+		blobIndex := len(blobData)
+		for j, data := range blobData {
+			if len(data)+len(txListBytesArray[i]) <= eth.MaxBlobDataSize {
+				blobIndex = j
+				break
+			}
+		}
+		if blobIndex == len(blobData) {
+			if len(blobData) == maxBlobsPerTransaction {
+				return nil, nil, fmt.Errorf("transactions lists don't fit in %d blobs", maxBlobsPerTransaction)
+			}
+			blobData = append(blobData, []byte{})
+		}
+
+		slots[i] = &blobSlot{
+			BlobIndex: uint8(blobIndex),
+			Offset:    uint32(len(blobData[blobIndex])),
+			Length:    uint32(len(txListBytesArray[i])),
+		}
+		blobData[blobIndex] = append(blobData[blobIndex], txListBytesArray[i]...)
+	}
+
+	blobs := make([]*eth.Blob, 0, len(blobData))
+	for _, data := range blobData {
+		var blob = &eth.Blob{}
+		if err := blob.FromData(data); err != nil {
+			return nil, nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+
+	return blobs, slots, nil
+}
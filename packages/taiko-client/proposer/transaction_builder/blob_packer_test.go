@@ -0,0 +1,47 @@
+package builder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackTxLists(t *testing.T) {
+	txLists := [][]byte{
+		bytes.Repeat([]byte{1}, eth.MaxBlobDataSize/4),
+		bytes.Repeat([]byte{2}, eth.MaxBlobDataSize*3/4),
+		bytes.Repeat([]byte{3}, eth.MaxBlobDataSize/2),
+		bytes.Repeat([]byte{4}, eth.MaxBlobDataSize/4),
+	}
+
+	blobs, slots, err := packTxLists(txLists)
+	require.Nil(t, err)
+	require.Len(t, blobs, 2)
+	require.Len(t, slots, len(txLists))
+
+	// The largest list is placed first, and the smaller lists fill up the remaining space.
+	require.Equal(t, &blobSlot{BlobIndex: 0, Offset: 0, Length: uint32(len(txLists[1]))}, slots[1])
+	require.Equal(t, &blobSlot{BlobIndex: 1, Offset: 0, Length: uint32(len(txLists[2]))}, slots[2])
+	require.Equal(t, uint8(0), slots[0].BlobIndex)
+	require.Equal(t, uint8(1), slots[3].BlobIndex)
+
+	for i, slot := range slots {
+		data, err := blobs[slot.BlobIndex].ToData()
+		require.Nil(t, err)
+		require.Equal(t, txLists[i], []byte(data[slot.Offset:slot.Offset+slot.Length]))
+	}
+}
+
+func TestPackTxListsTooLarge(t *testing.T) {
+	_, _, err := packTxLists([][]byte{make([]byte, eth.MaxBlobDataSize+1)})
+	require.ErrorContains(t, err, "too large to fit in a blob")
+
+	var txLists [][]byte
+	for i := 0; i <= maxBlobsPerTransaction; i++ {
+		txLists = append(txLists, make([]byte, eth.MaxBlobDataSize))
+	}
+	_, _, err = packTxLists(txLists)
+	require.ErrorContains(t, err, "don't fit in")
+}
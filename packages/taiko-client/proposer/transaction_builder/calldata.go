@@ -2,26 +2,24 @@ package builder
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // CalldataTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
 // bytes saved in calldata.
 type CalldataTransactionBuilder struct {
 	rpc                     *rpc.Client
-	proposerPrivateKey      *ecdsa.PrivateKey
+	signer                  signer.Signer
 	l2SuggestedFeeRecipient common.Address
 	taikoL1Address          common.Address
 	proverSetAddress        common.Address
@@ -33,7 +31,7 @@ type CalldataTransactionBuilder struct {
 // NewCalldataTransactionBuilder creates a new CalldataTransactionBuilder instance based on giving configurations.
 func NewCalldataTransactionBuilder(
 	rpc *rpc.Client,
-	proposerPrivateKey *ecdsa.PrivateKey,
+	signer signer.Signer,
 	l2SuggestedFeeRecipient common.Address,
 	taikoL1Address common.Address,
 	proverSetAddress common.Address,
@@ -43,7 +41,7 @@ func NewCalldataTransactionBuilder(
 ) *CalldataTransactionBuilder {
 	return &CalldataTransactionBuilder{
 		rpc,
-		proposerPrivateKey,
+		signer,
 		l2SuggestedFeeRecipient,
 		taikoL1Address,
 		proverSetAddress,
@@ -60,12 +58,12 @@ func (b *CalldataTransactionBuilder) BuildLegacy(
 	txListBytes []byte,
 ) (*txmgr.TxCandidate, error) {
 	// Check if the current L2 chain is before ontake fork.
-	state, err := rpc.GetProtocolStateVariables(b.rpc.TaikoL1, &bind.CallOpts{Context: ctx})
+	ontake, err := isOntake(ctx, b.rpc, b.chainConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if b.chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)) {
+	if ontake {
 		return nil, fmt.Errorf("legacy transaction builder is not supported after ontake fork")
 	}
 
@@ -83,7 +81,7 @@ func (b *CalldataTransactionBuilder) BuildLegacy(
 		}
 	}
 
-	signature, err := crypto.Sign(crypto.Keccak256(txListBytes), b.proposerPrivateKey)
+	signature, err := b.signer.SignData(ctx, txListBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -137,12 +135,12 @@ func (b *CalldataTransactionBuilder) BuildOntake(
 ) (*txmgr.TxCandidate, error) {
 	log.Debug("Building calldata tx ontake")
 	// Check if the current L2 chain is after ontake fork.
-	state, err := rpc.GetProtocolStateVariables(b.rpc.TaikoL1, &bind.CallOpts{Context: ctx})
+	ontake, err := isOntake(ctx, b.rpc, b.chainConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if !b.chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)) {
+	if !ontake {
 		return nil, fmt.Errorf("ontake transaction builder is not supported before ontake fork")
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -33,6 +34,21 @@ func getParentMetaHash(ctx context.Context, rpc *rpc.Client, forkHeight *big.Int
 	return parent.MetaHash, nil
 }
 
+// isOntake returns whether the current L2 chain is after ontake fork. A builder without RPC client,
+// which is used by the proposer simulation, always assumes that the ontake fork is activated.
+func isOntake(ctx context.Context, cli *rpc.Client, chainConfig *config.ChainConfig) (bool, error) {
+	if cli == nil {
+		return true, nil
+	}
+
+	state, err := rpc.GetProtocolStateVariables(cli.TaikoL1, &bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, err
+	}
+
+	return chainConfig.IsOntake(new(big.Int).SetUint64(state.B.NumBlocks)), nil
+}
+
 // isBlockForked returns whether a fork scheduled at block s is active at the
 // given head block.
 func isBlockForked(s, head *big.Int) bool {
@@ -13,6 +13,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 type TransactionBuilderTestSuite struct {
@@ -29,11 +30,12 @@ func (s *TransactionBuilderTestSuite) SetupTest() {
 	s.Nil(err)
 
 	protocolConfig := encoding.GetProtocolConfig(s.RPCClient.L2.ChainID.Uint64())
-	chainConfig := config.NewChainConfig(protocolConfig)
+	chainConfig, err := config.NewChainConfig(protocolConfig)
+	s.Nil(err)
 
 	s.calldataTxBuilder = NewCalldataTransactionBuilder(
 		s.RPCClient,
-		l1ProposerPrivKey,
+		signer.NewLocalSigner(l1ProposerPrivKey),
 		common.HexToAddress(os.Getenv("TAIKO_L2")),
 		common.HexToAddress(os.Getenv("TAIKO_L1")),
 		common.Address{},
@@ -43,7 +45,7 @@ func (s *TransactionBuilderTestSuite) SetupTest() {
 	)
 	s.blobTxBuiler = NewBlobTransactionBuilder(
 		s.RPCClient,
-		l1ProposerPrivKey,
+		signer.NewLocalSigner(l1ProposerPrivKey),
 		common.HexToAddress(os.Getenv("TAIKO_L1")),
 		common.Address{},
 		common.HexToAddress(os.Getenv("TAIKO_L2")),
@@ -17,11 +17,14 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 )
 
 // Config contains the configurations to initialize a Taiko prover.
 type Config struct {
 	L1WsEndpoint                            string
+	L1QuorumEndpoints                       []string
+	L1QuorumThreshold                       uint64
 	L2WsEndpoint                            string
 	L2HttpEndpoint                          string
 	TaikoL1Address                          common.Address
@@ -32,6 +35,7 @@ type Config struct {
 	TaikoTokenAddress                       common.Address
 	ProverSetAddress                        common.Address
 	L1ProverPrivKey                         *ecdsa.PrivateKey
+	RemoteSigner                            *signer.RemoteSignerConfig
 	StartingBlockID                         *big.Int
 	Dummy                                   bool
 	GuardianProverMinorityAddress           common.Address
@@ -46,6 +50,7 @@ type Config struct {
 	RPCTimeout                              time.Duration
 	ProveBlockGasLimit                      uint64
 	HTTPServerPort                          uint64
+	AdminToken                              string
 	Capacity                                uint64
 	MinEthBalance                           *big.Int
 	MinTaikoTokenBalance                    *big.Int
@@ -58,6 +63,7 @@ type Config struct {
 	RaikoZKVMHostEndpoint                   string
 	RaikoJWT                                string
 	RaikoRequestTimeout                     time.Duration
+	RaikoHealthCheckInterval                time.Duration
 	RaikoSP1Recursion                       string
 	RaikoSP1Prover                          string
 	RaikoRISC0Bonsai                        bool
@@ -67,6 +73,11 @@ type Config struct {
 	L1NodeVersion                           string
 	L2NodeVersion                           string
 	BlockConfirmations                      uint64
+	JobStorePath                            string
+	CostLedgerPath                          string
+	ProofBatchSize                          uint64
+	ProofBatchTimeout                       time.Duration
+	ProofBackendsConfigPath                 string
 	TxmgrConfigs                            *txmgr.CLIConfig
 	PrivateTxmgrConfigs                     *txmgr.CLIConfig
 }
@@ -76,9 +87,16 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var (
 		jwtSecret []byte
 	)
-	l1ProverPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name)))
+	remoteSigner, err := pkgFlags.InitRemoteSignerConfigFromCli(c)
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		return nil, err
+	}
+
+	var l1ProverPrivKey *ecdsa.PrivateKey
+	if remoteSigner == nil {
+		if l1ProverPrivKey, err = crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name))); err != nil {
+			return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		}
 	}
 
 	var startingBlockID *big.Int
@@ -133,7 +151,9 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
-	if !c.IsSet(flags.GuardianProverMajority.Name) && !c.IsSet(flags.RaikoHostEndpoint.Name) {
+	if !c.IsSet(flags.GuardianProverMajority.Name) &&
+		!c.IsSet(flags.RaikoHostEndpoint.Name) &&
+		!c.IsSet(flags.ProofBackendsConfig.Name) {
 		return nil, errors.New("empty raiko host endpoint")
 	}
 
@@ -146,6 +166,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 
 	return &Config{
 		L1WsEndpoint:                            c.String(flags.L1WSEndpoint.Name),
+		L1QuorumEndpoints:                       c.StringSlice(flags.L1QuorumEndpoints.Name),
+		L1QuorumThreshold:                       c.Uint64(flags.L1QuorumThreshold.Name),
 		L2WsEndpoint:                            c.String(flags.L2WSEndpoint.Name),
 		L2HttpEndpoint:                          c.String(flags.L2HTTPEndpoint.Name),
 		TaikoL1Address:                          common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
@@ -156,10 +178,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		TaikoTokenAddress:                       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		ProverSetAddress:                        common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
+		RemoteSigner:                            remoteSigner,
 		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
 		RaikoZKVMHostEndpoint:                   c.String(flags.RaikoZKVMHostEndpoint.Name),
 		RaikoJWT:                                common.Bytes2Hex(jwtSecret),
 		RaikoRequestTimeout:                     c.Duration(flags.RaikoRequestTimeout.Name),
+		RaikoHealthCheckInterval:                c.Duration(flags.RaikoHealthCheckInterval.Name),
 		RaikoSP1Recursion:                       c.String(flags.RaikoSP1Recursion.Name),
 		RaikoSP1Prover:                          c.String(flags.RaikoSP1Prover.Name),
 		RaikoRISC0Bonsai:                        c.Bool(flags.RaikoRISC0Bonsai.Name),
@@ -182,6 +206,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ProveBlockGasLimit:                      c.Uint64(flags.TxGasLimit.Name),
 		Capacity:                                c.Uint64(flags.ProverCapacity.Name),
 		HTTPServerPort:                          c.Uint64(flags.ProverHTTPServerPort.Name),
+		AdminToken:                              c.String(flags.ProverAdminToken.Name),
 		MinEthBalance:                           minEthBalance,
 		MinTaikoTokenBalance:                    minTaikoTokenBalance,
 		MaxExpiry:                               c.Duration(flags.MaxExpiry.Name),
@@ -191,6 +216,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		L1NodeVersion:                           c.String(flags.L1NodeVersion.Name),
 		L2NodeVersion:                           c.String(flags.L2NodeVersion.Name),
 		BlockConfirmations:                      c.Uint64(flags.BlockConfirmations.Name),
+		JobStorePath:                            c.String(flags.JobStorePath.Name),
+		CostLedgerPath:                          c.String(flags.CostLedgerPath.Name),
+		ProofBatchSize:                          c.Uint64(flags.ProofBatchSize.Name),
+		ProofBatchTimeout:                       c.Duration(flags.ProofBatchTimeout.Name),
+		ProofBackendsConfigPath:                 c.String(flags.ProofBackendsConfig.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1WSEndpoint.Name),
 			l1ProverPrivKey,
@@ -74,7 +74,7 @@ func (h *TransitionProvedEventHandler) Handle(
 		return nil
 	}
 	// If the proof is invalid, we contest it.
-	meta, err := getMetadataFromBlockID(ctx, h.rpc, e.BlockId, new(big.Int).SetUint64(e.ProposedIn))
+	meta, err := GetMetadataFromBlockID(ctx, h.rpc, e.BlockId, new(big.Int).SetUint64(e.ProposedIn))
 	if err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func (h *TransitionProvedEventHandler) Handle(
 		"stateRoot", common.Bytes2Hex(e.Tran.StateRoot[:]),
 	)
 	if h.isGuardian {
-		meta, err := getMetadataFromBlockID(
+		meta, err := GetMetadataFromBlockID(
 			ctx,
 			h.rpc,
 			e.BlockId,
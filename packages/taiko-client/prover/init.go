@@ -102,108 +102,79 @@ func (p *Prover) initProofSubmitters(
 	txBuilder *transaction.ProveBlockTxBuilder,
 	tiers []*rpc.TierProviderTierWithID,
 ) error {
+	// Restore the collected sub proofs of the combined producers, if the job store is enabled.
+	newProofStateManager := func(uint16) *proofProducer.ProofStateManager {
+		return proofProducer.NewProofStateManager()
+	}
+	if p.jobStore != nil {
+		newProofStateManager = func(tier uint16) *proofProducer.ProofStateManager {
+			return proofProducer.NewPersistentProofStateManager(p.jobStore.SubProofStore(tier))
+		}
+	}
+
+	// Load the proof backends of the tiers from the config file, the tiers which are not
+	// configured will use the built-in proof backends.
+	tierConfigs := make(map[uint16]*proofProducer.TierConfig)
+	if p.cfg.ProofBackendsConfigPath != "" {
+		var err error
+		if tierConfigs, err = proofProducer.LoadTierConfigs(p.cfg.ProofBackendsConfigPath); err != nil {
+			return err
+		}
+	}
+
+	registry := proofProducer.NewRegistry(&proofProducer.BackendDefaults{
+		RaikoHostEndpoint:        p.cfg.RaikoHostEndpoint,
+		RaikoZKVMHostEndpoint:    p.cfg.RaikoZKVMHostEndpoint,
+		RaikoJWT:                 p.cfg.RaikoJWT,
+		RaikoRequestTimeout:      p.cfg.RaikoRequestTimeout,
+		RaikoHealthCheckInterval: p.cfg.RaikoHealthCheckInterval,
+		RaikoSP1Recursion:        p.cfg.RaikoSP1Recursion,
+		RaikoSP1Prover:           p.cfg.RaikoSP1Prover,
+		RaikoRISC0Bonsai:         p.cfg.RaikoRISC0Bonsai,
+		RaikoRISC0Snark:          p.cfg.RaikoRISC0Snark,
+		RaikoRISC0Profile:        p.cfg.RaikoRISC0Profile,
+		RaikoRISC0ExecutionPo2:   p.cfg.RaikoRISC0ExecutionPo2,
+		SgxVerifierAddress:       p.cfg.SgxVerifierAddress,
+		Risc0VerifierAddress:     p.cfg.Risc0VerifierAddress,
+		Sp1VerifierAddress:       p.cfg.Sp1VerifierAddress,
+		Dummy:                    p.cfg.Dummy,
+		EnableLivenessBondProof:  p.cfg.EnableLivenessBondProof,
+	}, newProofStateManager)
+
+	p.proofBuffers = make(map[uint16]*proofSubmitter.ProofBuffer)
 	for _, tier := range p.sharedState.GetTiers() {
 		var (
-			producer  proofProducer.ProofProducer
-			submitter proofSubmitter.Submitter
-			err       error
+			producer           proofProducer.ProofProducer
+			emptyBlockProducer proofProducer.ProofProducer
+			submitter          proofSubmitter.Submitter
+			err                error
 		)
-		switch tier.ID {
-		case encoding.TierOptimisticID:
-			producer = &proofProducer.OptimisticProofProducer{}
-		case encoding.TierSgxID:
-			producer = &proofProducer.SGXProofProducer{
-				RaikoHostEndpoint:   p.cfg.RaikoHostEndpoint,
-				JWT:                 p.cfg.RaikoJWT,
-				ProofType:           proofProducer.ProofTypeSgx,
-				Dummy:               p.cfg.Dummy,
-				RaikoRequestTimeout: p.cfg.RaikoRequestTimeout,
-			}
-		case encoding.TierZkVMRisc0ID:
-			producer = &proofProducer.ZKvmProofProducer{
-				ZKProofType:            proofProducer.ZKProofTypeR0,
-				RaikoHostEndpoint:      p.cfg.RaikoZKVMHostEndpoint,
-				JWT:                    p.cfg.RaikoJWT,
-				Dummy:                  p.cfg.Dummy,
-				RaikoRequestTimeout:    p.cfg.RaikoRequestTimeout,
-				RaikoSP1Recursion:      p.cfg.RaikoSP1Recursion,
-				RaikoSP1Prover:         p.cfg.RaikoSP1Prover,
-				RaikoRISC0Bonsai:       p.cfg.RaikoRISC0Bonsai,
-				RaikoRISC0Snark:        p.cfg.RaikoRISC0Snark,
-				RaikoRISC0Profile:      p.cfg.RaikoRISC0Profile,
-				RaikoRISC0ExecutionPo2: p.cfg.RaikoRISC0ExecutionPo2,
-			}
-		case encoding.TierZkVMSp1ID:
-			producer = &proofProducer.ZKvmProofProducer{
-				ZKProofType:            proofProducer.ZKProofTypeSP1,
-				RaikoHostEndpoint:      p.cfg.RaikoZKVMHostEndpoint,
-				JWT:                    p.cfg.RaikoJWT,
-				Dummy:                  p.cfg.Dummy,
-				RaikoRequestTimeout:    p.cfg.RaikoRequestTimeout,
-				RaikoSP1Recursion:      p.cfg.RaikoSP1Recursion,
-				RaikoSP1Prover:         p.cfg.RaikoSP1Prover,
-				RaikoRISC0Bonsai:       p.cfg.RaikoRISC0Bonsai,
-				RaikoRISC0Snark:        p.cfg.RaikoRISC0Snark,
-				RaikoRISC0Profile:      p.cfg.RaikoRISC0Profile,
-				RaikoRISC0ExecutionPo2: p.cfg.RaikoRISC0ExecutionPo2,
-			}
-		case encoding.TierTwoOfThreeID:
-			producer = &proofProducer.CombinedProducer{
-				ProofTier:      encoding.TierTwoOfThreeID,
-				RequiredProofs: 1,
-				Producers: []proofProducer.ProofProducer{
-					&proofProducer.SGXProofProducer{
-						RaikoHostEndpoint:   p.cfg.RaikoHostEndpoint,
-						JWT:                 p.cfg.RaikoJWT,
-						ProofType:           proofProducer.ProofTypeSgx,
-						Dummy:               p.cfg.Dummy,
-						RaikoRequestTimeout: p.cfg.RaikoRequestTimeout,
-					},
-// 					&proofProducer.ZKvmProofProducer{
-// 						ZKProofType:            proofProducer.ZKProofTypeR0,
-// 						RaikoHostEndpoint:      p.cfg.RaikoZKVMHostEndpoint,
-// 						JWT:                    p.cfg.RaikoJWT,
-// 						Dummy:                  p.cfg.Dummy,
-// 						RaikoRequestTimeout:    p.cfg.RaikoRequestTimeout,
-// 						RaikoSP1Recursion:      p.cfg.RaikoSP1Recursion,
-// 						RaikoSP1Prover:         p.cfg.RaikoSP1Prover,
-// 						RaikoRISC0Bonsai:       p.cfg.RaikoRISC0Bonsai,
-// 						RaikoRISC0Snark:        p.cfg.RaikoRISC0Snark,
-// 						RaikoRISC0Profile:      p.cfg.RaikoRISC0Profile,
-// 						RaikoRISC0ExecutionPo2: p.cfg.RaikoRISC0ExecutionPo2,
-// 					},
-// 					&proofProducer.ZKvmProofProducer{
-// 						ZKProofType:            proofProducer.ZKProofTypeSP1,
-// 						RaikoHostEndpoint:      p.cfg.RaikoZKVMHostEndpoint,
-// 						JWT:                    p.cfg.RaikoJWT,
-// 						Dummy:                  p.cfg.Dummy,
-// 						RaikoRequestTimeout:    p.cfg.RaikoRequestTimeout,
-// 						RaikoSP1Recursion:      p.cfg.RaikoSP1Recursion,
-// 						RaikoSP1Prover:         p.cfg.RaikoSP1Prover,
-// 						RaikoRISC0Bonsai:       p.cfg.RaikoRISC0Bonsai,
-// 						RaikoRISC0Snark:        p.cfg.RaikoRISC0Snark,
-// 						RaikoRISC0Profile:      p.cfg.RaikoRISC0Profile,
-// 						RaikoRISC0ExecutionPo2: p.cfg.RaikoRISC0ExecutionPo2,
-// 					},
-				},
-				Verifiers: []common.Address{
-					p.cfg.SgxVerifierAddress,
-// 					p.cfg.Risc0VerifierAddress,
-// 					p.cfg.Sp1VerifierAddress,
-				},
-				ProofStates: proofProducer.NewProofStateManager(),
+
+		tierConfig, ok := tierConfigs[tier.ID]
+		if !ok {
+			if tierConfig, err = proofProducer.DefaultTierConfig(tier.ID); err != nil {
+				return err
 			}
-		case encoding.TierGuardianMinorityID:
-			producer = proofProducer.NewGuardianProofProducer(encoding.TierGuardianMinorityID, p.cfg.EnableLivenessBondProof)
-		case encoding.TierGuardianMajorityID:
-			producer = proofProducer.NewGuardianProofProducer(encoding.TierGuardianMajorityID, p.cfg.EnableLivenessBondProof)
-		default:
-			return fmt.Errorf("unsupported tier: %d", tier.ID)
 		}
 
+		if producer, err = registry.Build(tierConfig); err != nil {
+			return err
+		}
+		if emptyBlockProducer, err = registry.BuildEmptyBlockProducer(tierConfig); err != nil {
+			return err
+		}
+
+		log.Info(
+			"Proof backends initialized",
+			"tier", tier.ID,
+			"backends", len(tierConfig.Backends),
+			"requiredProofs", tierConfig.RequiredProofs,
+		)
+
 		if submitter, err = proofSubmitter.NewProofSubmitter(
 			p.rpc,
 			producer,
+			emptyBlockProducer,
 			p.proofGenerationCh,
 			p.cfg.ProverSetAddress,
 			p.cfg.TaikoL2Address,
@@ -215,13 +186,21 @@ func (p *Prover) initProofSubmitters(
 			tiers,
 			p.IsGuardianProver(),
 			p.cfg.GuardianProofSubmissionDelay,
+			p.jobStore,
+			p.costLedger,
 		); err != nil {
 			return err
 		}
 
 		p.proofSubmitters = append(p.proofSubmitters, submitter)
+
+		if p.cfg.ProofBatchSize > 1 {
+			p.proofBuffers[tier.ID] = proofSubmitter.NewProofBuffer(p.cfg.ProofBatchSize)
+		}
 	}
 
+	p.raikoPools = registry.RaikoPools()
+
 	return nil
 }
 
@@ -0,0 +1,328 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+var (
+	_ producer.SubProofStore = (*tierSubProofStore)(nil)
+
+	// ErrJobNotFound is returned when there is no job stored for the given block ID and tier.
+	ErrJobNotFound = errors.New("proof job not found")
+
+	jobKeyPrefix = []byte("proofJob-")
+)
+
+const (
+	// Database cache size and file handles used by the underlying leveldb instance.
+	dbCache   = 16
+	dbHandles = 16
+)
+
+// JobStatus represents the status of a proof job.
+type JobStatus uint8
+
+const (
+	// JobStatusRequested means the proof has been requested, but not fully generated yet.
+	JobStatusRequested JobStatus = iota
+	// JobStatusGenerated means the proof has been generated, but not submitted yet.
+	JobStatusGenerated
+	// JobStatusSubmitted means the proof has been submitted to the TaikoL1 contract.
+	JobStatusSubmitted
+)
+
+// String implements the fmt.Stringer interface.
+func (s JobStatus) String() string {
+	switch s {
+	case JobStatusRequested:
+		return "requested"
+	case JobStatusGenerated:
+		return "generated"
+	case JobStatusSubmitted:
+		return "submitted"
+	default:
+		return "unknown"
+	}
+}
+
+// Job represents a persisted proof job of a L2 block, a block has one job per proof tier.
+type Job struct {
+	BlockID       uint64                        `json:"blockId"`
+	Tier          uint16                        `json:"tier"`
+	ProposedIn    uint64                        `json:"proposedIn"`
+	Opts          *producer.ProofRequestOptions `json:"opts"`
+	VerifiedTiers []uint16                      `json:"verifiedTiers"`
+	SubProofs     []encoding.SubProof           `json:"subProofs"`
+	Proof         []byte                        `json:"proof"`
+	Status        JobStatus                     `json:"status"`
+	CreatedAt     time.Time                     `json:"createdAt"`
+	UpdatedAt     time.Time                     `json:"updatedAt"`
+}
+
+// JobStore is an embedded key-value store which persists proof jobs, so that the
+// prover can resume the collected proofs after a restart.
+type JobStore struct {
+	db ethdb.KeyValueStore
+	mu sync.Mutex
+}
+
+// Open opens (or creates) a leveldb backed job store at the given path.
+func Open(path string) (*JobStore, error) {
+	db, err := leveldb.New(path, dbCache, dbHandles, "prover/jobs/", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open proof job store (%s): %w", path, err)
+	}
+
+	log.Info("Proof job store opened", "path", path)
+
+	return New(db), nil
+}
+
+// NewMemory creates a new job store backed by an in-memory database.
+func NewMemory() *JobStore {
+	return New(memorydb.New())
+}
+
+// New creates a new job store with the given key-value database.
+func New(db ethdb.KeyValueStore) *JobStore {
+	return &JobStore{db: db}
+}
+
+// Get returns the stored job of the given block ID and tier.
+func (s *JobStore) Get(blockID *big.Int, tier uint16) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.get(blockID.Uint64(), tier)
+}
+
+// SaveRequest records a new proof request of the given block and tier, if the block already
+// has a job of the same tier for the same transition, the collected proofs will be kept.
+func (s *JobStore) SaveRequest(tier uint16, proposedIn uint64, opts *producer.ProofRequestOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.get(opts.BlockID.Uint64(), tier)
+	if err != nil && !errors.Is(err, ErrJobNotFound) {
+		return err
+	}
+
+	now := time.Now()
+	// Start over if there is no job yet, or the proven transition has changed.
+	if job == nil || job.Opts == nil || job.Opts.BlockHash != opts.BlockHash {
+		job = &Job{BlockID: opts.BlockID.Uint64(), Tier: tier, Status: JobStatusRequested, CreatedAt: now}
+	}
+	job.ProposedIn = proposedIn
+	job.Opts = opts
+	job.UpdatedAt = now
+
+	return s.put(job)
+}
+
+// SubProofStore returns a producer.SubProofStore which persists the sub proofs collected
+// for the jobs of the given tier.
+func (s *JobStore) SubProofStore(tier uint16) producer.SubProofStore {
+	return &tierSubProofStore{store: s, tier: tier}
+}
+
+// SaveSubProof records a verified sub proof of the given block's job of the given tier.
+func (s *JobStore) SaveSubProof(blockID *big.Int, tier, subTier uint16, subProof *encoding.SubProof) error {
+	return s.update(blockID, tier, func(job *Job) {
+		if !slices.Contains(job.VerifiedTiers, subTier) {
+			job.VerifiedTiers = append(job.VerifiedTiers, subTier)
+			if subProof != nil {
+				job.SubProofs = append(job.SubProofs, *subProof)
+			}
+		}
+	})
+}
+
+// SubProofs returns the verified sub proof tiers and the sub proofs collected for the
+// given block's job of the given tier.
+func (s *JobStore) SubProofs(blockID *big.Int, tier uint16) ([]uint16, []encoding.SubProof, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.get(blockID.Uint64(), tier)
+	if err != nil {
+		if errors.Is(err, ErrJobNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	return job.VerifiedTiers, job.SubProofs, nil
+}
+
+// SaveProof records the generated proof of the given block and tier.
+func (s *JobStore) SaveProof(blockID *big.Int, tier uint16, proof []byte) error {
+	return s.update(blockID, tier, func(job *Job) {
+		job.Proof = proof
+		job.Status = JobStatusGenerated
+	})
+}
+
+// MarkSubmitted marks the proof job of the given block and tier as submitted.
+func (s *JobStore) MarkSubmitted(blockID *big.Int, tier uint16) error {
+	return s.update(blockID, tier, func(job *Job) { job.Status = JobStatusSubmitted })
+}
+
+// Delete removes the proof job of the given block and tier.
+func (s *JobStore) Delete(blockID *big.Int, tier uint16) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.db.Delete(jobKey(blockID.Uint64(), tier))
+}
+
+// Pending returns all stored jobs which have not been submitted yet, ordered by block ID and tier.
+func (s *JobStore) Pending() ([]*Job, error) {
+	jobs, err := s.iterate(func(job *Job) bool { return job.Status != JobStatusSubmitted })
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].BlockID != jobs[j].BlockID {
+			return jobs[i].BlockID < jobs[j].BlockID
+		}
+		return jobs[i].Tier < jobs[j].Tier
+	})
+
+	return jobs, nil
+}
+
+// Prune removes all jobs whose block ID is not greater than the given verified block ID.
+func (s *JobStore) Prune(lastVerifiedBlockID *big.Int) error {
+	jobs, err := s.iterate(func(job *Job) bool { return job.BlockID <= lastVerifiedBlockID.Uint64() })
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := s.db.NewBatch()
+	for _, job := range jobs {
+		if err := batch.Delete(jobKey(job.BlockID, job.Tier)); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// Close closes the underlying database.
+func (s *JobStore) Close() error {
+	return s.db.Close()
+}
+
+// update applies the given function to the stored job of the given block and tier.
+func (s *JobStore) update(blockID *big.Int, tier uint16, f func(job *Job)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.get(blockID.Uint64(), tier)
+	if err != nil {
+		return err
+	}
+
+	f(job)
+	job.UpdatedAt = time.Now()
+
+	return s.put(job)
+}
+
+// iterate returns all stored jobs which match the given filter.
+func (s *JobStore) iterate(filter func(job *Job) bool) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		jobs []*Job
+		it   = s.db.NewIterator(jobKeyPrefix, nil)
+	)
+	defer it.Release()
+
+	for it.Next() {
+		job := new(Job)
+		if err := json.Unmarshal(it.Value(), job); err != nil {
+			return nil, fmt.Errorf("failed to decode proof job (key: %x): %w", it.Key(), err)
+		}
+		if filter(job) {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, it.Error()
+}
+
+// get fetches the job of the given block ID and tier, the caller should hold the lock.
+func (s *JobStore) get(blockID uint64, tier uint16) (*Job, error) {
+	key := jobKey(blockID, tier)
+
+	data, err := s.db.Get(key)
+	if err != nil {
+		if ok, _ := s.db.Has(key); !ok {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	job := new(Job)
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("failed to decode proof job (blockID: %d, tier: %d): %w", blockID, tier, err)
+	}
+
+	return job, nil
+}
+
+// put stores the given job, the caller should hold the lock.
+func (s *JobStore) put(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof job (blockID: %d, tier: %d): %w", job.BlockID, job.Tier, err)
+	}
+
+	return s.db.Put(jobKey(job.BlockID, job.Tier), data)
+}
+
+// jobKey returns the database key of the given block ID and tier.
+func jobKey(blockID uint64, tier uint16) []byte {
+	return binary.BigEndian.AppendUint16(
+		binary.BigEndian.AppendUint64(slices.Clone(jobKeyPrefix), blockID),
+		tier,
+	)
+}
+
+// tierSubProofStore binds the sub proofs persistence of a job store to a single proof tier.
+type tierSubProofStore struct {
+	store *JobStore
+	tier  uint16
+}
+
+// SaveSubProof implements the producer.SubProofStore interface.
+func (s *tierSubProofStore) SaveSubProof(blockID *big.Int, tier uint16, subProof *encoding.SubProof) error {
+	return s.store.SaveSubProof(blockID, s.tier, tier, subProof)
+}
+
+// SubProofs implements the producer.SubProofStore interface.
+func (s *tierSubProofStore) SubProofs(blockID *big.Int) ([]uint16, []encoding.SubProof, error) {
+	return s.store.SubProofs(blockID, s.tier)
+}
@@ -0,0 +1,173 @@
+package store
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+type JobStoreTestSuite struct {
+	suite.Suite
+	store *JobStore
+}
+
+func (s *JobStoreTestSuite) SetupTest() {
+	s.store = NewMemory()
+}
+
+func (s *JobStoreTestSuite) TearDownTest() {
+	s.Nil(s.store.Close())
+}
+
+func (s *JobStoreTestSuite) TestJobLifecycle() {
+	blockID := common.Big1
+	_, err := s.store.Get(blockID, encoding.TierTwoOfThreeID)
+	s.ErrorIs(err, ErrJobNotFound)
+
+	opts := &producer.ProofRequestOptions{BlockID: blockID, BlockHash: common.HexToHash("0x1")}
+	s.Nil(s.store.SaveRequest(encoding.TierTwoOfThreeID, 10, opts))
+
+	subProofStore := s.store.SubProofStore(encoding.TierTwoOfThreeID)
+	s.Nil(subProofStore.SaveSubProof(blockID, encoding.TierSgxID, &encoding.SubProof{
+		Verifier: common.HexToAddress("0x1234"),
+		Proof:    []byte{0x1},
+	}))
+	tiers, subProofs, err := subProofStore.SubProofs(blockID)
+	s.Nil(err)
+	s.Equal([]uint16{encoding.TierSgxID}, tiers)
+	s.Len(subProofs, 1)
+
+	// Requesting the same transition again should keep the collected sub proofs.
+	s.Nil(s.store.SaveRequest(encoding.TierTwoOfThreeID, 10, opts))
+	_, subProofs, err = subProofStore.SubProofs(blockID)
+	s.Nil(err)
+	s.Len(subProofs, 1)
+
+	s.Nil(s.store.SaveProof(blockID, encoding.TierTwoOfThreeID, []byte{0x2}))
+	job, err := s.store.Get(blockID, encoding.TierTwoOfThreeID)
+	s.Nil(err)
+	s.Equal(JobStatusGenerated, job.Status)
+	s.Equal(uint64(10), job.ProposedIn)
+	s.Equal([]byte{0x2}, job.Proof)
+	s.Equal(opts.BlockHash, job.Opts.BlockHash)
+
+	pending, err := s.store.Pending()
+	s.Nil(err)
+	s.Len(pending, 1)
+
+	s.Nil(s.store.MarkSubmitted(blockID, encoding.TierTwoOfThreeID))
+	pending, err = s.store.Pending()
+	s.Nil(err)
+	s.Empty(pending)
+}
+
+func (s *JobStoreTestSuite) TestSaveRequestNewTransition() {
+	blockID := common.Big1
+	s.Nil(s.store.SaveRequest(encoding.TierSgxID, 0, &producer.ProofRequestOptions{
+		BlockID:   blockID,
+		BlockHash: common.HexToHash("0x1"),
+	}))
+	s.Nil(s.store.SaveProof(blockID, encoding.TierSgxID, []byte{0x1}))
+
+	s.Nil(s.store.SaveRequest(encoding.TierSgxID, 0, &producer.ProofRequestOptions{
+		BlockID:   blockID,
+		BlockHash: common.HexToHash("0x2"),
+	}))
+	job, err := s.store.Get(blockID, encoding.TierSgxID)
+	s.Nil(err)
+	s.Equal(JobStatusRequested, job.Status)
+	s.Empty(job.Proof)
+}
+
+func (s *JobStoreTestSuite) TestJobsOfDifferentTiers() {
+	blockID := common.Big1
+	opts := &producer.ProofRequestOptions{BlockID: blockID, BlockHash: common.HexToHash("0x1")}
+
+	s.Nil(s.store.SaveRequest(encoding.TierSgxID, 0, opts))
+	s.Nil(s.store.SaveProof(blockID, encoding.TierSgxID, []byte{0x1}))
+
+	// Requesting a proof of another tier for the same block should not reset the existing job.
+	s.Nil(s.store.SaveRequest(encoding.TierGuardianMinorityID, 0, opts))
+	s.Nil(s.store.SubProofStore(encoding.TierGuardianMinorityID).SaveSubProof(
+		blockID,
+		encoding.TierGuardianMinorityID,
+		&encoding.SubProof{Proof: []byte{0x2}},
+	))
+
+	job, err := s.store.Get(blockID, encoding.TierSgxID)
+	s.Nil(err)
+	s.Equal(encoding.TierSgxID, job.Tier)
+	s.Equal(JobStatusGenerated, job.Status)
+	s.Equal([]byte{0x1}, job.Proof)
+	s.Empty(job.SubProofs)
+
+	job, err = s.store.Get(blockID, encoding.TierGuardianMinorityID)
+	s.Nil(err)
+	s.Equal(encoding.TierGuardianMinorityID, job.Tier)
+	s.Equal(JobStatusRequested, job.Status)
+	s.Len(job.SubProofs, 1)
+
+	pending, err := s.store.Pending()
+	s.Nil(err)
+	s.Len(pending, 2)
+
+	s.Nil(s.store.MarkSubmitted(blockID, encoding.TierSgxID))
+	pending, err = s.store.Pending()
+	s.Nil(err)
+	s.Len(pending, 1)
+	s.Equal(encoding.TierGuardianMinorityID, pending[0].Tier)
+
+	s.Nil(s.store.Prune(blockID))
+	_, err = s.store.Get(blockID, encoding.TierSgxID)
+	s.ErrorIs(err, ErrJobNotFound)
+	_, err = s.store.Get(blockID, encoding.TierGuardianMinorityID)
+	s.ErrorIs(err, ErrJobNotFound)
+}
+
+func (s *JobStoreTestSuite) TestPendingAndPrune() {
+	for _, id := range []int64{3, 1, 2} {
+		s.Nil(s.store.SaveRequest(encoding.TierSgxID, 0, &producer.ProofRequestOptions{BlockID: big.NewInt(id)}))
+	}
+
+	pending, err := s.store.Pending()
+	s.Nil(err)
+	s.Len(pending, 3)
+	for i, job := range pending {
+		s.Equal(uint64(i+1), job.BlockID)
+	}
+
+	s.Nil(s.store.Prune(common.Big2))
+	pending, err = s.store.Pending()
+	s.Nil(err)
+	s.Len(pending, 1)
+	s.Equal(uint64(3), pending[0].BlockID)
+}
+
+func (s *JobStoreTestSuite) TestReopen() {
+	path := filepath.Join(s.T().TempDir(), "jobs")
+
+	db, err := Open(path)
+	s.Nil(err)
+	s.Nil(db.SaveRequest(encoding.TierSgxID, 0, &producer.ProofRequestOptions{BlockID: common.Big1}))
+	s.Nil(db.SaveProof(common.Big1, encoding.TierSgxID, []byte{0x1}))
+	s.Nil(db.Close())
+
+	db, err = Open(path)
+	s.Nil(err)
+	defer db.Close()
+
+	job, err := db.Get(common.Big1, encoding.TierSgxID)
+	s.Nil(err)
+	s.Equal(JobStatusGenerated, job.Status)
+	s.Equal([]byte{0x1}, job.Proof)
+}
+
+func TestJobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(JobStoreTestSuite))
+}
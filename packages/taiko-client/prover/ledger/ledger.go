@@ -0,0 +1,336 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+var entryKeyPrefix = []byte("proofCost-")
+
+const (
+	// Database cache size and file handles used by the underlying leveldb instance.
+	dbCache   = 16
+	dbHandles = 16
+)
+
+// csvHeader is the header row of the CSV export.
+var csvHeader = []string{
+	"blockId",
+	"tier",
+	"txHash",
+	"batchSize",
+	"gasUsed",
+	"effectiveGasPrice",
+	"blobGasUsed",
+	"blobGasPrice",
+	"l1Cost",
+	"livenessBond",
+	"validityBond",
+	"generationTimeMs",
+	"submittedAt",
+}
+
+// Entry represents the costs of a single submitted proof. For the proofs submitted in a
+// batch, the transaction costs are shared equally by all proofs in the batch.
+type Entry struct {
+	BlockID           uint64         `json:"blockId"`
+	Tier              uint16         `json:"tier"`
+	TxHash            common.Hash    `json:"txHash"`
+	BatchSize         int            `json:"batchSize"`
+	GasUsed           uint64         `json:"gasUsed"`
+	EffectiveGasPrice *big.Int       `json:"effectiveGasPrice"`
+	BlobGasUsed       uint64         `json:"blobGasUsed"`
+	BlobGasPrice      *big.Int       `json:"blobGasPrice"`
+	L1Cost            *big.Int       `json:"l1Cost"`
+	LivenessBond      *big.Int       `json:"livenessBond"`
+	ValidityBond      *big.Int       `json:"validityBond"`
+	GenerationTime    time.Duration  `json:"generationTime"`
+	SubmittedAt       time.Time      `json:"submittedAt"`
+	Prover            common.Address `json:"prover"`
+}
+
+// NewEntries creates the ledger entries of the given proofs, which are submitted in the
+// transaction of the given receipt.
+//
+// The validity bond of each proof is read from the given tiers, which are fetched from the tier
+// provider TaikoL1 debits the bond with. The liveness bond is read from the block metadata, which
+// TaikoL1 sets to the protocol liveness bond, the proposer checks the prover balance against the
+// same config value with rpc.CheckProverBalance.
+func NewEntries(
+	receipt *types.Receipt,
+	proofs []*producer.ProofWithHeader,
+	tiers []*rpc.TierProviderTierWithID,
+) []*Entry {
+	var (
+		batchSize    = uint64(len(proofs))
+		gasPrice     = bigOrZero(receipt.EffectiveGasPrice)
+		blobGasPrice = bigOrZero(receipt.BlobGasPrice)
+		l1Cost       = new(big.Int).Add(
+			new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), gasPrice),
+			new(big.Int).Mul(new(big.Int).SetUint64(receipt.BlobGasUsed), blobGasPrice),
+		)
+		entries = make([]*Entry, 0, len(proofs))
+	)
+	if batchSize == 0 {
+		return nil
+	}
+
+	for _, proof := range proofs {
+		entry := &Entry{
+			BlockID:           proof.BlockID.Uint64(),
+			Tier:              proof.Tier,
+			TxHash:            receipt.TxHash,
+			BatchSize:         len(proofs),
+			GasUsed:           receipt.GasUsed / batchSize,
+			EffectiveGasPrice: gasPrice,
+			BlobGasUsed:       receipt.BlobGasUsed / batchSize,
+			BlobGasPrice:      blobGasPrice,
+			L1Cost:            new(big.Int).Div(l1Cost, new(big.Int).SetUint64(batchSize)),
+			LivenessBond:      common.Big0,
+			ValidityBond:      validityBondOf(tiers, proof.Tier),
+			GenerationTime:    proof.GenerationTime,
+			SubmittedAt:       time.Now().UTC(),
+		}
+		if proof.Meta != nil && proof.Meta.GetLivenessBond() != nil {
+			entry.LivenessBond = proof.Meta.GetLivenessBond()
+		}
+		if proof.Opts != nil {
+			entry.Prover = proof.Opts.ProverAddress
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// validityBondOf returns the validity bond of the given tier, or zero if the tier is unknown.
+func validityBondOf(tiers []*rpc.TierProviderTierWithID, tier uint16) *big.Int {
+	for _, t := range tiers {
+		if t.ID == tier {
+			return bigOrZero(t.ValidityBond)
+		}
+	}
+
+	return common.Big0
+}
+
+// UpdateMetrics updates the proof cost metrics with the given entry.
+func UpdateMetrics(entry *Entry) {
+	tier := strconv.Itoa(int(entry.Tier))
+
+	l1Cost, _ := utils.WeiToGWei(entry.L1Cost).Float64()
+	metrics.ProverProofL1CostGweiCounter.WithLabelValues(tier).Add(l1Cost)
+	metrics.ProverProofGasUsedCounter.WithLabelValues(tier).Add(float64(entry.GasUsed))
+	metrics.ProverProofCostRecordedCounter.WithLabelValues(tier).Add(1)
+	if entry.GenerationTime > 0 {
+		metrics.ProverProofGenerationTimeHistogram.WithLabelValues(tier).Observe(entry.GenerationTime.Seconds())
+	}
+}
+
+// Summary represents the aggregated costs of a proof tier in a time window.
+type Summary struct {
+	Tier                  uint16    `json:"tier"`
+	WindowStart           time.Time `json:"windowStart"`
+	Proofs                int       `json:"proofs"`
+	GasUsed               uint64    `json:"gasUsed"`
+	L1Cost                *big.Int  `json:"l1Cost"`
+	LivenessBond          *big.Int  `json:"livenessBond"`
+	ValidityBond          *big.Int  `json:"validityBond"`
+	AverageL1Cost         *big.Int  `json:"averageL1Cost"`
+	AverageGenerationTime string    `json:"averageGenerationTime"`
+}
+
+// Ledger is an embedded key-value store which persists the costs of the submitted proofs.
+type Ledger struct {
+	db ethdb.KeyValueStore
+	mu sync.Mutex
+}
+
+// Open opens (or creates) a leveldb backed ledger at the given path.
+func Open(path string) (*Ledger, error) {
+	db, err := leveldb.New(path, dbCache, dbHandles, "prover/ledger/", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open proof cost ledger (%s): %w", path, err)
+	}
+
+	log.Info("Proof cost ledger opened", "path", path)
+
+	return New(db), nil
+}
+
+// NewMemory creates a new ledger backed by an in-memory database.
+func NewMemory() *Ledger {
+	return New(memorydb.New())
+}
+
+// New creates a new ledger with the given key-value database.
+func New(db ethdb.KeyValueStore) *Ledger {
+	return &Ledger{db: db}
+}
+
+// Record persists the given entry.
+func (l *Ledger) Record(entry *Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof cost entry (blockID: %d): %w", entry.BlockID, err)
+	}
+
+	return l.db.Put(entryKey(entry.BlockID, entry.TxHash), data)
+}
+
+// Entries returns all entries submitted in the given time range, sorted by block ID. Zero
+// times mean the range is unbounded.
+func (l *Ledger) Entries(from time.Time, to time.Time) ([]*Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var (
+		entries []*Entry
+		it      = l.db.NewIterator(entryKeyPrefix, nil)
+	)
+	defer it.Release()
+
+	for it.Next() {
+		entry := new(Entry)
+		if err := json.Unmarshal(it.Value(), entry); err != nil {
+			return nil, fmt.Errorf("failed to decode proof cost entry (key: %x): %w", it.Key(), err)
+		}
+		if (!from.IsZero() && entry.SubmittedAt.Before(from)) || (!to.IsZero() && !entry.SubmittedAt.Before(to)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, it.Error()
+}
+
+// Close closes the underlying database.
+func (l *Ledger) Close() error {
+	return l.db.Close()
+}
+
+// Summarize aggregates the given entries by proof tier and time window, a zero window
+// aggregates all entries of a tier together.
+func Summarize(entries []*Entry, window time.Duration) []*Summary {
+	type summaryKey struct {
+		tier        uint16
+		windowStart int64
+	}
+
+	var (
+		summaries       = make(map[summaryKey]*Summary)
+		generationTimes = make(map[summaryKey]time.Duration)
+	)
+	for _, entry := range entries {
+		key := summaryKey{tier: entry.Tier}
+		if window > 0 {
+			key.windowStart = entry.SubmittedAt.Truncate(window).Unix()
+		}
+
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &Summary{
+				Tier:         entry.Tier,
+				L1Cost:       new(big.Int),
+				LivenessBond: new(big.Int),
+				ValidityBond: new(big.Int),
+			}
+			if window > 0 {
+				summary.WindowStart = time.Unix(key.windowStart, 0).UTC()
+			}
+			summaries[key] = summary
+		}
+
+		summary.Proofs++
+		summary.GasUsed += entry.GasUsed
+		summary.L1Cost.Add(summary.L1Cost, bigOrZero(entry.L1Cost))
+		summary.LivenessBond.Add(summary.LivenessBond, bigOrZero(entry.LivenessBond))
+		summary.ValidityBond.Add(summary.ValidityBond, bigOrZero(entry.ValidityBond))
+		generationTimes[key] += entry.GenerationTime
+	}
+
+	res := make([]*Summary, 0, len(summaries))
+	for key, summary := range summaries {
+		summary.AverageL1Cost = new(big.Int).Div(summary.L1Cost, big.NewInt(int64(summary.Proofs)))
+		summary.AverageGenerationTime = (generationTimes[key] / time.Duration(summary.Proofs)).String()
+		res = append(res, summary)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].WindowStart.Equal(res[j].WindowStart) {
+			return res[i].WindowStart.Before(res[j].WindowStart)
+		}
+		return res[i].Tier < res[j].Tier
+	})
+
+	return res
+}
+
+// WriteCSV writes the given entries to the given writer in CSV format.
+func WriteCSV(w io.Writer, entries []*Entry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := writer.Write([]string{
+			strconv.FormatUint(entry.BlockID, 10),
+			strconv.Itoa(int(entry.Tier)),
+			entry.TxHash.Hex(),
+			strconv.Itoa(entry.BatchSize),
+			strconv.FormatUint(entry.GasUsed, 10),
+			bigOrZero(entry.EffectiveGasPrice).String(),
+			strconv.FormatUint(entry.BlobGasUsed, 10),
+			bigOrZero(entry.BlobGasPrice).String(),
+			bigOrZero(entry.L1Cost).String(),
+			bigOrZero(entry.LivenessBond).String(),
+			bigOrZero(entry.ValidityBond).String(),
+			strconv.FormatInt(entry.GenerationTime.Milliseconds(), 10),
+			entry.SubmittedAt.Format(time.RFC3339),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// entryKey returns the database key of the given block ID and transaction hash, so that
+// the entries are sorted by block ID.
+func entryKey(blockID uint64, txHash common.Hash) []byte {
+	return append(binary.BigEndian.AppendUint64(slices.Clone(entryKeyPrefix), blockID), txHash.Bytes()...)
+}
+
+// bigOrZero returns the given big integer, or zero if it's nil.
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return common.Big0
+	}
+	return n
+}
@@ -0,0 +1,187 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+var testTiers = []*rpc.TierProviderTierWithID{
+	{ID: encoding.TierSgxID, ITierProviderTier: bindings.ITierProviderTier{ValidityBond: big.NewInt(1000)}},
+	{ID: encoding.TierGuardianMinorityID, ITierProviderTier: bindings.ITierProviderTier{ValidityBond: big.NewInt(2000)}},
+}
+
+type LedgerTestSuite struct {
+	suite.Suite
+	ledger *Ledger
+}
+
+func (s *LedgerTestSuite) SetupTest() {
+	s.ledger = NewMemory()
+}
+
+func (s *LedgerTestSuite) TearDownTest() {
+	s.Nil(s.ledger.Close())
+}
+
+func (s *LedgerTestSuite) TestNewEntries() {
+	receipt := &types.Receipt{
+		TxHash:            common.HexToHash("0x1"),
+		GasUsed:           300_000,
+		EffectiveGasPrice: big.NewInt(10),
+		BlobGasUsed:       0,
+	}
+	proofs := []*producer.ProofWithHeader{
+		{BlockID: common.Big1, Tier: encoding.TierSgxID, Meta: &metadata.TaikoDataBlockMetadataLegacy{}},
+		{BlockID: common.Big2, Tier: encoding.TierSgxID, GenerationTime: time.Minute},
+		{BlockID: common.Big3, Tier: encoding.TierSgxID},
+	}
+
+	entries := NewEntries(receipt, proofs, testTiers)
+	s.Len(entries, 3)
+	for i, entry := range entries {
+		s.Equal(uint64(i+1), entry.BlockID)
+		s.Equal(3, entry.BatchSize)
+		s.Equal(uint64(100_000), entry.GasUsed)
+		s.Equal(big.NewInt(1_000_000), entry.L1Cost)
+		s.Equal(big.NewInt(1000), entry.ValidityBond)
+		s.Zero(entry.LivenessBond.Sign())
+	}
+	s.Equal(time.Minute, entries[1].GenerationTime)
+
+	s.Empty(NewEntries(receipt, nil, nil))
+}
+
+func (s *LedgerTestSuite) TestNewEntriesBonds() {
+	livenessBond := big.NewInt(125)
+	proofs := []*producer.ProofWithHeader{
+		{
+			BlockID: common.Big1,
+			Tier:    encoding.TierSgxID,
+			Meta: &metadata.TaikoDataBlockMetadataOntake{
+				TaikoDataBlockMetadataV2: bindings.TaikoDataBlockMetadataV2{LivenessBond: livenessBond},
+			},
+		},
+		{BlockID: common.Big2, Tier: encoding.TierGuardianMinorityID},
+		{BlockID: common.Big3, Tier: encoding.TierOptimisticID},
+	}
+
+	// The bonds are the ones debited by the protocol, the validity bond follows the tier of
+	// each proof, and the liveness bond follows the block metadata.
+	entries := NewEntries(&types.Receipt{}, proofs, testTiers)
+	s.Len(entries, 3)
+	s.Equal(livenessBond, entries[0].LivenessBond)
+	s.Equal(big.NewInt(1000), entries[0].ValidityBond)
+	s.Equal(big.NewInt(2000), entries[1].ValidityBond)
+	s.Zero(entries[1].LivenessBond.Sign())
+	s.Zero(entries[2].ValidityBond.Sign())
+}
+
+func (s *LedgerTestSuite) TestRecordAndEntries() {
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, entry := range []*Entry{
+		{BlockID: 2, Tier: encoding.TierSgxID, TxHash: common.HexToHash("0x2"), SubmittedAt: now},
+		{BlockID: 1, Tier: encoding.TierSgxID, TxHash: common.HexToHash("0x1"), SubmittedAt: now.Add(-time.Hour)},
+		{BlockID: 3, Tier: encoding.TierOptimisticID, TxHash: common.HexToHash("0x3"), SubmittedAt: now.Add(time.Hour)},
+	} {
+		entry.L1Cost = big.NewInt(int64(i + 1))
+		s.Nil(s.ledger.Record(entry))
+	}
+
+	entries, err := s.ledger.Entries(time.Time{}, time.Time{})
+	s.Nil(err)
+	s.Len(entries, 3)
+	for i, entry := range entries {
+		s.Equal(uint64(i+1), entry.BlockID)
+	}
+
+	entries, err = s.ledger.Entries(now, now.Add(time.Hour))
+	s.Nil(err)
+	s.Len(entries, 1)
+	s.Equal(uint64(2), entries[0].BlockID)
+}
+
+func (s *LedgerTestSuite) TestSummarize() {
+	now := time.Now().UTC().Truncate(time.Hour)
+	entries := []*Entry{
+		{
+			Tier:           encoding.TierSgxID,
+			GasUsed:        100,
+			L1Cost:         big.NewInt(100),
+			GenerationTime: time.Minute,
+			SubmittedAt:    now,
+		},
+		{
+			Tier:           encoding.TierSgxID,
+			GasUsed:        300,
+			L1Cost:         big.NewInt(300),
+			GenerationTime: 3 * time.Minute,
+			SubmittedAt:    now.Add(time.Minute),
+		},
+		{Tier: encoding.TierSgxID, GasUsed: 100, L1Cost: big.NewInt(100), SubmittedAt: now.Add(time.Hour)},
+		{Tier: encoding.TierOptimisticID, GasUsed: 50, L1Cost: big.NewInt(50), SubmittedAt: now},
+	}
+
+	summaries := Summarize(entries, 0)
+	s.Len(summaries, 2)
+	s.Equal(encoding.TierOptimisticID, summaries[0].Tier)
+	s.Equal(3, summaries[1].Proofs)
+	s.Equal(big.NewInt(500), summaries[1].L1Cost)
+
+	summaries = Summarize(entries, time.Hour)
+	s.Len(summaries, 3)
+	s.Equal(now, summaries[1].WindowStart)
+	s.Equal(encoding.TierSgxID, summaries[1].Tier)
+	s.Equal(2, summaries[1].Proofs)
+	s.Equal(uint64(400), summaries[1].GasUsed)
+	s.Equal(big.NewInt(200), summaries[1].AverageL1Cost)
+	s.Equal((2 * time.Minute).String(), summaries[1].AverageGenerationTime)
+}
+
+func (s *LedgerTestSuite) TestWriteCSV() {
+	var buf bytes.Buffer
+	s.Nil(WriteCSV(&buf, []*Entry{{BlockID: 1, Tier: encoding.TierSgxID, L1Cost: big.NewInt(10)}}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	s.Nil(err)
+	s.Len(records, 2)
+	s.Equal(csvHeader, records[0])
+	s.Equal("1", records[1][0])
+	s.Equal("200", records[1][1])
+	s.Equal("10", records[1][8])
+}
+
+func (s *LedgerTestSuite) TestReopen() {
+	path := filepath.Join(s.T().TempDir(), "ledger")
+
+	db, err := Open(path)
+	s.Nil(err)
+	s.Nil(db.Record(&Entry{BlockID: 1, L1Cost: big.NewInt(1), SubmittedAt: time.Now()}))
+	s.Nil(db.Close())
+
+	db, err = Open(path)
+	s.Nil(err)
+	defer db.Close()
+
+	entries, err := db.Entries(time.Time{}, time.Time{})
+	s.Nil(err)
+	s.Len(entries, 1)
+	s.Equal(big.NewInt(1), entries[0].L1Cost)
+}
+
+func TestLedgerTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerTestSuite))
+}
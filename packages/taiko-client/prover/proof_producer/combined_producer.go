@@ -22,9 +22,17 @@ type BlockProofState struct {
 	proofs        []encoding.SubProof
 }
 
+// SubProofStore persists the sub proofs collected by a CombinedProducer, so that they can
+// be restored after a prover restart.
+type SubProofStore interface {
+	SaveSubProof(blockID *big.Int, tier uint16, subProof *encoding.SubProof) error
+	SubProofs(blockID *big.Int) ([]uint16, []encoding.SubProof, error)
+}
+
 type ProofStateManager struct {
 	mu     sync.Mutex
 	states map[uint64]*BlockProofState
+	store  SubProofStore
 }
 
 func NewProofStateManager() *ProofStateManager {
@@ -33,6 +41,15 @@ func NewProofStateManager() *ProofStateManager {
 	}
 }
 
+// NewPersistentProofStateManager creates a new ProofStateManager which restores and persists
+// the block proof states through the given store.
+func NewPersistentProofStateManager(store SubProofStore) *ProofStateManager {
+	return &ProofStateManager{
+		states: make(map[uint64]*BlockProofState),
+		store:  store,
+	}
+}
+
 func (m *ProofStateManager) create(blockID *big.Int) {
 	blockIDUint64 := blockID.Uint64()
 
@@ -45,6 +62,16 @@ func (m *ProofStateManager) create(blockID *big.Int) {
 			verifiedTiers: []uint16{},
 			proofs:        []encoding.SubProof{},
 		}
+		if m.store != nil {
+			verifiedTiers, proofs, err := m.store.SubProofs(blockID)
+			if err != nil {
+				log.Error("Failed to restore block proof state", "blockID", blockIDUint64, "error", err)
+			} else if len(verifiedTiers) != 0 {
+				log.Info("Restored block proof state", "blockID", blockIDUint64, "verifiedTiers", verifiedTiers)
+				state.verifiedTiers = append(state.verifiedTiers, verifiedTiers...)
+				state.proofs = append(state.proofs, proofs...)
+			}
+		}
 		m.states[blockIDUint64] = state
 	}
 }
@@ -88,8 +115,16 @@ func (m *ProofStateManager) addTierAndProof(
 
 	state.verifiedTiers = append(state.verifiedTiers, tier)
 
+	var persisted *encoding.SubProof
 	if uint8(len(state.proofs)) < requiredProofs {
 		state.proofs = append(state.proofs, subProof)
+		persisted = &subProof
+	}
+
+	if m.store != nil {
+		if err := m.store.SaveSubProof(blockID, tier, persisted); err != nil {
+			log.Error("Failed to persist sub proof", "blockID", blockIDUint64, "tier", tier, "error", err)
+		}
 	}
 
 	return uint8(len(state.proofs)) == requiredProofs
@@ -43,7 +43,7 @@ func TestCombinedProducerRequestProof(t *testing.T) {
 			common.HexToAddress("0x1234567890123456789012345678901234567890"),
 			common.HexToAddress("0x0987654321098765432109876543210987654321"),
 		},
-		ProofStates: make(map[uint64]*BlockProofState),
+		ProofStates: NewProofStateManager(),
 	}
 
 	blockID := big.NewInt(1)
@@ -81,7 +81,7 @@ func TestCombinedProducerRequestCancel(t *testing.T) {
 			common.HexToAddress("0x1234567890123456789012345678901234567890"),
 			common.HexToAddress("0x0987654321098765432109876543210987654321"),
 		},
-		ProofStates: make(map[uint64]*BlockProofState),
+		ProofStates: NewProofStateManager(),
 	}
 
 	opts := &ProofRequestOptions{
@@ -93,60 +93,90 @@ func TestCombinedProducerRequestCancel(t *testing.T) {
 	require.Nil(t, err)
 }
 
-func TestGetProofState(t *testing.T) {
-	producer := &CombinedProducer{
-		ProofTier:   encoding.TierTwoOfThreeID,
-		ProofStates: make(map[uint64]*BlockProofState),
-	}
+func TestProofStateManager(t *testing.T) {
+	manager := NewProofStateManager()
 
 	blockID := big.NewInt(1)
 
 	// First call should create new state
-	state1 := producer.getProofState(blockID)
-	require.NotNil(t, state1)
-	require.Empty(t, state1.verifiedTiers)
-	require.Empty(t, state1.proofs)
+	manager.create(blockID)
+	require.False(t, manager.containsTier(blockID, 1))
+	require.Zero(t, manager.currentProofCount(blockID))
 
 	// Modify state
-	state1.verifiedTiers = append(state1.verifiedTiers, uint16(1))
-	state1.proofs = append(state1.proofs, encoding.SubProof{
+	require.False(t, manager.addTierAndProof(blockID, 1, encoding.SubProof{
 		Verifier: common.HexToAddress("0x1234"),
-	})
+	}, 2))
 
-	// Second call should return same state
-	state2 := producer.getProofState(blockID)
-	require.Equal(t, state1, state2)
-	require.Equal(t, []uint16{1}, state2.verifiedTiers)
-	require.Len(t, state2.proofs, 1)
+	// Second call should keep the same state
+	manager.create(blockID)
+	require.True(t, manager.containsTier(blockID, 1))
+	require.Equal(t, 1, manager.currentProofCount(blockID))
 
 	// Different blockID should get new state
 	blockID2 := big.NewInt(2)
-	state3 := producer.getProofState(blockID2)
-	require.NotNil(t, state3)
-	require.Empty(t, state3.verifiedTiers)
-	require.Empty(t, state3.proofs)
+	manager.create(blockID2)
+	require.False(t, manager.containsTier(blockID2, 1))
+	require.Zero(t, manager.currentProofCount(blockID2))
 }
 
 func TestCleanOldProofStates(t *testing.T) {
-	producer := &CombinedProducer{
-		ProofTier:   encoding.TierTwoOfThreeID,
-		ProofStates: make(map[uint64]*BlockProofState),
+	manager := NewProofStateManager()
+
+	for i := int64(1); i <= 5; i++ {
+		manager.create(big.NewInt(i))
+	}
+
+	manager.cleanOldProofStates(big.NewInt(258), BlockHistoryLength)
+	require.Len(t, manager.states, 4)
+}
+
+type memorySubProofStore struct {
+	tiers  map[uint64][]uint16
+	proofs map[uint64][]encoding.SubProof
+}
+
+func (s *memorySubProofStore) SaveSubProof(blockID *big.Int, tier uint16, subProof *encoding.SubProof) error {
+	s.tiers[blockID.Uint64()] = append(s.tiers[blockID.Uint64()], tier)
+	if subProof != nil {
+		s.proofs[blockID.Uint64()] = append(s.proofs[blockID.Uint64()], *subProof)
 	}
+	return nil
+}
+
+func (s *memorySubProofStore) SubProofs(blockID *big.Int) ([]uint16, []encoding.SubProof, error) {
+	return s.tiers[blockID.Uint64()], s.proofs[blockID.Uint64()], nil
+}
 
-	producer.getProofState(big.NewInt(1))
-	producer.getProofState(big.NewInt(2))
-	producer.getProofState(big.NewInt(3))
-	producer.getProofState(big.NewInt(4))
-	producer.getProofState(big.NewInt(5))
+func TestPersistentProofStateManager(t *testing.T) {
+	store := &memorySubProofStore{
+		tiers:  make(map[uint64][]uint16),
+		proofs: make(map[uint64][]encoding.SubProof),
+	}
+	blockID := big.NewInt(1)
 
-	producer.CleanOldProofStates(big.NewInt(258))
-	require.Len(t, producer.ProofStates, 4)
+	manager := NewPersistentProofStateManager(store)
+	manager.create(blockID)
+	require.False(t, manager.addTierAndProof(blockID, encoding.TierSgxID, encoding.SubProof{
+		Verifier: common.HexToAddress("0x1234"),
+		Proof:    []byte{0x1},
+	}, 2))
+
+	// A new manager (e.g. after a prover restart) should restore the collected sub proofs.
+	restored := NewPersistentProofStateManager(store)
+	restored.create(blockID)
+	require.True(t, restored.containsTier(blockID, encoding.TierSgxID))
+	require.Equal(t, 1, restored.currentProofCount(blockID))
+	require.True(t, restored.addTierAndProof(blockID, encoding.TierZkVMSp1ID, encoding.SubProof{
+		Verifier: common.HexToAddress("0x5678"),
+		Proof:    []byte{0x2},
+	}, 2))
 }
 
 func TestCombinedProducerTier(t *testing.T) {
 	producer := &CombinedProducer{
 		ProofTier:   encoding.TierSgxAndZkVMID,
-		ProofStates: make(map[uint64]*BlockProofState),
+		ProofStates: NewProofStateManager(),
 	}
 
 	require.Equal(t, encoding.TierSgxAndZkVMID, producer.Tier())
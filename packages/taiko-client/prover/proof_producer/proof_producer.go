@@ -55,6 +55,8 @@ type ProofWithHeader struct {
 	Proof   []byte
 	Opts    *ProofRequestOptions
 	Tier    uint16
+	// Time spent to generate the proof, zero if the proof is restored from the job store.
+	GenerationTime time.Duration
 }
 
 type ProofProducer interface {
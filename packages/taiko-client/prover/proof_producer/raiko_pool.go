@@ -0,0 +1,316 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+)
+
+var (
+	// errRaikoUnavailable is returned when a Raiko host can't serve the request, the
+	// request will be re-submitted to another host of the pool.
+	errRaikoUnavailable = errors.New("raiko host unavailable")
+	errNoRaikoEndpoints = errors.New("no raiko endpoints")
+)
+
+const (
+	raikoHealthCheckTimeout = 5 * time.Second
+	// Assignments which have not been polled for this long are considered abandoned.
+	raikoAssignmentTTL = 1 * time.Hour
+)
+
+// raikoEndpoint represents a single Raiko host in the pool.
+type raikoEndpoint struct {
+	url         string
+	healthy     bool
+	outstanding int
+}
+
+// raikoAssignment represents a proof request which is in flight on a Raiko host.
+type raikoAssignment struct {
+	endpoint *raikoEndpoint
+	lastUsed time.Time
+}
+
+// RaikoPool is a pool of Raiko hosts serving the same proof types. A proof request is
+// assigned to the healthy host with the least outstanding requests and sticks to it
+// while it's being polled, if the host becomes unavailable, the request will be
+// re-submitted to another host.
+type RaikoPool struct {
+	endpoints           []*raikoEndpoint
+	assignments         map[string]*raikoAssignment
+	healthCheckInterval time.Duration
+	client              *http.Client
+	mu                  sync.Mutex
+}
+
+// NewRaikoPool creates a new Raiko pool instance with the given host endpoints, all
+// hosts are considered healthy until the first health check.
+func NewRaikoPool(endpoints []string, healthCheckInterval time.Duration) (*RaikoPool, error) {
+	pool := &RaikoPool{
+		assignments:         make(map[string]*raikoAssignment),
+		healthCheckInterval: healthCheckInterval,
+		client:              &http.Client{Timeout: raikoHealthCheckTimeout},
+	}
+
+	for _, endpoint := range endpoints {
+		endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+		if endpoint == "" {
+			continue
+		}
+		pool.endpoints = append(pool.endpoints, &raikoEndpoint{url: endpoint, healthy: true})
+	}
+
+	if len(pool.endpoints) == 0 {
+		return nil, errNoRaikoEndpoints
+	}
+
+	return pool, nil
+}
+
+// Start starts the health checks of the Raiko hosts, which will be stopped when the
+// given context is cancelled.
+func (p *RaikoPool) Start(ctx context.Context) {
+	if p.healthCheckInterval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.checkHealth(ctx)
+				p.pruneAssignments()
+			}
+		}
+	}()
+}
+
+// Do calls the given function with the Raiko host assigned to the given request key. If
+// the function fails with errRaikoUnavailable, the host will be marked as unhealthy, and
+// the request will be re-submitted to the next available host.
+func (p *RaikoPool) Do(ctx context.Context, key string, fn func(endpoint string) error) error {
+	var (
+		tried   = make(map[*raikoEndpoint]bool)
+		lastErr error
+	)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		endpoint := p.acquire(key, tried)
+		if endpoint == nil {
+			if lastErr != nil {
+				return lastErr
+			}
+			return errNoRaikoEndpoints
+		}
+
+		err := fn(endpoint.url)
+		if err == nil || !errors.Is(err, errRaikoUnavailable) {
+			return err
+		}
+
+		log.Warn("Raiko host unavailable, failing over", "key", key, "endpoint", endpoint.url, "error", err)
+		metrics.ProverRaikoFailoverCounter.Add(1)
+
+		p.markHealthy(endpoint, false)
+		p.Release(key)
+		tried[endpoint] = true
+		lastErr = err
+	}
+}
+
+// Release releases the Raiko host assigned to the given request key, should be called
+// once the request is finished.
+func (p *RaikoPool) Release(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.release(key)
+}
+
+// release releases the assignment of the given request key, the caller must hold the lock.
+func (p *RaikoPool) release(key string) {
+	assignment, ok := p.assignments[key]
+	if !ok {
+		return
+	}
+
+	assignment.endpoint.outstanding--
+	delete(p.assignments, key)
+}
+
+// acquire returns the Raiko host assigned to the given request key, or assigns one if there
+// is no assignment yet. Healthy hosts are preferred, if all of them are unhealthy, the
+// request will still be tried on the untried ones.
+func (p *RaikoPool) acquire(key string, tried map[*raikoEndpoint]bool) *raikoEndpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if assignment, ok := p.assignments[key]; ok {
+		if !tried[assignment.endpoint] {
+			assignment.lastUsed = time.Now()
+			return assignment.endpoint
+		}
+		p.release(key)
+	}
+
+	var selected *raikoEndpoint
+	for _, endpoint := range p.endpoints {
+		if tried[endpoint] {
+			continue
+		}
+		if selected == nil ||
+			(endpoint.healthy && !selected.healthy) ||
+			(endpoint.healthy == selected.healthy && endpoint.outstanding < selected.outstanding) {
+			selected = endpoint
+		}
+	}
+	if selected == nil {
+		return nil
+	}
+
+	selected.outstanding++
+	p.assignments[key] = &raikoAssignment{endpoint: selected, lastUsed: time.Now()}
+
+	return selected
+}
+
+// checkHealth probes the `/health` endpoint of all Raiko hosts.
+func (p *RaikoPool) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func(endpoint *raikoEndpoint) {
+			defer wg.Done()
+
+			err := p.probe(ctx, endpoint.url)
+			if err != nil {
+				log.Warn("Raiko host health check failed", "endpoint", endpoint.url, "error", err)
+				metrics.ProverRaikoHealthCheckFailedCounter.Add(1)
+			}
+			p.markHealthy(endpoint, err == nil)
+		}(endpoint)
+	}
+	wg.Wait()
+}
+
+// probe sends a health check request to the given Raiko host.
+func (p *RaikoPool) probe(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/health", nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// markHealthy updates the health status of the given Raiko host.
+func (p *RaikoPool) markHealthy(endpoint *raikoEndpoint, healthy bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if endpoint.healthy != healthy {
+		log.Info("Raiko host health status changed", "endpoint", endpoint.url, "healthy", healthy)
+	}
+	endpoint.healthy = healthy
+}
+
+// pruneAssignments releases the abandoned assignments.
+func (p *RaikoPool) pruneAssignments() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, assignment := range p.assignments {
+		if time.Since(assignment.lastUsed) > raikoAssignmentTTL {
+			p.release(key)
+		}
+	}
+}
+
+// HealthyEndpoints returns the number of healthy Raiko hosts.
+func (p *RaikoPool) HealthyEndpoints() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var count int
+	for _, endpoint := range p.endpoints {
+		if endpoint.healthy {
+			count++
+		}
+	}
+
+	return count
+}
+
+// raikoRequestKey returns the pool request key of the given proof request, the requests of the
+// same block with different tiers or metadata are assigned separately.
+func raikoRequestKey(proofType string, tier uint16, opts *ProofRequestOptions) string {
+	return fmt.Sprintf("%s-%d-%d-%s", proofType, tier, opts.BlockID, opts.MetaHash.Hex())
+}
+
+// doRaikoRequest calls the given function with the Raiko host selected by the given pool,
+// or the given default endpoint if there is no pool.
+func doRaikoRequest(
+	ctx context.Context,
+	pool *RaikoPool,
+	endpoint string,
+	key string,
+	fn func(endpoint string) error,
+) error {
+	if pool == nil {
+		return fn(endpoint)
+	}
+
+	return pool.Do(ctx, key, fn)
+}
+
+// releaseRaikoRequest releases the Raiko host assigned to the given request key, if there is a pool.
+func releaseRaikoRequest(pool *RaikoPool, key string) {
+	if pool != nil {
+		pool.Release(key)
+	}
+}
+
+// checkRaikoResponse wraps the transport errors and the responses which indicate that a Raiko
+// host can't serve the request (502, 503, 504 and 429) with errRaikoUnavailable, so that the
+// request can be re-submitted to another host. Other error responses are left to the caller,
+// since they are proof generation failures rather than host failures. The response body will
+// be closed if an error is returned.
+func checkRaikoResponse(res *http.Response, err error) error {
+	if err != nil {
+		return fmt.Errorf("%w: %w", errRaikoUnavailable, err)
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusTooManyRequests:
+		res.Body.Close()
+		return fmt.Errorf("%w: statusCode: %d", errRaikoUnavailable, res.StatusCode)
+	}
+
+	return nil
+}
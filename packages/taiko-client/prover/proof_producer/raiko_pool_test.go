@@ -0,0 +1,181 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+)
+
+func TestNewRaikoPool(t *testing.T) {
+	_, err := NewRaikoPool([]string{"", " "}, 0)
+	require.ErrorIs(t, err, errNoRaikoEndpoints)
+
+	pool, err := NewRaikoPool([]string{"http://a/", "http://b"}, 0)
+	require.Nil(t, err)
+	require.Equal(t, 2, pool.HealthyEndpoints())
+	require.Equal(t, "http://a", pool.endpoints[0].url)
+}
+
+func TestRaikoPoolLoadBalance(t *testing.T) {
+	pool, err := NewRaikoPool([]string{"http://a", "http://b"}, 0)
+	require.Nil(t, err)
+
+	endpoints := make(map[string]string)
+	for _, key := range []string{"1", "2", "1"} {
+		require.Nil(t, pool.Do(context.Background(), key, func(endpoint string) error {
+			endpoints[key] = endpoint
+			return nil
+		}))
+	}
+
+	// Requests are spread across the hosts, and the same request sticks to its host.
+	require.NotEqual(t, endpoints["1"], endpoints["2"])
+	require.Equal(t, 1, pool.endpoints[0].outstanding)
+	require.Equal(t, 1, pool.endpoints[1].outstanding)
+
+	pool.Release("1")
+	pool.Release("2")
+	require.Zero(t, pool.endpoints[0].outstanding)
+	require.Zero(t, pool.endpoints[1].outstanding)
+	require.Empty(t, pool.assignments)
+}
+
+func TestRaikoPoolFailover(t *testing.T) {
+	pool, err := NewRaikoPool([]string{"http://a", "http://b"}, 0)
+	require.Nil(t, err)
+
+	var tried []string
+	require.Nil(t, pool.Do(context.Background(), "1", func(endpoint string) error {
+		tried = append(tried, endpoint)
+		if endpoint == "http://a" {
+			return errRaikoUnavailable
+		}
+		return nil
+	}))
+	require.Equal(t, []string{"http://a", "http://b"}, tried)
+	require.Equal(t, 1, pool.HealthyEndpoints())
+	require.Equal(t, "http://b", pool.assignments["1"].endpoint.url)
+
+	// Errors returned by a healthy host won't trigger a failover.
+	errTest := errors.New("test")
+	require.ErrorIs(t, pool.Do(context.Background(), "2", func(_ string) error { return errTest }), errTest)
+	require.Equal(t, 1, pool.HealthyEndpoints())
+
+	// All hosts are unavailable.
+	pool.Release("1")
+	pool.Release("2")
+	require.ErrorIs(t, pool.Do(context.Background(), "3", func(_ string) error {
+		return errRaikoUnavailable
+	}), errRaikoUnavailable)
+	require.Zero(t, pool.HealthyEndpoints())
+}
+
+func TestRaikoPoolHealthCheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	pool, err := NewRaikoPool([]string{unhealthy.URL, healthy.URL}, time.Second)
+	require.Nil(t, err)
+
+	pool.checkHealth(context.Background())
+	require.Equal(t, 1, pool.HealthyEndpoints())
+
+	// The healthy host is preferred even if it has more outstanding requests.
+	pool.endpoints[1].outstanding = 10
+	require.Nil(t, pool.Do(context.Background(), "1", func(endpoint string) error {
+		require.Equal(t, healthy.URL, endpoint)
+		return nil
+	}))
+}
+
+func TestRaikoRequestKey(t *testing.T) {
+	opts := &ProofRequestOptions{BlockID: common.Big1, MetaHash: common.HexToHash("0x01")}
+	key := raikoRequestKey(ProofTypeSgx, 200, opts)
+
+	require.Equal(t, key, raikoRequestKey(ProofTypeSgx, 200, &ProofRequestOptions{
+		BlockID:  common.Big1,
+		MetaHash: common.HexToHash("0x01"),
+	}))
+	require.NotEqual(t, key, raikoRequestKey(ProofTypeSgx, 250, opts))
+	require.NotEqual(t, key, raikoRequestKey(ProofTypeSgx, 200, &ProofRequestOptions{
+		BlockID:  common.Big1,
+		MetaHash: common.HexToHash("0x02"),
+	}))
+}
+
+func TestSGXProducerFailover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"proof":"0x1234","status":"ok"}}`))
+	}))
+	defer up.Close()
+
+	pool, err := NewRaikoPool([]string{down.URL, up.URL}, 0)
+	require.Nil(t, err)
+
+	producer := &SGXProofProducer{RaikoPool: pool, ProofType: ProofTypeSgx, RaikoRequestTimeout: time.Minute}
+	res, err := producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&metadata.TaikoDataBlockMetadataLegacy{},
+		&types.Header{Number: common.Big1},
+		time.Now(),
+	)
+	require.Nil(t, err)
+	require.Equal(t, common.Hex2Bytes("1234"), res.Proof)
+	require.Equal(t, 1, pool.HealthyEndpoints())
+	require.Empty(t, pool.assignments)
+}
+
+func TestSGXProducerInternalServerError(t *testing.T) {
+	var requests atomic.Int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"proof":"0x1234","status":"ok"}}`))
+	}))
+	defer up.Close()
+
+	pool, err := NewRaikoPool([]string{failing.URL, up.URL}, 0)
+	require.Nil(t, err)
+
+	producer := &SGXProofProducer{RaikoPool: pool, ProofType: ProofTypeSgx, RaikoRequestTimeout: time.Minute}
+	_, err = producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&metadata.TaikoDataBlockMetadataLegacy{},
+		&types.Header{Number: common.Big1},
+		time.Now(),
+	)
+	// The internal server error is a proof generation failure, it should neither fail over
+	// to another host, nor mark the host as unhealthy.
+	require.ErrorContains(t, err, "statusCode: 500")
+	require.NotErrorIs(t, err, errRaikoUnavailable)
+	require.Equal(t, int32(1), requests.Load())
+	require.Equal(t, 2, pool.HealthyEndpoints())
+}
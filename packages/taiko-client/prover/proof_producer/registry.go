@@ -0,0 +1,402 @@
+package producer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+)
+
+// Proof backend types which can be used in the proof backends config file.
+const (
+	BackendOptimistic = "optimistic"
+	BackendSgx        = "sgx"
+	BackendNative     = "native"
+	BackendRisc0      = "risc0"
+	BackendSp1        = "sp1"
+	BackendGuardian   = "guardian"
+)
+
+var (
+	errUnknownBackend = errors.New("unknown proof backend type")
+	errNoBackends     = errors.New("no proof backends configured")
+)
+
+// BackendConfig describes a single proof backend, empty fields fall back to the
+// command line flags in BackendDefaults. The Raiko backends can be served by several
+// hosts, which are load-balanced and failed over by a RaikoPool.
+type BackendConfig struct {
+	Type      string         `json:"type"`
+	Endpoint  string         `json:"endpoint,omitempty"`
+	Endpoints []string       `json:"endpoints,omitempty"`
+	JWTPath   string         `json:"jwtPath,omitempty"`
+	Verifier  common.Address `json:"verifier,omitempty"`
+}
+
+// TierConfig describes which proof backends are used to prove the given tier, and how many
+// of their proofs are required.
+type TierConfig struct {
+	Tier           uint16           `json:"tier"`
+	RequiredProofs uint8            `json:"requiredProofs,omitempty"`
+	Backends       []*BackendConfig `json:"backends"`
+	// Optional cheaper backend to prove the blocks without any transaction other than the
+	// anchor transaction, its proofs must be of the same tier.
+	EmptyBlockBackend *BackendConfig `json:"emptyBlockBackend,omitempty"`
+}
+
+// BackendDefaults contains the default configurations of the proof backends, the Raiko
+// host endpoints can be comma separated lists.
+type BackendDefaults struct {
+	RaikoHostEndpoint        string
+	RaikoZKVMHostEndpoint    string
+	RaikoJWT                 string
+	RaikoRequestTimeout      time.Duration
+	RaikoHealthCheckInterval time.Duration
+	RaikoSP1Recursion        string
+	RaikoSP1Prover           string
+	RaikoRISC0Bonsai         bool
+	RaikoRISC0Snark          bool
+	RaikoRISC0Profile        bool
+	RaikoRISC0ExecutionPo2   *big.Int
+	SgxVerifierAddress       common.Address
+	Risc0VerifierAddress     common.Address
+	Sp1VerifierAddress       common.Address
+	Dummy                    bool
+	EnableLivenessBondProof  bool
+}
+
+// BackendFactory creates a proof producer for the given backend of the given tier.
+type BackendFactory func(r *Registry, tier uint16, cfg *BackendConfig) (ProofProducer, error)
+
+// Registry builds the proof producers of the protocol tiers from the registered proof backends.
+type Registry struct {
+	defaults        *BackendDefaults
+	factories       map[string]BackendFactory
+	defaultVerifier map[string]common.Address
+	newProofStates  func(tier uint16) *ProofStateManager
+	raikoPools      map[string]*RaikoPool
+}
+
+// NewRegistry creates a new registry with all the built-in proof backends registered.
+// The given newProofStates function creates the proof states of the combined producer of a tier.
+func NewRegistry(defaults *BackendDefaults, newProofStates func(tier uint16) *ProofStateManager) *Registry {
+	r := &Registry{
+		defaults:  defaults,
+		factories: make(map[string]BackendFactory),
+		defaultVerifier: map[string]common.Address{
+			BackendSgx:   defaults.SgxVerifierAddress,
+			BackendRisc0: defaults.Risc0VerifierAddress,
+			BackendSp1:   defaults.Sp1VerifierAddress,
+		},
+		newProofStates: newProofStates,
+		raikoPools:     make(map[string]*RaikoPool),
+	}
+
+	r.Register(BackendOptimistic, newOptimisticBackend)
+	r.Register(BackendSgx, newRaikoSGXBackend(ProofTypeSgx))
+	r.Register(BackendNative, newRaikoSGXBackend(ProofTypeCPU))
+	r.Register(BackendRisc0, newRaikoZKVMBackend(ZKProofTypeR0))
+	r.Register(BackendSp1, newRaikoZKVMBackend(ZKProofTypeSP1))
+	r.Register(BackendGuardian, newGuardianBackend)
+
+	return r
+}
+
+// Defaults returns the default configurations of the proof backends.
+func (r *Registry) Defaults() *BackendDefaults {
+	return r.defaults
+}
+
+// RaikoPools returns all the Raiko pools created by the registry, so that their health
+// checks can be started.
+func (r *Registry) RaikoPools() []*RaikoPool {
+	pools := make([]*RaikoPool, 0, len(r.raikoPools))
+	for _, pool := range r.raikoPools {
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+// raikoPool returns the Raiko pool of the given endpoints, the backends with the same
+// endpoints share a pool, so that their outstanding requests are balanced together.
+func (r *Registry) raikoPool(endpoints []string) (*RaikoPool, error) {
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+
+	key := strings.Join(endpoints, ",")
+	if pool, ok := r.raikoPools[key]; ok {
+		return pool, nil
+	}
+
+	pool, err := NewRaikoPool(endpoints, r.defaults.RaikoHealthCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+	r.raikoPools[key] = pool
+
+	return pool, nil
+}
+
+// Register registers a new proof backend factory, an existing one with the same type will be replaced.
+func (r *Registry) Register(backendType string, factory BackendFactory) {
+	r.factories[backendType] = factory
+}
+
+// Build creates the proof producer of the given tier config. A tier with a single backend
+// whose proofs are of the same tier uses the backend directly, otherwise the backends
+// are combined, and the sub proofs will be verified by their corresponding verifiers.
+func (r *Registry) Build(cfg *TierConfig) (ProofProducer, error) {
+	if len(cfg.Backends) == 0 {
+		return nil, fmt.Errorf("%w, tier: %d", errNoBackends, cfg.Tier)
+	}
+
+	var (
+		producers = make([]ProofProducer, 0, len(cfg.Backends))
+		verifiers = make([]common.Address, 0, len(cfg.Backends))
+	)
+	for _, backend := range cfg.Backends {
+		factory, ok := r.factories[backend.Type]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s, tier: %d", errUnknownBackend, backend.Type, cfg.Tier)
+		}
+
+		producer, err := factory(r, cfg.Tier, backend)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create proof backend %s for tier %d: %w", backend.Type, cfg.Tier, err)
+		}
+
+		verifier := backend.Verifier
+		if verifier == (common.Address{}) {
+			verifier = r.defaultVerifier[backend.Type]
+		}
+
+		producers = append(producers, producer)
+		verifiers = append(verifiers, verifier)
+	}
+
+	requiredProofs := cfg.RequiredProofs
+	if requiredProofs == 0 {
+		requiredProofs = 1
+	}
+	if int(requiredProofs) > len(producers) {
+		return nil, fmt.Errorf(
+			"required proofs (%d) exceed the number of backends (%d), tier: %d",
+			requiredProofs,
+			len(producers),
+			cfg.Tier,
+		)
+	}
+
+	if len(producers) == 1 && producers[0].Tier() == cfg.Tier {
+		return producers[0], nil
+	}
+
+	return &CombinedProducer{
+		ProofTier:      cfg.Tier,
+		RequiredProofs: requiredProofs,
+		Producers:      producers,
+		Verifiers:      verifiers,
+		ProofStates:    r.newProofStates(cfg.Tier),
+	}, nil
+}
+
+// BuildEmptyBlockProducer creates the empty block proof producer of the given tier config, it
+// returns nil if there is no empty block backend configured.
+func (r *Registry) BuildEmptyBlockProducer(cfg *TierConfig) (ProofProducer, error) {
+	if cfg.EmptyBlockBackend == nil {
+		return nil, nil
+	}
+
+	factory, ok := r.factories[cfg.EmptyBlockBackend.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s, tier: %d", errUnknownBackend, cfg.EmptyBlockBackend.Type, cfg.Tier)
+	}
+
+	producer, err := factory(r, cfg.Tier, cfg.EmptyBlockBackend)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create empty block backend %s for tier %d: %w",
+			cfg.EmptyBlockBackend.Type,
+			cfg.Tier,
+			err,
+		)
+	}
+	if producer.Tier() != cfg.Tier {
+		return nil, fmt.Errorf(
+			"empty block backend %s generates tier %d proofs, expected tier: %d",
+			cfg.EmptyBlockBackend.Type,
+			producer.Tier(),
+			cfg.Tier,
+		)
+	}
+
+	return producer, nil
+}
+
+// DefaultTierConfig returns the built-in proof backends config of the given protocol tier.
+func DefaultTierConfig(tier uint16) (*TierConfig, error) {
+	var backend string
+	switch tier {
+	case encoding.TierOptimisticID:
+		backend = BackendOptimistic
+	case encoding.TierSgxID, encoding.TierTwoOfThreeID:
+		backend = BackendSgx
+	case encoding.TierZkVMRisc0ID:
+		backend = BackendRisc0
+	case encoding.TierZkVMSp1ID:
+		backend = BackendSp1
+	case encoding.TierGuardianMinorityID, encoding.TierGuardianMajorityID:
+		backend = BackendGuardian
+	default:
+		return nil, fmt.Errorf("unsupported tier: %d", tier)
+	}
+
+	return &TierConfig{Tier: tier, RequiredProofs: 1, Backends: []*BackendConfig{{Type: backend}}}, nil
+}
+
+// LoadTierConfigs loads the proof backends config file, and returns the tier configs keyed by tier ID.
+func LoadTierConfigs(path string) (map[uint16]*TierConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proof backends config: %w", err)
+	}
+
+	var file struct {
+		Tiers []*TierConfig `json:"tiers"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode proof backends config: %w", err)
+	}
+
+	configs := make(map[uint16]*TierConfig, len(file.Tiers))
+	for _, cfg := range file.Tiers {
+		if _, ok := configs[cfg.Tier]; ok {
+			return nil, fmt.Errorf("duplicate tier in proof backends config: %d", cfg.Tier)
+		}
+		configs[cfg.Tier] = cfg
+	}
+
+	return configs, nil
+}
+
+// newOptimisticBackend creates an optimistic proof backend.
+func newOptimisticBackend(_ *Registry, _ uint16, _ *BackendConfig) (ProofProducer, error) {
+	return &OptimisticProofProducer{}, nil
+}
+
+// newGuardianBackend creates a guardian proof backend.
+func newGuardianBackend(r *Registry, tier uint16, _ *BackendConfig) (ProofProducer, error) {
+	return NewGuardianProofProducer(tier, r.defaults.EnableLivenessBondProof), nil
+}
+
+// newRaikoSGXBackend returns a factory of the Raiko SGX proof backend with the given proof type.
+func newRaikoSGXBackend(proofType string) BackendFactory {
+	return func(r *Registry, _ uint16, cfg *BackendConfig) (ProofProducer, error) {
+		defaults := r.defaults
+		jwtSecret, err := backendJWT(cfg, defaults)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints := backendEndpoints(cfg, defaults.RaikoHostEndpoint)
+		pool, err := r.raikoPool(endpoints)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SGXProofProducer{
+			RaikoHostEndpoint:   firstOrEmpty(endpoints),
+			RaikoPool:           pool,
+			JWT:                 jwtSecret,
+			ProofType:           proofType,
+			Dummy:               defaults.Dummy,
+			RaikoRequestTimeout: defaults.RaikoRequestTimeout,
+		}, nil
+	}
+}
+
+// newRaikoZKVMBackend returns a factory of the Raiko zkVM proof backend with the given proof type.
+func newRaikoZKVMBackend(zkProofType string) BackendFactory {
+	return func(r *Registry, _ uint16, cfg *BackendConfig) (ProofProducer, error) {
+		defaults := r.defaults
+		jwtSecret, err := backendJWT(cfg, defaults)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints := backendEndpoints(cfg, defaults.RaikoZKVMHostEndpoint)
+		pool, err := r.raikoPool(endpoints)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ZKvmProofProducer{
+			ZKProofType:            zkProofType,
+			RaikoHostEndpoint:      firstOrEmpty(endpoints),
+			RaikoPool:              pool,
+			JWT:                    jwtSecret,
+			Dummy:                  defaults.Dummy,
+			RaikoRequestTimeout:    defaults.RaikoRequestTimeout,
+			RaikoSP1Recursion:      defaults.RaikoSP1Recursion,
+			RaikoSP1Prover:         defaults.RaikoSP1Prover,
+			RaikoRISC0Bonsai:       defaults.RaikoRISC0Bonsai,
+			RaikoRISC0Snark:        defaults.RaikoRISC0Snark,
+			RaikoRISC0Profile:      defaults.RaikoRISC0Profile,
+			RaikoRISC0ExecutionPo2: defaults.RaikoRISC0ExecutionPo2,
+		}, nil
+	}
+}
+
+// backendJWT returns the JWT secret of the given backend.
+func backendJWT(cfg *BackendConfig, defaults *BackendDefaults) (string, error) {
+	if cfg.JWTPath == "" {
+		return defaults.RaikoJWT, nil
+	}
+
+	jwtSecret, err := jwt.ParseSecretFromFile(cfg.JWTPath)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT secret file: %w", err)
+	}
+
+	return common.Bytes2Hex(jwtSecret), nil
+}
+
+// backendEndpoints returns the Raiko host endpoints of the given backend, the endpoint
+// strings can be comma separated lists.
+func backendEndpoints(cfg *BackendConfig, defaultEndpoint string) []string {
+	endpoints := cfg.Endpoints
+	if len(endpoints) == 0 {
+		endpoint := cfg.Endpoint
+		if endpoint == "" {
+			endpoint = defaultEndpoint
+		}
+		endpoints = strings.Split(endpoint, ",")
+	}
+
+	var res []string
+	for _, endpoint := range endpoints {
+		if endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/"); endpoint != "" {
+			res = append(res, endpoint)
+		}
+	}
+
+	return res
+}
+
+// firstOrEmpty returns the first element of the given slice, or an empty string if it's empty.
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,174 @@
+package producer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+var testBackendDefaults = &BackendDefaults{
+	RaikoHostEndpoint:     "http://localhost:8080",
+	RaikoZKVMHostEndpoint: "http://localhost:8081",
+	SgxVerifierAddress:    common.HexToAddress("0x01"),
+	Risc0VerifierAddress:  common.HexToAddress("0x02"),
+	Sp1VerifierAddress:    common.HexToAddress("0x03"),
+}
+
+func newTestProofStates(uint16) *ProofStateManager { return NewProofStateManager() }
+
+func TestLoadTierConfigs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backends.json")
+	require.Nil(t, os.WriteFile(path, []byte(`{
+		"tiers": [
+			{
+				"tier": 1100,
+				"requiredProofs": 2,
+				"backends": [
+					{ "type": "sgx" },
+					{ "type": "risc0", "endpoint": "http://risc0:8080" },
+					{ "type": "sp1", "verifier": "0x0000000000000000000000000000000000000004" }
+				]
+			}
+		]
+	}`), 0600))
+
+	configs, err := LoadTierConfigs(path)
+	require.Nil(t, err)
+	require.Len(t, configs, 1)
+
+	cfg := configs[encoding.TierTwoOfThreeID]
+	require.NotNil(t, cfg)
+	require.Equal(t, uint8(2), cfg.RequiredProofs)
+	require.Len(t, cfg.Backends, 3)
+	require.Equal(t, "http://risc0:8080", cfg.Backends[1].Endpoint)
+	require.Equal(t, common.HexToAddress("0x04"), cfg.Backends[2].Verifier)
+
+	require.Nil(t, os.WriteFile(path, []byte(`{"tiers": [{"tier": 200}, {"tier": 200}]}`), 0600))
+	_, err = LoadTierConfigs(path)
+	require.ErrorContains(t, err, "duplicate tier")
+}
+
+func TestRegistryBuild(t *testing.T) {
+	registry := NewRegistry(testBackendDefaults, newTestProofStates)
+
+	producer, err := registry.Build(&TierConfig{
+		Tier:           encoding.TierTwoOfThreeID,
+		RequiredProofs: 2,
+		Backends: []*BackendConfig{
+			{Type: BackendSgx},
+			{Type: BackendRisc0, Endpoint: "http://risc0:8080"},
+			{Type: BackendSp1, Verifier: common.HexToAddress("0x04")},
+		},
+	})
+	require.Nil(t, err)
+
+	combined, ok := producer.(*CombinedProducer)
+	require.True(t, ok)
+	require.Equal(t, encoding.TierTwoOfThreeID, combined.Tier())
+	require.Equal(t, uint8(2), combined.RequiredProofs)
+	require.Len(t, combined.Producers, 3)
+	require.Equal(t, []common.Address{
+		testBackendDefaults.SgxVerifierAddress,
+		testBackendDefaults.Risc0VerifierAddress,
+		common.HexToAddress("0x04"),
+	}, combined.Verifiers)
+	require.Equal(t, "http://risc0:8080", combined.Producers[1].(*ZKvmProofProducer).RaikoHostEndpoint)
+	require.Equal(t, testBackendDefaults.RaikoZKVMHostEndpoint, combined.Producers[2].(*ZKvmProofProducer).RaikoHostEndpoint)
+
+	producer, err = registry.Build(&TierConfig{Tier: encoding.TierSgxID, Backends: []*BackendConfig{{Type: BackendSgx}}})
+	require.Nil(t, err)
+	require.IsType(t, &SGXProofProducer{}, producer)
+
+	// The backends with the same Raiko hosts share a pool.
+	require.Len(t, registry.RaikoPools(), 3)
+	require.Same(t, combined.Producers[0].(*SGXProofProducer).RaikoPool, producer.(*SGXProofProducer).RaikoPool)
+
+	producer, err = registry.Build(&TierConfig{
+		Tier:     encoding.TierSgxID,
+		Backends: []*BackendConfig{{Type: BackendSgx, Endpoints: []string{"http://sgx1:8080", "http://sgx2:8080/"}}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "http://sgx1:8080", producer.(*SGXProofProducer).RaikoHostEndpoint)
+	require.Equal(t, 2, producer.(*SGXProofProducer).RaikoPool.HealthyEndpoints())
+}
+
+func TestRegistryBuildInvalidConfig(t *testing.T) {
+	registry := NewRegistry(testBackendDefaults, newTestProofStates)
+
+	_, err := registry.Build(&TierConfig{Tier: encoding.TierSgxID})
+	require.ErrorIs(t, err, errNoBackends)
+
+	_, err = registry.Build(&TierConfig{Tier: encoding.TierSgxID, Backends: []*BackendConfig{{Type: "unknown"}}})
+	require.ErrorIs(t, err, errUnknownBackend)
+
+	_, err = registry.Build(&TierConfig{
+		Tier:           encoding.TierTwoOfThreeID,
+		RequiredProofs: 2,
+		Backends:       []*BackendConfig{{Type: BackendSgx}},
+	})
+	require.ErrorContains(t, err, "required proofs")
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry(testBackendDefaults, newTestProofStates)
+	registry.Register("dummy", func(_ *Registry, tier uint16, _ *BackendConfig) (ProofProducer, error) {
+		return NewGuardianProofProducer(tier, false), nil
+	})
+
+	producer, err := registry.Build(&TierConfig{
+		Tier:     encoding.TierGuardianMinorityID,
+		Backends: []*BackendConfig{{Type: "dummy"}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, encoding.TierGuardianMinorityID, producer.Tier())
+}
+
+func TestDefaultTierConfig(t *testing.T) {
+	registry := NewRegistry(testBackendDefaults, newTestProofStates)
+
+	for _, tier := range []uint16{
+		encoding.TierOptimisticID,
+		encoding.TierSgxID,
+		encoding.TierZkVMRisc0ID,
+		encoding.TierZkVMSp1ID,
+		encoding.TierTwoOfThreeID,
+		encoding.TierGuardianMinorityID,
+		encoding.TierGuardianMajorityID,
+	} {
+		cfg, err := DefaultTierConfig(tier)
+		require.Nil(t, err)
+
+		producer, err := registry.Build(cfg)
+		require.Nil(t, err)
+		require.Equal(t, tier, producer.Tier())
+	}
+
+	_, err := DefaultTierConfig(encoding.TierSgxAndZkVMID)
+	require.ErrorContains(t, err, "unsupported tier")
+}
+
+func TestRegistryBuildEmptyBlockProducer(t *testing.T) {
+	registry := NewRegistry(testBackendDefaults, newTestProofStates)
+
+	producer, err := registry.BuildEmptyBlockProducer(&TierConfig{Tier: encoding.TierSgxID})
+	require.Nil(t, err)
+	require.Nil(t, producer)
+
+	producer, err = registry.BuildEmptyBlockProducer(&TierConfig{
+		Tier:              encoding.TierSgxID,
+		EmptyBlockBackend: &BackendConfig{Type: BackendNative},
+	})
+	require.Nil(t, err)
+	require.Equal(t, ProofTypeCPU, producer.(*SGXProofProducer).ProofType)
+
+	_, err = registry.BuildEmptyBlockProducer(&TierConfig{
+		Tier:              encoding.TierTwoOfThreeID,
+		EmptyBlockBackend: &BackendConfig{Type: BackendNative},
+	})
+	require.ErrorContains(t, err, "expected tier")
+}
@@ -28,9 +28,10 @@ const (
 
 // SGXProofProducer generates a SGX proof for the given block.
 type SGXProofProducer struct {
-	RaikoHostEndpoint   string // a proverd RPC endpoint
-	ProofType           string // Proof type
-	JWT                 string // JWT provided by Raiko
+	RaikoHostEndpoint   string     // a proverd RPC endpoint
+	RaikoPool           *RaikoPool // optional pool of proverd RPC endpoints, overrides RaikoHostEndpoint
+	ProofType           string     // Proof type
+	JWT                 string     // JWT provided by Raiko
 	Dummy               bool
 	RaikoRequestTimeout time.Duration
 	DummyProofProducer
@@ -131,15 +132,20 @@ func (s *SGXProofProducer) callProverDaemon(
 	requestAt time.Time,
 ) ([]byte, error) {
 	var (
-		proof []byte
+		proof  []byte
+		output *RaikoRequestProofBodyResponse
+		key    = raikoRequestKey(s.ProofType, s.Tier(), opts)
 	)
 
 	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, s.RaikoRequestTimeout)
 	defer cancel()
 
-	output, err := s.requestProof(ctx, opts)
-	if err != nil {
-		log.Error("Failed to request proof", "height", opts.BlockID, "error", err, "endpoint", s.RaikoHostEndpoint)
+	if err := doRaikoRequest(ctx, s.RaikoPool, s.RaikoHostEndpoint, key, func(endpoint string) (err error) {
+		output, err = s.requestProof(ctx, endpoint, opts)
+		return err
+	}); err != nil {
+		log.Error("Failed to request proof", "height", opts.BlockID, "error", err)
+		releaseRaikoRequest(s.RaikoPool, key)
 		return nil, err
 	}
 
@@ -152,6 +158,7 @@ func (s *SGXProofProducer) callProverDaemon(
 		)
 		return nil, errProofGenerating
 	}
+	releaseRaikoRequest(s.RaikoPool, key)
 
 	// Raiko returns "" as proof when proof type is native,
 	// so we just convert "" to bytes
@@ -177,6 +184,7 @@ func (s *SGXProofProducer) callProverDaemon(
 // requestProof sends a RPC request to proverd to try to get the requested proof.
 func (s *SGXProofProducer) requestProof(
 	ctx context.Context,
+	endpoint string,
 	opts *ProofRequestOptions,
 ) (*RaikoRequestProofBodyResponse, error) {
 	reqBody := RaikoRequestProofBody{
@@ -198,7 +206,7 @@ func (s *SGXProofProducer) requestProof(
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", s.RaikoHostEndpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +216,7 @@ func (s *SGXProofProducer) requestProof(
 	}
 
 	res, err := client.Do(req)
-	if err != nil {
+	if err := checkRaikoResponse(res, err); err != nil {
 		return nil, err
 	}
 
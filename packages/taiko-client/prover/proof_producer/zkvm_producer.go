@@ -54,6 +54,7 @@ type ProofDataV2 struct {
 type ZKvmProofProducer struct {
 	ZKProofType            string // ZK Proof type
 	RaikoHostEndpoint      string
+	RaikoPool              *RaikoPool // optional pool of Raiko endpoints, overrides RaikoHostEndpoint
 	RaikoRequestTimeout    time.Duration
 	JWT                    string // JWT provided by Raiko
 	Dummy                  bool
@@ -113,7 +114,12 @@ func (s *ZKvmProofProducer) RequestCancel(
 	ctx context.Context,
 	opts *ProofRequestOptions,
 ) error {
-	return s.requestCancel(ctx, opts)
+	key := raikoRequestKey(s.ZKProofType, s.Tier(), opts)
+	defer releaseRaikoRequest(s.RaikoPool, key)
+
+	return doRaikoRequest(ctx, s.RaikoPool, s.RaikoHostEndpoint, key, func(endpoint string) error {
+		return s.requestCancel(ctx, endpoint, opts)
+	})
 }
 
 // callProverDaemon keeps polling the proverd service to get the requested proof.
@@ -123,15 +129,20 @@ func (s *ZKvmProofProducer) callProverDaemon(
 	requestAt time.Time,
 ) ([]byte, error) {
 	var (
-		proof []byte
+		proof  []byte
+		output *RaikoRequestProofBodyResponseV2
+		key    = raikoRequestKey(s.ZKProofType, s.Tier(), opts)
 	)
 
 	zkCtx, zkCancel := rpc.CtxWithTimeoutOrDefault(ctx, s.RaikoRequestTimeout)
 	defer zkCancel()
 
-	output, err := s.requestProof(zkCtx, opts)
-	if err != nil {
-		log.Error("Failed to request proof", "height", opts.BlockID, "error", err, "endpoint", s.RaikoHostEndpoint)
+	if err := doRaikoRequest(zkCtx, s.RaikoPool, s.RaikoHostEndpoint, key, func(endpoint string) (err error) {
+		output, err = s.requestProof(zkCtx, endpoint, opts)
+		return err
+	}); err != nil {
+		log.Error("Failed to request proof", "height", opts.BlockID, "error", err)
+		releaseRaikoRequest(s.RaikoPool, key)
 		return nil, err
 	}
 
@@ -141,6 +152,7 @@ func (s *ZKvmProofProducer) callProverDaemon(
 	if output.Data.Status == StatusRegistered {
 		return nil, ErrRetry
 	}
+	releaseRaikoRequest(s.RaikoPool, key)
 
 	if len(output.Data.Proof.Proof) == 0 {
 		return nil, errEmptyProof
@@ -159,6 +171,7 @@ func (s *ZKvmProofProducer) callProverDaemon(
 // requestProof sends a RPC request to proverd to try to get the requested proof.
 func (s *ZKvmProofProducer) requestProof(
 	ctx context.Context,
+	endpoint string,
 	opts *ProofRequestOptions,
 ) (*RaikoRequestProofBodyResponseV2, error) {
 	var reqBody RaikoRequestProofBody
@@ -196,7 +209,7 @@ func (s *ZKvmProofProducer) requestProof(
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", s.RaikoHostEndpoint+"/v2/proof", bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint+"/v2/proof", bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +219,7 @@ func (s *ZKvmProofProducer) requestProof(
 	}
 
 	res, err := client.Do(req)
-	if err != nil {
+	if err := checkRaikoResponse(res, err); err != nil {
 		return nil, err
 	}
 
@@ -240,6 +253,7 @@ func (s *ZKvmProofProducer) requestProof(
 
 func (s *ZKvmProofProducer) requestCancel(
 	ctx context.Context,
+	endpoint string,
 	opts *ProofRequestOptions,
 ) error {
 	reqBody := RaikoRequestProofBody{
@@ -265,7 +279,7 @@ func (s *ZKvmProofProducer) requestCancel(
 	req, err := http.NewRequestWithContext(
 		ctx,
 		"POST",
-		s.RaikoHostEndpoint+"/v2/proof/cancel",
+		endpoint+"/v2/proof/cancel",
 		bytes.NewBuffer(jsonValue),
 	)
 	if err != nil {
@@ -277,7 +291,7 @@ func (s *ZKvmProofProducer) requestCancel(
 	}
 
 	res, err := client.Do(req)
-	if err != nil {
+	if err := checkRaikoResponse(res, err); err != nil {
 		return err
 	}
 
@@ -14,6 +14,7 @@ import (
 type Submitter interface {
 	RequestProof(ctx context.Context, meta metadata.TaikoBlockMetaData) error
 	SubmitProof(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) error
+	BatchSubmitProofs(ctx context.Context, proofs []*proofProducer.ProofWithHeader) error
 	Producer() proofProducer.ProofProducer
 	Tier() uint16
 }
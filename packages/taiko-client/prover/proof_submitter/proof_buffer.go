@@ -0,0 +1,81 @@
+package submitter
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+var (
+	// ErrBufferOverflow is returned when the proof buffer is already full.
+	ErrBufferOverflow = errors.New("proof buffer overflow")
+)
+
+// ProofBuffer caches the generated proofs of a proof tier, so that they can be
+// submitted to the TaikoL1 smart contract in a single batch transaction.
+type ProofBuffer struct {
+	MaxLength   uint64
+	buffer      []*producer.ProofWithHeader
+	firstItemAt time.Time
+	mutex       sync.RWMutex
+}
+
+// NewProofBuffer creates a new ProofBuffer instance.
+func NewProofBuffer(maxLength uint64) *ProofBuffer {
+	return &ProofBuffer{
+		buffer:    make([]*producer.ProofWithHeader, 0, maxLength),
+		MaxLength: maxLength,
+	}
+}
+
+// Write adds a new proof into the buffer, and returns the current buffer length.
+func (pb *ProofBuffer) Write(item *producer.ProofWithHeader) (int, error) {
+	pb.mutex.Lock()
+	defer pb.mutex.Unlock()
+
+	if uint64(len(pb.buffer)) >= pb.MaxLength {
+		return len(pb.buffer), ErrBufferOverflow
+	}
+
+	if len(pb.buffer) == 0 {
+		pb.firstItemAt = time.Now()
+	}
+	pb.buffer = append(pb.buffer, item)
+
+	return len(pb.buffer), nil
+}
+
+// Len returns the current length of the buffer.
+func (pb *ProofBuffer) Len() int {
+	pb.mutex.RLock()
+	defer pb.mutex.RUnlock()
+
+	return len(pb.buffer)
+}
+
+// Full returns whether the buffer has reached its max length.
+func (pb *ProofBuffer) Full() bool {
+	return uint64(pb.Len()) >= pb.MaxLength
+}
+
+// FirstItemAt returns the time when the oldest proof in the buffer was added.
+func (pb *ProofBuffer) FirstItemAt() time.Time {
+	pb.mutex.RLock()
+	defer pb.mutex.RUnlock()
+
+	return pb.firstItemAt
+}
+
+// Flush returns all proofs in the buffer, and then clears the buffer.
+func (pb *ProofBuffer) Flush() []*producer.ProofWithHeader {
+	pb.mutex.Lock()
+	defer pb.mutex.Unlock()
+
+	items := pb.buffer
+	pb.buffer = make([]*producer.ProofWithHeader, 0, pb.MaxLength)
+	pb.firstItemAt = time.Time{}
+
+	return items
+}
@@ -0,0 +1,37 @@
+package submitter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+func TestProofBuffer(t *testing.T) {
+	pb := NewProofBuffer(2)
+	require.Zero(t, pb.Len())
+	require.True(t, pb.FirstItemAt().IsZero())
+
+	length, err := pb.Write(&producer.ProofWithHeader{BlockID: big.NewInt(1)})
+	require.Nil(t, err)
+	require.Equal(t, 1, length)
+	require.False(t, pb.Full())
+	require.False(t, pb.FirstItemAt().IsZero())
+
+	length, err = pb.Write(&producer.ProofWithHeader{BlockID: big.NewInt(2)})
+	require.Nil(t, err)
+	require.Equal(t, 2, length)
+	require.True(t, pb.Full())
+
+	_, err = pb.Write(&producer.ProofWithHeader{BlockID: big.NewInt(3)})
+	require.ErrorIs(t, err, ErrBufferOverflow)
+
+	items := pb.Flush()
+	require.Len(t, items, 2)
+	require.Equal(t, big.NewInt(1), items[0].BlockID)
+	require.Equal(t, big.NewInt(2), items[1].BlockID)
+	require.Zero(t, pb.Len())
+	require.True(t, pb.FirstItemAt().IsZero())
+}
@@ -94,7 +94,7 @@ func (c *ProofContester) SubmitContest(
 	if err != nil {
 		return err
 	}
-	return c.sender.Send(
+	_, err = c.sender.Send(
 		ctx,
 		&proofProducer.ProofWithHeader{
 			BlockID: blockID,
@@ -123,4 +123,5 @@ func (c *ProofContester) SubmitContest(
 			tier,
 		),
 	)
+	return err
 }
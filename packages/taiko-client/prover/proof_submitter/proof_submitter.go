@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	validator "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/anchor_tx_validator"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
+	store "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/job_store"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/ledger"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
 )
@@ -35,20 +38,26 @@ var (
 // ProofSubmitter is responsible requesting proofs for the given L2
 // blocks, and submitting the generated proofs to the TaikoL1 smart contract.
 type ProofSubmitter struct {
-	rpc              *rpc.Client
-	proofProducer    proofProducer.ProofProducer
-	resultCh         chan *proofProducer.ProofWithHeader
-	anchorValidator  *validator.AnchorTxValidator
-	txBuilder        *transaction.ProveBlockTxBuilder
-	sender           *transaction.Sender
-	proverAddress    common.Address
-	proverSetAddress common.Address
-	taikoL2Address   common.Address
-	graffiti         [32]byte
-	tiers            []*rpc.TierProviderTierWithID
+	rpc           *rpc.Client
+	proofProducer proofProducer.ProofProducer
+	// Optional producer for the blocks without any transaction other than the anchor transaction.
+	emptyBlockProducer proofProducer.ProofProducer
+	resultCh           chan *proofProducer.ProofWithHeader
+	anchorValidator    *validator.AnchorTxValidator
+	txBuilder          *transaction.ProveBlockTxBuilder
+	sender             *transaction.Sender
+	proverAddress      common.Address
+	proverSetAddress   common.Address
+	taikoL2Address     common.Address
+	graffiti           [32]byte
+	tiers              []*rpc.TierProviderTierWithID
 	// Guardian prover related.
 	isGuardian      bool
 	submissionDelay time.Duration
+	// Persistent proof jobs, nil if not enabled.
+	jobStore *store.JobStore
+	// Proof cost ledger, nil if not enabled.
+	costLedger *ledger.Ledger
 
 	cache sync.Map
 }
@@ -57,6 +66,7 @@ type ProofSubmitter struct {
 func NewProofSubmitter(
 	rpcClient *rpc.Client,
 	proofProducer proofProducer.ProofProducer,
+	emptyBlockProducer proofProducer.ProofProducer,
 	resultCh chan *proofProducer.ProofWithHeader,
 	proverSetAddress common.Address,
 	taikoL2Address common.Address,
@@ -68,6 +78,8 @@ func NewProofSubmitter(
 	tiers []*rpc.TierProviderTierWithID,
 	isGuardian bool,
 	submissionDelay time.Duration,
+	jobStore *store.JobStore,
+	costLedger *ledger.Ledger,
 ) (*ProofSubmitter, error) {
 	anchorValidator, err := validator.New(taikoL2Address, rpcClient.L2.ChainID, rpcClient)
 	if err != nil {
@@ -75,19 +87,22 @@ func NewProofSubmitter(
 	}
 
 	return &ProofSubmitter{
-		rpc:              rpcClient,
-		proofProducer:    proofProducer,
-		resultCh:         resultCh,
-		anchorValidator:  anchorValidator,
-		txBuilder:        builder,
-		sender:           transaction.NewSender(rpcClient, txmgr, privateTxmgr, proverSetAddress, gasLimit),
-		proverAddress:    txmgr.From(),
-		proverSetAddress: proverSetAddress,
-		taikoL2Address:   taikoL2Address,
-		graffiti:         rpc.StringToBytes32(graffiti),
-		tiers:            tiers,
-		isGuardian:       isGuardian,
-		submissionDelay:  submissionDelay,
+		rpc:                rpcClient,
+		proofProducer:      proofProducer,
+		emptyBlockProducer: emptyBlockProducer,
+		resultCh:           resultCh,
+		anchorValidator:    anchorValidator,
+		txBuilder:          builder,
+		sender:             transaction.NewSender(rpcClient, txmgr, privateTxmgr, proverSetAddress, gasLimit),
+		proverAddress:      txmgr.From(),
+		proverSetAddress:   proverSetAddress,
+		taikoL2Address:     taikoL2Address,
+		graffiti:           rpc.StringToBytes32(graffiti),
+		tiers:              tiers,
+		isGuardian:         isGuardian,
+		submissionDelay:    submissionDelay,
+		jobStore:           jobStore,
+		costLedger:         costLedger,
 	}, nil
 }
 
@@ -104,8 +119,9 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 		return fmt.Errorf("failed to fetch l2 Header, blockID: %d, error: %w", meta.GetBlockID(), err)
 	}
 
-	if header.TxHash == types.EmptyTxsHash {
-		return errors.New("no transaction in block")
+	producer, err := s.producerFor(ctx, header)
+	if err != nil {
+		return err
 	}
 
 	parent, err := s.rpc.L2.BlockByHash(ctx, header.ParentHash)
@@ -147,6 +163,15 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 		return nil
 	}
 
+	// If the proof has already been generated before a restart, resume the submission directly.
+	if result := s.restoreGeneratedProof(opts, meta, header); result != nil {
+		s.cache.Delete(meta.GetBlockID().Uint64())
+		s.resultCh <- result
+		metrics.ProverQueuedProofCounter.Add(1)
+		return nil
+	}
+	s.saveProofRequest(blockInfo.ProposedIn, opts)
+
 	startTime := time.Now()
 
 	log.Info("Starting the polling for proof request at proof submitter",
@@ -176,7 +201,7 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 				return nil
 			}
 
-			result, err := s.proofProducer.RequestProof(
+			result, err := producer.RequestProof(
 				ctx,
 				opts,
 				meta.GetBlockID(),
@@ -188,7 +213,7 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 				// If request proof has timed out in retry, let's cancel the proof generating and skip
 				if errors.Is(err, proofProducer.ErrProofInProgress) && time.Since(startTime) >= ProofTimeout {
 					log.Error("Request proof has timed out, start to cancel", "blockID", opts.BlockID)
-					if cancelErr := s.proofProducer.RequestCancel(ctx, opts); cancelErr != nil {
+					if cancelErr := producer.RequestCancel(ctx, opts); cancelErr != nil {
 						log.Error("Failed to request cancellation of proof", "err", cancelErr)
 					}
 					s.cache.Delete(meta.GetBlockID().Uint64())
@@ -196,6 +221,8 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 				}
 				return fmt.Errorf("failed to request proof (id: %d): %w", meta.GetBlockID(), err)
 			}
+			result.GenerationTime = time.Since(startTime)
+			s.saveGeneratedProof(result)
 			s.cache.Delete(meta.GetBlockID().Uint64())
 			s.resultCh <- result
 			metrics.ProverQueuedProofCounter.Add(1)
@@ -210,6 +237,31 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoBl
 	return nil
 }
 
+// producerFor returns the proof producer for the given L2 block, the blocks without any transaction
+// other than the anchor transaction will be proven by the empty block producer, if it's set.
+func (s *ProofSubmitter) producerFor(ctx context.Context, header *types.Header) (proofProducer.ProofProducer, error) {
+	if s.emptyBlockProducer == nil {
+		if header.TxHash == types.EmptyTxsHash {
+			return nil, errors.New("no transaction in block")
+		}
+		return s.proofProducer, nil
+	}
+
+	if header.TxHash != types.EmptyTxsHash {
+		block, err := s.rpc.L2.BlockByHash(ctx, header.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the L2 block by hash (%s): %w", header.Hash(), err)
+		}
+		if block.Transactions().Len() > 1 {
+			return s.proofProducer, nil
+		}
+	}
+
+	log.Info("Proving empty block with the empty block producer", "blockID", header.Number, "tier", s.Tier())
+
+	return s.emptyBlockProducer, nil
+}
+
 // SubmitProof implements the Submitter interface.
 func (s *ProofSubmitter) SubmitProof(
 	ctx context.Context,
@@ -232,6 +284,7 @@ func (s *ProofSubmitter) SubmitProof(
 		return err
 	}
 	if proofStatus.IsSubmitted && !proofStatus.Invalid {
+		s.markProofSubmitted(proofWithHeader.BlockID)
 		return nil
 	}
 
@@ -266,24 +319,13 @@ func (s *ProofSubmitter) SubmitProof(
 
 	metrics.ProverReceivedProofCounter.Add(1)
 
-	// Get the corresponding L2 block.
-	block, err := s.rpc.L2.BlockByHash(ctx, proofWithHeader.Header.Hash())
-	if err != nil {
-		return fmt.Errorf("failed to get L2 block with given hash %s: %w", proofWithHeader.Header.Hash(), err)
-	}
-
-	if block.Transactions().Len() == 0 {
-		return fmt.Errorf("invalid block without anchor transaction, blockID %s", proofWithHeader.BlockID)
-	}
-
-	// Validate TaikoL2.anchor transaction inside the L2 block.
-	anchorTx := block.Transactions()[0]
-	if err = s.anchorValidator.ValidateAnchorTx(anchorTx); err != nil {
-		return fmt.Errorf("invalid anchor transaction: %w", err)
+	// Validate TaikoL2.anchor transaction inside the corresponding L2 block.
+	if err = s.validateAnchorTx(ctx, proofWithHeader); err != nil {
+		return err
 	}
 
 	// Build the TaikoL1.proveBlock transaction and send it to the L1 node.
-	if err = s.sender.Send(
+	receipt, err := s.sender.Send(
 		ctx,
 		proofWithHeader,
 		s.txBuilder.Build(
@@ -301,7 +343,8 @@ func (s *ProofSubmitter) SubmitProof(
 			},
 			proofWithHeader.Tier,
 		),
-	); err != nil {
+	)
+	if err != nil {
 		if err.Error() == transaction.ErrUnretryableSubmission.Error() {
 			return nil
 		}
@@ -309,12 +352,189 @@ func (s *ProofSubmitter) SubmitProof(
 		return err
 	}
 
+	s.markProofSubmitted(proofWithHeader.BlockID)
+	s.recordProofCosts(receipt, []*proofProducer.ProofWithHeader{proofWithHeader})
+
 	metrics.ProverSentProofCounter.Add(1)
 	metrics.ProverLatestProvenBlockIDGauge.Set(float64(proofWithHeader.BlockID.Uint64()))
 
 	return nil
 }
 
+// restoreGeneratedProof returns the persisted proof of the given block, if it has already been
+// generated for the same tier and transition.
+func (s *ProofSubmitter) restoreGeneratedProof(
+	opts *proofProducer.ProofRequestOptions,
+	meta metadata.TaikoBlockMetaData,
+	header *types.Header,
+) *proofProducer.ProofWithHeader {
+	if s.jobStore == nil {
+		return nil
+	}
+
+	job, err := s.jobStore.Get(opts.BlockID, s.Tier())
+	if err != nil {
+		if !errors.Is(err, store.ErrJobNotFound) {
+			log.Error("Failed to get proof job", "blockID", opts.BlockID, "error", err)
+		}
+		return nil
+	}
+
+	if job.Status != store.JobStatusGenerated ||
+		job.Opts == nil ||
+		job.Opts.BlockHash != opts.BlockHash {
+		return nil
+	}
+
+	log.Info("Restored generated proof from job store", "blockID", opts.BlockID, "tier", job.Tier)
+
+	return &proofProducer.ProofWithHeader{
+		BlockID: opts.BlockID,
+		Header:  header,
+		Meta:    meta,
+		Proof:   job.Proof,
+		Opts:    opts,
+		Tier:    job.Tier,
+	}
+}
+
+// saveProofRequest persists the given proof request, if the job store is enabled.
+func (s *ProofSubmitter) saveProofRequest(proposedIn uint64, opts *proofProducer.ProofRequestOptions) {
+	if s.jobStore == nil {
+		return
+	}
+
+	if err := s.jobStore.SaveRequest(s.Tier(), proposedIn, opts); err != nil {
+		log.Error("Failed to save proof request", "blockID", opts.BlockID, "error", err)
+	}
+}
+
+// saveGeneratedProof persists the given generated proof, if the job store is enabled.
+func (s *ProofSubmitter) saveGeneratedProof(result *proofProducer.ProofWithHeader) {
+	if s.jobStore == nil {
+		return
+	}
+
+	if err := s.jobStore.SaveProof(result.BlockID, s.Tier(), result.Proof); err != nil {
+		log.Error("Failed to save generated proof", "blockID", result.BlockID, "error", err)
+	}
+}
+
+// markProofSubmitted marks the proof job of the given block as submitted, if the job store is enabled.
+func (s *ProofSubmitter) markProofSubmitted(blockID *big.Int) {
+	if s.jobStore == nil {
+		return
+	}
+
+	if err := s.jobStore.MarkSubmitted(blockID, s.Tier()); err != nil && !errors.Is(err, store.ErrJobNotFound) {
+		log.Error("Failed to mark proof job as submitted", "blockID", blockID, "error", err)
+	}
+}
+
+// recordProofCosts updates the proof cost metrics, and persists the costs of the given proofs,
+// which are submitted in the transaction of the given receipt, if the ledger is enabled.
+func (s *ProofSubmitter) recordProofCosts(receipt *types.Receipt, proofs []*proofProducer.ProofWithHeader) {
+	if receipt == nil || len(proofs) == 0 {
+		return
+	}
+
+	for _, entry := range ledger.NewEntries(receipt, proofs, s.tiers) {
+		ledger.UpdateMetrics(entry)
+
+		if s.costLedger == nil {
+			continue
+		}
+		if err := s.costLedger.Record(entry); err != nil {
+			log.Error("Failed to record proof costs", "blockID", entry.BlockID, "error", err)
+		}
+	}
+}
+
+// BatchSubmitProofs implements the Submitter interface.
+func (s *ProofSubmitter) BatchSubmitProofs(
+	ctx context.Context,
+	proofs []*proofProducer.ProofWithHeader,
+) error {
+	log.Info("Batch submit block proofs", "count", len(proofs), "tier", s.Tier())
+
+	// Guardian proofs have a submission delay, and legacy blocks can not be proven in a batch,
+	// so we submit them one by one.
+	if len(proofs) == 1 ||
+		s.isGuardian ||
+		slices.ContainsFunc(proofs, func(p *proofProducer.ProofWithHeader) bool { return !p.Meta.IsOntakeBlock() }) {
+		return s.submitProofsOneByOne(ctx, proofs)
+	}
+
+	metrics.ProverReceivedProofCounter.Add(float64(len(proofs)))
+
+	for _, proofWithHeader := range proofs {
+		if err := s.validateAnchorTx(ctx, proofWithHeader); err != nil {
+			return err
+		}
+	}
+
+	// Build the TaikoL1.proveBlocks transaction and send it to the L1 node.
+	submitted, receipt, err := s.sender.SendBatch(
+		ctx,
+		proofs,
+		func(proofs []*proofProducer.ProofWithHeader) transaction.TxBuilder {
+			return s.txBuilder.BuildProveBlocks(proofs, s.graffiti)
+		},
+	)
+	if err != nil {
+		metrics.ProverSubmissionErrorCounter.Add(1)
+		if errors.Is(err, transaction.ErrUnretryableSubmission) {
+			log.Warn("Batch proof submission reverted, fallback to single submissions", "count", len(proofs))
+			return s.submitProofsOneByOne(ctx, proofs)
+		}
+		return err
+	}
+
+	for _, proofWithHeader := range submitted {
+		s.markProofSubmitted(proofWithHeader.BlockID)
+		metrics.ProverLatestProvenBlockIDGauge.Set(float64(proofWithHeader.BlockID.Uint64()))
+	}
+	s.recordProofCosts(receipt, submitted)
+	metrics.ProverSentProofCounter.Add(float64(len(submitted)))
+
+	return nil
+}
+
+// submitProofsOneByOne submits the given proofs in separate transactions.
+func (s *ProofSubmitter) submitProofsOneByOne(ctx context.Context, proofs []*proofProducer.ProofWithHeader) error {
+	var errs []error
+	for _, proofWithHeader := range proofs {
+		if err := s.SubmitProof(ctx, proofWithHeader); err != nil {
+			errs = append(errs, fmt.Errorf("failed to submit proof (id: %d): %w", proofWithHeader.BlockID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateAnchorTx validates the TaikoL2.anchor transaction inside the L2 block of the given proof.
+func (s *ProofSubmitter) validateAnchorTx(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) error {
+	// Get the corresponding L2 block.
+	block, err := s.rpc.L2.BlockByHash(ctx, proofWithHeader.Header.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to get L2 block with given hash %s: %w", proofWithHeader.Header.Hash(), err)
+	}
+
+	if block.Transactions().Len() == 0 {
+		// The blocks without any transaction are only proven by the empty block producer.
+		if s.emptyBlockProducer != nil {
+			return nil
+		}
+		return fmt.Errorf("invalid block without anchor transaction, blockID %s", proofWithHeader.BlockID)
+	}
+
+	if err = s.anchorValidator.ValidateAnchorTx(block.Transactions()[0]); err != nil {
+		return fmt.Errorf("invalid anchor transaction: %w", err)
+	}
+
+	return nil
+}
+
 // getRandomBumpedSubmissionDelay returns a random bumped submission delay.
 func (s *ProofSubmitter) getRandomBumpedSubmissionDelay(expiredAt time.Time) (time.Duration, error) {
 	if s.submissionDelay == 0 {
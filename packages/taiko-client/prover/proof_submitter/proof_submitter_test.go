@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -83,6 +84,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 	s.submitter, err = NewProofSubmitter(
 		s.RPCClient,
 		&producer.OptimisticProofProducer{},
+		nil,
 		s.proofCh,
 		rpc.ZeroAddress,
 		common.HexToAddress(os.Getenv("TAIKO_L2")),
@@ -94,6 +96,8 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		tiers,
 		false,
 		0*time.Second,
+		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.contester = NewProofContester(
@@ -121,6 +125,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		0,
 		nil,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 
@@ -184,6 +189,7 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 	submitter1, err := NewProofSubmitter(
 		s.RPCClient,
 		&producer.OptimisticProofProducer{},
+		nil,
 		s.proofCh,
 		common.Address{},
 		common.HexToAddress(os.Getenv("TAIKO_L2")),
@@ -195,6 +201,8 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 		s.submitter.tiers,
 		false,
 		time.Duration(0),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 
@@ -205,6 +213,7 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 	submitter2, err := NewProofSubmitter(
 		s.RPCClient,
 		&producer.OptimisticProofProducer{},
+		nil,
 		s.proofCh,
 		common.Address{},
 		common.HexToAddress(os.Getenv("TAIKO_L2")),
@@ -216,6 +225,8 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 		s.submitter.tiers,
 		false,
 		1*time.Hour,
+		nil,
+		nil,
 	)
 	s.Nil(err)
 	delay, err = submitter2.getRandomBumpedSubmissionDelay(time.Now())
@@ -263,6 +274,113 @@ func (s *ProofSubmitterTestSuite) TestSubmitProofs() {
 	}
 }
 
+func (s *ProofSubmitterTestSuite) TestProveEmptyBlocks() {
+	emptyBlockProof := crypto.Keccak256([]byte("RETURN_LIVENESS_BOND"))
+	s.submitter.emptyBlockProducer = producer.NewGuardianProofProducer(encoding.TierOptimisticID, true)
+	defer func() { s.submitter.emptyBlockProducer = nil }()
+
+	var emptyBlocks int
+	for _, m := range s.ProposeAndInsertEmptyBlocks(s.proposer, s.blobSyncer) {
+		s.Nil(s.submitter.RequestProof(context.Background(), m))
+		proofWithHeader := <-s.proofCh
+
+		block, err := s.RPCClient.L2.BlockByHash(context.Background(), proofWithHeader.Header.Hash())
+		s.Nil(err)
+		if block.Transactions().Len() <= 1 {
+			emptyBlocks++
+			s.Equal(emptyBlockProof, proofWithHeader.Proof)
+		} else {
+			s.NotEqual(emptyBlockProof, proofWithHeader.Proof)
+		}
+		s.Nil(s.submitter.SubmitProof(context.Background(), proofWithHeader))
+	}
+	s.NotZero(emptyBlocks)
+}
+
+func (s *ProofSubmitterTestSuite) TestProducerForEmptyBlock() {
+	header := &types.Header{Number: common.Big1, TxHash: types.EmptyTxsHash}
+
+	_, err := s.submitter.producerFor(context.Background(), header)
+	s.ErrorContains(err, "no transaction in block")
+
+	emptyBlockProducer := producer.NewGuardianProofProducer(encoding.TierOptimisticID, true)
+	s.submitter.emptyBlockProducer = emptyBlockProducer
+	defer func() { s.submitter.emptyBlockProducer = nil }()
+
+	p, err := s.submitter.producerFor(context.Background(), header)
+	s.Nil(err)
+	s.Same(emptyBlockProducer, p)
+}
+
+func (s *ProofSubmitterTestSuite) TestProveBlockWithoutTransactions() {
+	s.submitter.emptyBlockProducer = producer.NewGuardianProofProducer(encoding.TierOptimisticID, true)
+	defer func() { s.submitter.emptyBlockProducer = nil }()
+
+	m := s.ProposeAndInsertValidBlock(s.proposer, s.blobSyncer)
+
+	// Replace the inserted block with a block without any transaction at the same height.
+	header := s.replaceWithBlockWithoutTransactions(m.GetBlockID())
+	s.Equal(types.EmptyTxsHash, header.TxHash)
+
+	s.Nil(s.submitter.RequestProof(context.Background(), m))
+	proofWithHeader := <-s.proofCh
+	s.Equal(header.Hash(), proofWithHeader.Header.Hash())
+	s.Equal(crypto.Keccak256([]byte("RETURN_LIVENESS_BOND")), proofWithHeader.Proof)
+
+	s.Nil(s.submitter.SubmitProof(context.Background(), proofWithHeader))
+
+	proofStatus, err := rpc.GetBlockProofStatus(
+		context.Background(),
+		s.RPCClient,
+		m.GetBlockID(),
+		s.submitter.proverAddress,
+		rpc.ZeroAddress,
+	)
+	s.Nil(err)
+	s.True(proofStatus.IsSubmitted)
+}
+
+// replaceWithBlockWithoutTransactions inserts a sibling of the given L2 block, which has no
+// transaction, and sets it as the new L2 head, the original block is set back as the L2 head
+// when the test is cleaned up.
+func (s *ProofSubmitterTestSuite) replaceWithBlockWithoutTransactions(blockID *big.Int) *types.Header {
+	block, err := s.RPCClient.L2.BlockByNumber(context.Background(), blockID)
+	s.Nil(err)
+	parent, err := s.RPCClient.L2.HeaderByHash(context.Background(), block.ParentHash())
+	s.Nil(err)
+
+	header := types.CopyHeader(block.Header())
+	header.Root = parent.Root
+	header.TxHash = types.EmptyTxsHash
+	header.ReceiptHash = types.EmptyReceiptsHash
+	header.Bloom = types.Bloom{}
+	header.GasUsed = 0
+	emptyBlock := types.NewBlockWithHeader(header).WithBody(types.Body{Withdrawals: block.Withdrawals()})
+
+	status, err := s.RPCClient.L2Engine.NewPayload(
+		context.Background(),
+		engine.BlockToExecutableData(emptyBlock, nil, nil, nil).ExecutionPayload,
+	)
+	s.Nil(err)
+	s.Equal(engine.VALID, status.Status)
+
+	s.setL2Head(emptyBlock.Hash())
+	s.T().Cleanup(func() { s.setL2Head(block.Hash()) })
+
+	return emptyBlock.Header()
+}
+
+// setL2Head sets the L2 head to the given block.
+func (s *ProofSubmitterTestSuite) setL2Head(hash common.Hash) {
+	res, err := s.RPCClient.L2Engine.ForkchoiceUpdate(
+		context.Background(),
+		&engine.ForkchoiceStateV1{HeadBlockHash: hash},
+		nil,
+	)
+	s.Nil(err)
+	s.Equal(engine.VALID, res.PayloadStatus.Status)
+}
+
 func (s *ProofSubmitterTestSuite) TestGuardianSubmitProofs() {
 	for _, m := range s.ProposeAndInsertEmptyBlocks(s.proposer, s.blobSyncer) {
 		s.Nil(s.submitter.RequestProof(context.Background(), m))
@@ -14,10 +14,12 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
 var (
 	ErrUnretryableSubmission = errors.New("unretryable submission error")
+	ErrLegacyBlockInBatch    = errors.New("legacy blocks can not be proven in a batch")
 	ZeroAddress              common.Address
 )
 
@@ -153,3 +155,65 @@ func (a *ProveBlockTxBuilder) Build(
 		}, nil
 	}
 }
+
+// BuildProveBlocks creates a new TaikoL1.proveBlocks transaction which proves all the given
+// ontake blocks at once.
+func (a *ProveBlockTxBuilder) BuildProveBlocks(
+	proofs []*producer.ProofWithHeader,
+	graffiti [32]byte,
+) TxBuilder {
+	return func(txOpts *bind.TransactOpts) (*txmgr.TxCandidate, error) {
+		var (
+			blockIDs = make([]uint64, len(proofs))
+			inputs   = make([][]byte, len(proofs))
+			data     []byte
+			to       = a.taikoL1Address
+			err      error
+		)
+
+		for i, proof := range proofs {
+			if !proof.Meta.IsOntakeBlock() {
+				return nil, ErrLegacyBlockInBatch
+			}
+
+			if inputs[i], err = encoding.EncodeProveBlockInput(
+				proof.Meta,
+				&bindings.TaikoDataTransition{
+					ParentHash: proof.Header.ParentHash,
+					BlockHash:  proof.Opts.BlockHash,
+					StateRoot:  proof.Opts.StateRoot,
+					Graffiti:   graffiti,
+				},
+				&bindings.TaikoDataTierProof{Tier: proof.Tier, Data: proof.Proof},
+			); err != nil {
+				return nil, err
+			}
+			blockIDs[i] = proof.BlockID.Uint64()
+		}
+
+		log.Info(
+			"Build batch proof submission transaction",
+			"blockIDs", blockIDs,
+			"gasLimit", txOpts.GasLimit,
+		)
+
+		if a.proverSetAddress != ZeroAddress {
+			if data, err = encoding.ProverSetABI.Pack("proveBlocks", blockIDs, inputs, []byte{}); err != nil {
+				return nil, err
+			}
+			to = a.proverSetAddress
+		} else {
+			if data, err = encoding.TaikoL1ABI.Pack("proveBlocks", blockIDs, inputs, []byte{}); err != nil {
+				return nil, err
+			}
+		}
+
+		return &txmgr.TxCandidate{
+			TxData:   data,
+			To:       &to,
+			Blobs:    nil,
+			GasLimit: txOpts.GasLimit,
+			Value:    txOpts.Value,
+		}, nil
+	}
+}
@@ -1,11 +1,15 @@
 package transaction
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
 func (s *TransactionTestSuite) TestBuildTxs() {
@@ -18,3 +22,27 @@ func (s *TransactionTestSuite) TestBuildTxs() {
 	)(&bind.TransactOpts{Nonce: common.Big0, GasLimit: 0, GasTipCap: common.Big0})
 	s.Nil(err)
 }
+
+func (s *TransactionTestSuite) TestBuildProveBlocks() {
+	proofs := []*producer.ProofWithHeader{}
+	for _, id := range []uint64{1, 2} {
+		proofs = append(proofs, &producer.ProofWithHeader{
+			BlockID: new(big.Int).SetUint64(id),
+			Meta: metadata.NewTaikoDataBlockMetadataOntake(&bindings.TaikoL1ClientBlockProposedV2{
+				BlockId: new(big.Int).SetUint64(id),
+				Meta:    bindings.TaikoDataBlockMetadataV2{Id: id},
+			}),
+			Header: &types.Header{},
+			Opts:   &producer.ProofRequestOptions{},
+			Tier:   1,
+		})
+	}
+
+	candidate, err := s.builder.BuildProveBlocks(proofs, [32]byte{})(&bind.TransactOpts{GasLimit: 0})
+	s.Nil(err)
+	s.NotEmpty(candidate.TxData)
+
+	proofs[0].Meta = &metadata.TaikoDataBlockMetadataLegacy{}
+	_, err = s.builder.BuildProveBlocks(proofs, [32]byte{})(&bind.TransactOpts{GasLimit: 0})
+	s.ErrorIs(err, ErrLegacyBlockInBatch)
+}
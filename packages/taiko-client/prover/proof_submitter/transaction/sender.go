@@ -45,12 +45,13 @@ func NewSender(
 	}
 }
 
-// Send sends the given proof to the TaikoL1 smart contract with a backoff policy.
+// Send sends the given proof to the TaikoL1 smart contract with a backoff policy, it returns
+// the transaction receipt, or nil if the proof no longer needs to be submitted.
 func (s *Sender) Send(
 	ctx context.Context,
 	proofWithHeader *producer.ProofWithHeader,
 	buildTx TxBuilder,
-) error {
+) (*types.Receipt, error) {
 	// Check if the proof has already been submitted.
 	proofStatus, err := rpc.GetBlockProofStatus(
 		ctx,
@@ -60,22 +61,108 @@ func (s *Sender) Send(
 		s.proverSetAddress,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if proofStatus.IsSubmitted && !proofStatus.Invalid {
-		return fmt.Errorf("a valid proof for block %d is already submitted", proofWithHeader.BlockID)
+		return nil, fmt.Errorf("a valid proof for block %d is already submitted", proofWithHeader.BlockID)
 	}
 
 	// Check if this proof is still needed to be submitted.
 	ok, err := s.validateProof(ctx, proofWithHeader)
 	if err != nil || !ok {
-		return err
+		return nil, err
 	}
 
+	receipt, err := s.sendTx(ctx, buildTx, "blockID", proofWithHeader.BlockID, "tier", proofWithHeader.Tier)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"💰 Your block proof was accepted",
+		"blockID", proofWithHeader.BlockID,
+		"parentHash", proofWithHeader.Header.ParentHash,
+		"hash", proofWithHeader.Header.Hash(),
+		"stateRoot", proofWithHeader.Opts.StateRoot,
+		"txHash", receipt.TxHash,
+		"tier", proofWithHeader.Tier,
+		"isContest", len(proofWithHeader.Proof) == 0,
+	)
+
+	metrics.ProverSubmissionAcceptedCounter.Add(1)
+
+	return receipt, nil
+}
+
+// SendBatch sends the given proofs to the TaikoL1 smart contract in a single transaction with a backoff
+// policy, the proofs which no longer need to be submitted will be skipped. It returns the submitted proofs
+// and the transaction receipt.
+func (s *Sender) SendBatch(
+	ctx context.Context,
+	proofs []*producer.ProofWithHeader,
+	buildTx func(proofs []*producer.ProofWithHeader) TxBuilder,
+) ([]*producer.ProofWithHeader, *types.Receipt, error) {
+	var (
+		needed   []*producer.ProofWithHeader
+		blockIDs []uint64
+	)
+	for _, proofWithHeader := range proofs {
+		// Check if the proof has already been submitted.
+		proofStatus, err := rpc.GetBlockProofStatus(
+			ctx,
+			s.rpc,
+			proofWithHeader.BlockID,
+			proofWithHeader.Opts.ProverAddress,
+			s.proverSetAddress,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if proofStatus.IsSubmitted && !proofStatus.Invalid {
+			log.Info("A valid proof is already submitted, skip it in batch", "blockID", proofWithHeader.BlockID)
+			continue
+		}
+
+		// Check if this proof is still needed to be submitted.
+		ok, err := s.validateProof(ctx, proofWithHeader)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		needed = append(needed, proofWithHeader)
+		blockIDs = append(blockIDs, proofWithHeader.BlockID.Uint64())
+	}
+
+	if len(needed) == 0 {
+		return nil, nil, nil
+	}
+
+	receipt, err := s.sendTx(ctx, buildTx(needed), "blockIDs", blockIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Info(
+		"💰 Your batch block proofs were accepted",
+		"blockIDs", blockIDs,
+		"txHash", receipt.TxHash,
+	)
+
+	metrics.ProverSubmissionAcceptedCounter.Add(float64(len(needed)))
+
+	return needed, receipt, nil
+}
+
+// sendTx assembles the transaction through the given builder and sends it with a backoff policy,
+// ErrUnretryableSubmission will be returned if the transaction is reverted.
+func (s *Sender) sendTx(ctx context.Context, buildTx TxBuilder, logCtx ...interface{}) (*types.Receipt, error) {
 	// Assemble the TaikoL1.proveBlock transaction.
 	txCandidate, err := buildTx(&bind.TransactOpts{GasLimit: s.gasLimit})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Send the transaction.
@@ -95,36 +182,24 @@ func (s *Sender) Send(
 		if isPrivate {
 			s.txmgrSelector.RecordPrivateTxMgrFailed()
 		}
-		return encoding.TryParsingCustomError(err)
+		return nil, encoding.TryParsingCustomError(err)
 	}
 
 	if receipt.Status != types.ReceiptStatusSuccessful {
 		log.Error(
 			"Failed to submit proof",
-			"blockID", proofWithHeader.BlockID,
-			"tier", proofWithHeader.Tier,
-			"txHash", receipt.TxHash,
-			"isPrivateMempool", isPrivate,
-			"error", encoding.TryParsingCustomErrorFromReceipt(ctx, s.rpc.L1, txMgr.From(), receipt),
+			append(
+				logCtx,
+				"txHash", receipt.TxHash,
+				"isPrivateMempool", isPrivate,
+				"error", encoding.TryParsingCustomErrorFromReceipt(ctx, s.rpc.L1, txMgr.From(), receipt),
+			)...,
 		)
 		metrics.ProverSubmissionRevertedCounter.Add(1)
-		return ErrUnretryableSubmission
+		return nil, ErrUnretryableSubmission
 	}
 
-	log.Info(
-		"💰 Your block proof was accepted",
-		"blockID", proofWithHeader.BlockID,
-		"parentHash", proofWithHeader.Header.ParentHash,
-		"hash", proofWithHeader.Header.Hash(),
-		"stateRoot", proofWithHeader.Opts.StateRoot,
-		"txHash", receipt.TxHash,
-		"tier", proofWithHeader.Tier,
-		"isContest", len(proofWithHeader.Proof) == 0,
-	)
-
-	metrics.ProverSubmissionAcceptedCounter.Add(1)
-
-	return nil
+	return receipt, nil
 }
 
 // validateProof checks if the proof's corresponding L1 block is still in the canonical chain and if the
@@ -2,8 +2,10 @@ package prover
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -21,14 +23,21 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
 	guardianProverHeartbeater "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/guardian_prover_heartbeater"
+	store "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/job_store"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/ledger"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/server"
 	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
 )
 
+// proofBufferCheckInterval is the interval to check whether the buffered proofs should be submitted.
+var proofBufferCheckInterval = 5 * time.Second
+
 // Prover keeps trying to prove newly proposed blocks.
 type Prover struct {
 	// Configurations
@@ -38,6 +47,9 @@ type Prover struct {
 	// Clients
 	rpc *rpc.Client
 
+	// HTTP server
+	server *server.ProverServer
+
 	// Guardian prover related
 	guardianProverHeartbeater guardianProverHeartbeater.BlockSenderHeartbeater
 
@@ -46,6 +58,8 @@ type Prover struct {
 
 	// States
 	sharedState *state.SharedState
+	jobStore    *store.JobStore
+	costLedger  *ledger.Ledger
 
 	// Event handlers
 	blockProposedHandler       handler.BlockProposedHandler
@@ -57,6 +71,10 @@ type Prover struct {
 	// Proof submitters
 	proofSubmitters []proofSubmitter.Submitter
 	proofContester  proofSubmitter.Contester
+	// Proof buffers for batch submissions, keyed by proof tier
+	proofBuffers map[uint16]*proofSubmitter.ProofBuffer
+	// Raiko host pools of the proof producers
+	raikoPools []*proofProducer.RaikoPool
 
 	assignmentExpiredCh chan metadata.TaikoBlockMetaData
 	proveNotify         chan struct{}
@@ -118,6 +136,9 @@ func InitFromConfig(
 		GuardianProverMinorityAddress: cfg.GuardianProverMinorityAddress,
 		GuardianProverMajorityAddress: cfg.GuardianProverMajorityAddress,
 		Timeout:                       cfg.RPCTimeout,
+		L1QuorumEndpoints:             cfg.L1QuorumEndpoints,
+		L1QuorumThreshold:             cfg.L1QuorumThreshold,
+		L1QuorumObserver:              metrics.L1QuorumObserver,
 	}); err != nil {
 		return err
 	}
@@ -158,17 +179,24 @@ func InitFromConfig(
 		p.cfg.GuardianProverMinorityAddress,
 	)
 
+	log.Debug("Initializing L1 prover signer")
+	l1ProverSigner, err := signer.New(ctx, cfg.L1ProverPrivKey, cfg.RemoteSigner)
+	if err != nil {
+		return fmt.Errorf("initialize L1 prover signer error: %w", err)
+	}
+
 	log.Debug("Setting up transaction managers")
 	if txMgr != nil {
 		p.txmgr = txMgr
 		log.Debug("Using provided transaction manager")
 	} else {
 		log.Debug("Creating new transaction manager")
-		if p.txmgr, err = txmgr.NewSimpleTxManager(
+		if p.txmgr, err = signer.NewTxManager(
 			"prover",
 			log.Root(),
 			&metrics.TxMgrMetrics,
 			*cfg.TxmgrConfigs,
+			l1ProverSigner,
 		); err != nil {
 			return err
 		}
@@ -181,11 +209,12 @@ func InitFromConfig(
 		log.Debug("Checking private transaction manager config")
 		if cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
 			log.Debug("Creating new private transaction manager")
-			if p.privateTxmgr, err = txmgr.NewSimpleTxManager(
+			if p.privateTxmgr, err = signer.NewTxManager(
 				"privateMempoolProver",
 				log.Root(),
 				&metrics.TxMgrMetrics,
 				*cfg.PrivateTxmgrConfigs,
+				l1ProverSigner,
 			); err != nil {
 				return err
 			}
@@ -195,6 +224,22 @@ func InitFromConfig(
 		}
 	}
 
+	// Proof job store
+	if cfg.JobStorePath != "" {
+		log.Debug("Initializing proof job store", "path", cfg.JobStorePath)
+		if p.jobStore, err = store.Open(cfg.JobStorePath); err != nil {
+			return err
+		}
+	}
+
+	// Proof cost ledger
+	if cfg.CostLedgerPath != "" {
+		log.Debug("Initializing proof cost ledger", "path", cfg.CostLedgerPath)
+		if p.costLedger, err = ledger.Open(cfg.CostLedgerPath); err != nil {
+			return err
+		}
+	}
+
 	// Proof submitters
 	log.Debug("Initializing proof submitters")
 	if err := p.initProofSubmitters(txBuilder, tiers); err != nil {
@@ -213,6 +258,23 @@ func InitFromConfig(
 		txBuilder,
 	)
 
+	// Prover server
+	if p.server, err = server.New(&server.NewProverServerOpts{
+		RPC:               p.rpc,
+		SharedState:       p.sharedState,
+		ProverAddress:     p.ProverAddress(),
+		ProverSetAddress:  p.cfg.ProverSetAddress,
+		Graffiti:          p.cfg.Graffiti,
+		ProofSubmitters:   p.proofSubmitters,
+		ProofSubmissionCh: p.proofSubmissionCh,
+		ProofGenerationCh: p.proofGenerationCh,
+		AdminToken:        p.cfg.AdminToken,
+		CostLedger:        p.costLedger,
+		OntakeForkHeight:  p.protocolConfig.OntakeForkHeight,
+	}); err != nil {
+		return err
+	}
+
 	// Initialize event handlers.
 	log.Debug("Initializing event handlers")
 	if err := p.initEventHandlers(); err != nil {
@@ -233,9 +295,27 @@ func (p *Prover) Start() error {
 		}
 	}
 
-	// 2. Start the main event loop of the prover.
+	// 2. Start the HTTP server.
+	if p.cfg.HTTPServerPort > 0 {
+		go func() {
+			if err := p.server.Start(fmt.Sprintf(":%v", p.cfg.HTTPServerPort)); !errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start http server", "error", err)
+			}
+		}()
+	}
+
+	// 3. Start the health checks of the Raiko hosts.
+	for _, pool := range p.raikoPools {
+		pool.Start(p.ctx)
+	}
+
+	// 4. Start the main event loop of the prover.
 	go p.eventLoop()
 
+	// 5. Resume the persisted proof jobs.
+	p.wg.Add(1)
+	go p.replayProofJobs()
+
 	return nil
 }
 
@@ -261,6 +341,10 @@ func (p *Prover) eventLoop() {
 	forceProvingTicker := time.NewTicker(15 * time.Second)
 	defer forceProvingTicker.Stop()
 
+	// Ticker to submit the buffered proofs which have reached the batch deadline.
+	proofBufferTicker := time.NewTicker(proofBufferCheckInterval)
+	defer proofBufferTicker.Stop()
+
 	// Channels
 	chBufferSize := p.protocolConfig.BlockMaxProposals
 	blockProposedCh := make(chan *bindings.TaikoL1ClientBlockProposed, chBufferSize)
@@ -298,15 +382,22 @@ func (p *Prover) eventLoop() {
 		case req := <-p.proofContestCh:
 			p.withRetry(func() error { return p.contestProofOp(req) })
 		case proofWithHeader := <-p.proofGenerationCh:
-			p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
+			p.bufferOrSubmitProof(proofWithHeader)
+		case <-proofBufferTicker.C:
+			p.flushExpiredProofBuffers()
 		case req := <-p.proofSubmissionCh:
 			p.withRetry(func() error { return p.requestProofOp(req.Meta, req.Tier) })
 		case <-p.proveNotify:
+			if p.sharedState.IsProvingPaused() {
+				log.Debug("Proving is paused, skip proving new blocks")
+				continue
+			}
 			if err := p.proveOp(); err != nil {
 				log.Error("Prove new blocks error", "error", err)
 			}
 		case e := <-blockVerifiedCh:
 			p.blockVerifiedHandler.Handle(encoding.BlockVerifiedEventToV2(e))
+			p.pruneProofJobs(e.BlockId)
 		case e := <-transitionProvedCh:
 			p.withRetry(func() error {
 				blockInfo, err := p.rpc.GetL2BlockInfo(p.ctx, e.BlockId)
@@ -328,6 +419,7 @@ func (p *Prover) eventLoop() {
 			})
 		case e := <-blockVerifiedV2Ch:
 			p.blockVerifiedHandler.Handle(e)
+			p.pruneProofJobs(e.BlockId)
 		case e := <-transitionProvedV2Ch:
 			p.withRetry(func() error {
 				return p.transitionProvedHandler.Handle(p.ctx, e)
@@ -349,8 +441,76 @@ func (p *Prover) eventLoop() {
 }
 
 // Close closes the prover instance.
-func (p *Prover) Close(_ context.Context) {
+func (p *Prover) Close(ctx context.Context) {
+	if p.server != nil {
+		if err := p.server.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down prover server", "error", err)
+		}
+	}
+
 	p.wg.Wait()
+
+	if p.jobStore != nil {
+		if err := p.jobStore.Close(); err != nil {
+			log.Error("Failed to close proof job store", "error", err)
+		}
+	}
+
+	if p.costLedger != nil {
+		if err := p.costLedger.Close(); err != nil {
+			log.Error("Failed to close proof cost ledger", "error", err)
+		}
+	}
+}
+
+// replayProofJobs requests the proofs of all persisted jobs which have not been submitted yet,
+// so that the collected proofs can be resumed instead of being regenerated.
+func (p *Prover) replayProofJobs() {
+	defer p.wg.Done()
+
+	if p.jobStore == nil {
+		return
+	}
+
+	jobs, err := p.jobStore.Pending()
+	if err != nil {
+		log.Error("Failed to load pending proof jobs", "error", err)
+		return
+	}
+
+	log.Info("Replaying pending proof jobs", "count", len(jobs))
+
+	for _, job := range jobs {
+		meta, err := handler.GetMetadataFromBlockID(
+			p.ctx,
+			p.rpc,
+			new(big.Int).SetUint64(job.BlockID),
+			new(big.Int).SetUint64(job.ProposedIn),
+		)
+		if err != nil {
+			log.Error("Failed to fetch metadata of proof job", "blockID", job.BlockID, "error", err)
+			continue
+		}
+
+		log.Info("Resuming proof job", "blockID", job.BlockID, "tier", job.Tier, "status", job.Status)
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case p.proofSubmissionCh <- &proofProducer.ProofRequestBody{Tier: job.Tier, Meta: meta}:
+		}
+	}
+}
+
+// pruneProofJobs removes the persisted proof jobs of the verified blocks.
+func (p *Prover) pruneProofJobs(lastVerifiedBlockID *big.Int) {
+	if p.jobStore == nil {
+		return
+	}
+
+	if err := p.jobStore.Prune(lastVerifiedBlockID); err != nil {
+		log.Error("Failed to prune proof jobs", "lastVerifiedBlockID", lastVerifiedBlockID, "error", err)
+	}
 }
 
 // proveOp iterates through BlockProposed events.
@@ -445,6 +605,68 @@ func (p *Prover) submitProofOp(proofWithHeader *proofProducer.ProofWithHeader) e
 	return nil
 }
 
+// bufferOrSubmitProof adds the given proof into the buffer of its tier if batch submission is enabled,
+// and submits the whole batch once the buffer is full, otherwise the proof is submitted directly.
+func (p *Prover) bufferOrSubmitProof(proofWithHeader *proofProducer.ProofWithHeader) {
+	buffer, ok := p.proofBuffers[proofWithHeader.Tier]
+	if !ok {
+		p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
+		return
+	}
+
+	length, err := buffer.Write(proofWithHeader)
+	if err != nil {
+		log.Error("Failed to add proof into buffer", "blockID", proofWithHeader.BlockID, "error", err)
+		p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
+		return
+	}
+
+	log.Info(
+		"Proof added into buffer",
+		"blockID", proofWithHeader.BlockID,
+		"tier", proofWithHeader.Tier,
+		"bufferLength", length,
+	)
+
+	if buffer.Full() {
+		p.flushProofBuffer(proofWithHeader.Tier, buffer)
+	}
+}
+
+// flushExpiredProofBuffers submits the buffered proofs which have been waiting longer than the batch timeout.
+func (p *Prover) flushExpiredProofBuffers() {
+	for tier, buffer := range p.proofBuffers {
+		if buffer.Len() != 0 && time.Since(buffer.FirstItemAt()) >= p.cfg.ProofBatchTimeout {
+			p.flushProofBuffer(tier, buffer)
+		}
+	}
+}
+
+// flushProofBuffer submits all proofs in the given buffer in a batch.
+func (p *Prover) flushProofBuffer(tier uint16, buffer *proofSubmitter.ProofBuffer) {
+	proofs := buffer.Flush()
+	if len(proofs) == 0 {
+		return
+	}
+
+	p.withRetry(func() error { return p.batchSubmitProofsOp(tier, proofs) })
+}
+
+// batchSubmitProofsOp performs a batch proof submission operation.
+func (p *Prover) batchSubmitProofsOp(tier uint16, proofs []*proofProducer.ProofWithHeader) error {
+	submitter := p.getSubmitterByTier(tier)
+	if submitter == nil {
+		return nil
+	}
+
+	if err := submitter.BatchSubmitProofs(p.ctx, proofs); err != nil {
+		log.Error("Batch submit proofs error", "tier", tier, "count", len(proofs), "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // Name returns the application name.
 func (p *Prover) Name() string {
 	return "prover"
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	submitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+)
+
+// L1Cursor represents the current L1 cursor of the prover.
+type L1Cursor struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// Status represents the current status of the prover.
+type Status struct {
+	L1Current            *L1Cursor `json:"l1Current"`
+	ProofSubmissionQueue int       `json:"proofSubmissionQueue"`
+	ProofGenerationQueue int       `json:"proofGenerationQueue"`
+	Paused               bool      `json:"paused"`
+}
+
+// BlockProofStatus represents the on-chain proof status of a L2 block.
+type BlockProofStatus struct {
+	BlockID     uint64          `json:"blockId"`
+	IsSubmitted bool            `json:"isSubmitted"`
+	Invalid     bool            `json:"invalid"`
+	BlockHash   *common.Hash    `json:"blockHash,omitempty"`
+	StateRoot   *common.Hash    `json:"stateRoot,omitempty"`
+	Prover      *common.Address `json:"prover,omitempty"`
+	Contester   *common.Address `json:"contester,omitempty"`
+	Tier        uint16          `json:"tier,omitempty"`
+}
+
+// GetStatus handles a query to the current prover status.
+//
+//	@Summary		Get current prover status
+//	@ID			   	get-status
+//	@Produce		json
+//	@Success		200	{object} Status
+//	@Router			/status [get]
+func (s *ProverServer) GetStatus(c echo.Context) error {
+	status := &Status{
+		ProofSubmissionQueue: len(s.proofSubmissionCh),
+		ProofGenerationQueue: len(s.proofGenerationCh),
+		Paused:               s.sharedState.IsProvingPaused(),
+	}
+
+	if l1Current := s.sharedState.GetL1Current(); l1Current != nil {
+		status.L1Current = &L1Cursor{Number: l1Current.Number.Uint64(), Hash: l1Current.Hash()}
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+// GetBlockProofStatus handles a query to the on-chain proof status of the given L2 block.
+//
+//	@Summary		Get the proof status of a L2 block
+//	@ID			   	get-block-proof-status
+//	@Param			id	path	int	true	"L2 block ID"
+//	@Produce		json
+//	@Success		200	{object} BlockProofStatus
+//	@Router			/blocks/{id}/proofStatus [get]
+func (s *ProverServer) GetBlockProofStatus(c echo.Context) error {
+	blockID, err := parseBlockID(c)
+	if err != nil {
+		return err
+	}
+
+	proofStatus, err := rpc.GetBlockProofStatus(
+		c.Request().Context(),
+		s.rpc,
+		blockID,
+		s.proverAddress,
+		s.proverSetAddress,
+	)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	res := &BlockProofStatus{
+		BlockID:     blockID.Uint64(),
+		IsSubmitted: proofStatus.IsSubmitted,
+		Invalid:     proofStatus.Invalid,
+	}
+	if ts := proofStatus.CurrentTransitionState; ts != nil {
+		blockHash, stateRoot := common.Hash(ts.BlockHash), common.Hash(ts.StateRoot)
+		res.BlockHash = &blockHash
+		res.StateRoot = &stateRoot
+		res.Prover = &ts.Prover
+		res.Contester = &ts.Contester
+		res.Tier = ts.Tier
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ForceProveBlock handles a request to force proving the given L2 block, an optional `tier`
+// query parameter can be used to override the block's minimum tier.
+//
+//	@Summary		Force proving a L2 block
+//	@ID			   	force-prove-block
+//	@Param			id	path	int	true	"L2 block ID"
+//	@Param			tier	query	int	false	"Proof tier"
+//	@Success		202
+//	@Router			/admin/blocks/{id}/prove [post]
+func (s *ProverServer) ForceProveBlock(c echo.Context) error {
+	blockID, err := parseBlockID(c)
+	if err != nil {
+		return err
+	}
+
+	blockInfo, err := s.getL2BlockInfo(c.Request().Context(), blockID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	meta, err := handler.GetMetadataFromBlockID(
+		c.Request().Context(),
+		s.rpc,
+		blockID,
+		new(big.Int).SetUint64(blockInfo.ProposedIn),
+	)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	tier := meta.GetMinTier()
+	if c.QueryParam("tier") != "" {
+		if tier, err = parseTier(c); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Force proving block", "blockID", blockID, "tier", tier)
+
+	select {
+	case s.proofSubmissionCh <- &producer.ProofRequestBody{Tier: tier, Meta: meta}:
+	default:
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "proof submission queue is full")
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// CancelProofRequest handles a request to cancel the proof generation of the given L2 block,
+// the `tier` query parameter is required to select the proof producer.
+//
+//	@Summary		Cancel a proof request of a L2 block
+//	@ID			   	cancel-proof-request
+//	@Param			id	path	int	true	"L2 block ID"
+//	@Param			tier	query	int	true	"Proof tier"
+//	@Success		200
+//	@Router			/admin/blocks/{id}/cancel [post]
+func (s *ProverServer) CancelProofRequest(c echo.Context) error {
+	blockID, err := parseBlockID(c)
+	if err != nil {
+		return err
+	}
+
+	tier, err := parseTier(c)
+	if err != nil {
+		return err
+	}
+
+	var proofSubmitter submitter.Submitter
+	for _, sub := range s.proofSubmitters {
+		if sub.Tier() == tier {
+			proofSubmitter = sub
+			break
+		}
+	}
+	if proofSubmitter == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "no proof producer found for the given tier")
+	}
+
+	// The meta hash is a part of the Raiko request key, which selects the host of the request.
+	blockInfo, err := s.getL2BlockInfo(c.Request().Context(), blockID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	graffiti := rpc.StringToBytes32(s.graffiti)
+	opts := &producer.ProofRequestOptions{
+		BlockID:       blockID,
+		ProverAddress: s.proverAddress,
+		MetaHash:      blockInfo.MetaHash,
+		Graffiti:      common.Bytes2Hex(graffiti[:]),
+	}
+	if s.proverSetAddress != rpc.ZeroAddress {
+		opts.ProverAddress = s.proverSetAddress
+	}
+
+	log.Info("Cancelling proof request", "blockID", blockID, "tier", tier)
+
+	if err := proofSubmitter.Producer().RequestCancel(c.Request().Context(), opts); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PauseProving handles a request to pause proving newly proposed blocks, the proofs
+// which are already in flight will still be submitted.
+//
+//	@Summary		Pause proving newly proposed blocks
+//	@ID			   	pause-proving
+//	@Success		200
+//	@Router			/admin/pause [post]
+func (s *ProverServer) PauseProving(c echo.Context) error {
+	log.Info("Proving paused")
+	s.sharedState.SetProvingPaused(true)
+
+	return c.NoContent(http.StatusOK)
+}
+
+// ResumeProving handles a request to resume proving newly proposed blocks.
+//
+//	@Summary		Resume proving newly proposed blocks
+//	@ID			   	resume-proving
+//	@Success		200
+//	@Router			/admin/resume [post]
+func (s *ProverServer) ResumeProving(c echo.Context) error {
+	log.Info("Proving resumed")
+	s.sharedState.SetProvingPaused(false)
+
+	return c.NoContent(http.StatusOK)
+}
+
+// getL2BlockInfo fetches the L2 block information from the protocol, the blocks proposed before
+// the ontake fork are fetched with the legacy getter.
+func (s *ProverServer) getL2BlockInfo(ctx context.Context, blockID *big.Int) (bindings.TaikoDataBlockV2, error) {
+	if blockID.Uint64() < s.ontakeForkHeight {
+		return s.blockInfoFetcher.GetL2BlockInfo(ctx, blockID)
+	}
+
+	return s.blockInfoFetcher.GetL2BlockInfoV2(ctx, blockID)
+}
+
+// parseBlockID parses the `id` path parameter.
+func parseBlockID(c echo.Context) (*big.Int, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid block ID")
+	}
+
+	return new(big.Int).SetUint64(id), nil
+}
+
+// parseTier parses the `tier` query parameter.
+func parseTier(c echo.Context) (uint16, error) {
+	tier, err := strconv.ParseUint(c.QueryParam("tier"), 10, 16)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "invalid proof tier")
+	}
+
+	return uint16(tier), nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/ledger"
+)
+
+// GetProofCosts handles a query to the recorded proof costs, the `from` and `to` query
+// parameters are optional unix timestamps, and `format` can be either `json` or `csv`.
+//
+//	@Summary		Export the recorded proof costs
+//	@ID			   	get-proof-costs
+//	@Param			from	query	int	false	"Start unix timestamp (inclusive)"
+//	@Param			to	query	int	false	"End unix timestamp (exclusive)"
+//	@Param			format	query	string	false	"Export format, json (default) or csv"
+//	@Produce		json
+//	@Produce		text/csv
+//	@Success		200	{object} []ledger.Entry
+//	@Router			/costs [get]
+func (s *ProverServer) GetProofCosts(c echo.Context) error {
+	entries, err := s.queryProofCosts(c)
+	if err != nil {
+		return err
+	}
+
+	switch c.QueryParam("format") {
+	case "", "json":
+		return c.JSON(http.StatusOK, entries)
+	case "csv":
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="proof_costs.csv"`)
+		c.Response().WriteHeader(http.StatusOK)
+		return ledger.WriteCSV(c.Response(), entries)
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid export format")
+	}
+}
+
+// GetProofCostsSummary handles a query to the recorded proof costs aggregated by proof tier
+// and time window, the `window` query parameter is an optional duration, such as `1h`.
+//
+//	@Summary		Get the proof costs aggregated by tier and time window
+//	@ID			   	get-proof-costs-summary
+//	@Param			from	query	int	false	"Start unix timestamp (inclusive)"
+//	@Param			to	query	int	false	"End unix timestamp (exclusive)"
+//	@Param			window	query	string	false	"Aggregation window, such as 1h"
+//	@Produce		json
+//	@Success		200	{object} []ledger.Summary
+//	@Router			/costs/summary [get]
+func (s *ProverServer) GetProofCostsSummary(c echo.Context) error {
+	var (
+		window time.Duration
+		err    error
+	)
+	if c.QueryParam("window") != "" {
+		if window, err = time.ParseDuration(c.QueryParam("window")); err != nil || window < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid window")
+		}
+	}
+
+	entries, err := s.queryProofCosts(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, ledger.Summarize(entries, window))
+}
+
+// queryProofCosts returns the recorded proof costs in the time range of the query parameters.
+func (s *ProverServer) queryProofCosts(c echo.Context) ([]*ledger.Entry, error) {
+	from, err := parseTimestamp(c, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTimestamp(c, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.costLedger.Entries(from, to)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return entries, nil
+}
+
+// parseTimestamp parses the given optional unix timestamp query parameter.
+func parseTimestamp(c echo.Context, name string) (time.Time, error) {
+	if c.QueryParam(name) == "" {
+		return time.Time{}, nil
+	}
+
+	timestamp, err := strconv.ParseInt(c.QueryParam(name), 10, 64)
+	if err != nil {
+		return time.Time{}, echo.NewHTTPError(http.StatusBadRequest, "invalid "+name+" timestamp")
+	}
+
+	return time.Unix(timestamp, 0), nil
+}
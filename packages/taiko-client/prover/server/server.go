@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"math/big"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/ledger"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	submitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
+)
+
+var (
+	errNoSharedState = errors.New("no prover shared state")
+	errNoRPCClient   = errors.New("no RPC client")
+)
+
+// blockInfoFetcher fetches the L2 block information from the protocol, it is implemented by
+// the RPC client.
+type blockInfoFetcher interface {
+	GetL2BlockInfo(ctx context.Context, blockID *big.Int) (bindings.TaikoDataBlockV2, error)
+	GetL2BlockInfoV2(ctx context.Context, blockID *big.Int) (bindings.TaikoDataBlockV2, error)
+}
+
+// ProverServer represents a prover server instance, which exposes the prover status and
+// some authenticated admin actions.
+type ProverServer struct {
+	echo              *echo.Echo
+	rpc               *rpc.Client
+	sharedState       *state.SharedState
+	proverAddress     common.Address
+	proverSetAddress  common.Address
+	graffiti          string
+	proofSubmitters   []submitter.Submitter
+	proofSubmissionCh chan *producer.ProofRequestBody
+	proofGenerationCh chan *producer.ProofWithHeader
+	adminToken        string
+	costLedger        *ledger.Ledger
+	blockInfoFetcher  blockInfoFetcher
+	ontakeForkHeight  uint64
+}
+
+// NewProverServerOpts contains all configurations for creating a prover server instance.
+type NewProverServerOpts struct {
+	RPC               *rpc.Client
+	SharedState       *state.SharedState
+	ProverAddress     common.Address
+	ProverSetAddress  common.Address
+	Graffiti          string
+	ProofSubmitters   []submitter.Submitter
+	ProofSubmissionCh chan *producer.ProofRequestBody
+	ProofGenerationCh chan *producer.ProofWithHeader
+	// The admin routes will only be enabled when the token is not empty.
+	AdminToken string
+	// The proof cost routes will only be enabled when the ledger is not nil.
+	CostLedger       *ledger.Ledger
+	OntakeForkHeight uint64
+}
+
+// New creates a new prover server instance.
+func New(opts *NewProverServerOpts) (*ProverServer, error) {
+	if opts.SharedState == nil {
+		return nil, errNoSharedState
+	}
+	if opts.RPC == nil {
+		return nil, errNoRPCClient
+	}
+
+	srv := &ProverServer{
+		echo:              echo.New(),
+		rpc:               opts.RPC,
+		sharedState:       opts.SharedState,
+		proverAddress:     opts.ProverAddress,
+		proverSetAddress:  opts.ProverSetAddress,
+		graffiti:          opts.Graffiti,
+		proofSubmitters:   opts.ProofSubmitters,
+		proofSubmissionCh: opts.ProofSubmissionCh,
+		proofGenerationCh: opts.ProofGenerationCh,
+		adminToken:        opts.AdminToken,
+		costLedger:        opts.CostLedger,
+		blockInfoFetcher:  opts.RPC,
+		ontakeForkHeight:  opts.OntakeForkHeight,
+	}
+
+	srv.echo.HideBanner = true
+	srv.configureMiddleware()
+	srv.configureRoutes()
+
+	return srv, nil
+}
+
+// Start starts the HTTP server.
+func (s *ProverServer) Start(address string) error {
+	return s.echo.Start(address)
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *ProverServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// ServeHTTP implements the `http.Handler` interface which serves HTTP requests.
+func (s *ProverServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.echo.ServeHTTP(w, r)
+}
+
+// Health endpoints for probes.
+func (s *ProverServer) Health(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// LogSkipper implements the `middleware.Skipper` interface.
+func LogSkipper(c echo.Context) bool {
+	switch c.Request().URL.Path {
+	case "/healthz":
+		return true
+	default:
+		return false
+	}
+}
+
+// configureMiddleware configures the server middlewares.
+func (s *ProverServer) configureMiddleware() {
+	s.echo.Use(middleware.RequestID())
+
+	s.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: LogSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+}
+
+// configureRoutes contains all routes which will be used by prover server.
+func (s *ProverServer) configureRoutes() {
+	s.echo.GET("/", s.Health)
+	s.echo.GET("/healthz", s.Health)
+	s.echo.GET("/status", s.GetStatus)
+	s.echo.GET("/blocks/:id/proofStatus", s.GetBlockProofStatus)
+
+	if s.costLedger != nil {
+		s.echo.GET("/costs", s.GetProofCosts)
+		s.echo.GET("/costs/summary", s.GetProofCostsSummary)
+	}
+
+	if s.adminToken == "" {
+		return
+	}
+
+	admin := s.echo.Group("/admin", middleware.KeyAuth(func(key string, _ echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(s.adminToken)) == 1, nil
+	}))
+	admin.POST("/blocks/:id/prove", s.ForceProveBlock)
+	admin.POST("/blocks/:id/cancel", s.CancelProofRequest)
+	admin.POST("/pause", s.PauseProving)
+	admin.POST("/resume", s.ResumeProving)
+}
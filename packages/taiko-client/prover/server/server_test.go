@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/ledger"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
+)
+
+const testAdminToken = "testAdminToken"
+
+type ProverServerTestSuite struct {
+	suite.Suite
+	srv *ProverServer
+}
+
+func (s *ProverServerTestSuite) SetupTest() {
+	s.srv = &ProverServer{
+		echo:              echo.New(),
+		sharedState:       state.New(),
+		proofSubmissionCh: make(chan *producer.ProofRequestBody, 10),
+		proofGenerationCh: make(chan *producer.ProofWithHeader, 10),
+		adminToken:        testAdminToken,
+		costLedger:        ledger.NewMemory(),
+	}
+
+	s.srv.configureMiddleware()
+	s.srv.configureRoutes()
+}
+
+func (s *ProverServerTestSuite) TestNew() {
+	_, err := New(&NewProverServerOpts{})
+	s.ErrorIs(err, errNoSharedState)
+
+	_, err = New(&NewProverServerOpts{SharedState: state.New()})
+	s.ErrorIs(err, errNoRPCClient)
+}
+
+func (s *ProverServerTestSuite) TestHealth() {
+	res := s.request(http.MethodGet, "/healthz", "")
+	s.Equal(http.StatusOK, res.Code)
+}
+
+func (s *ProverServerTestSuite) TestGetStatus() {
+	l1Current := &types.Header{Number: common.Big256}
+	s.srv.sharedState.SetL1Current(l1Current)
+	s.srv.proofSubmissionCh <- &producer.ProofRequestBody{}
+
+	res := s.request(http.MethodGet, "/status", "")
+	s.Equal(http.StatusOK, res.Code)
+
+	status := new(Status)
+	s.Nil(json.Unmarshal(res.Body.Bytes(), status))
+	s.Equal(common.Big256.Uint64(), status.L1Current.Number)
+	s.Equal(l1Current.Hash(), status.L1Current.Hash)
+	s.Equal(1, status.ProofSubmissionQueue)
+	s.Zero(status.ProofGenerationQueue)
+	s.False(status.Paused)
+}
+
+func (s *ProverServerTestSuite) TestPauseAndResume() {
+	s.Equal(http.StatusBadRequest, s.request(http.MethodPost, "/admin/pause", "").Code)
+	s.Equal(http.StatusUnauthorized, s.request(http.MethodPost, "/admin/pause", "wrongToken").Code)
+	s.False(s.srv.sharedState.IsProvingPaused())
+
+	s.Equal(http.StatusOK, s.request(http.MethodPost, "/admin/pause", testAdminToken).Code)
+	s.True(s.srv.sharedState.IsProvingPaused())
+
+	s.Equal(http.StatusOK, s.request(http.MethodPost, "/admin/resume", testAdminToken).Code)
+	s.False(s.srv.sharedState.IsProvingPaused())
+}
+
+func (s *ProverServerTestSuite) TestInvalidParams() {
+	s.Equal(http.StatusBadRequest, s.request(http.MethodGet, "/blocks/abc/proofStatus", "").Code)
+	s.Equal(http.StatusBadRequest, s.request(http.MethodPost, "/admin/blocks/1/cancel?tier=abc", testAdminToken).Code)
+	s.Equal(http.StatusNotFound, s.request(http.MethodPost, "/admin/blocks/1/cancel?tier=200", testAdminToken).Code)
+}
+
+func (s *ProverServerTestSuite) TestGetProofCosts() {
+	s.Nil(s.srv.costLedger.Record(&ledger.Entry{BlockID: 1, Tier: 200, L1Cost: common.Big1, SubmittedAt: time.Now()}))
+
+	res := s.request(http.MethodGet, "/costs", "")
+	s.Equal(http.StatusOK, res.Code)
+	var entries []*ledger.Entry
+	s.Nil(json.Unmarshal(res.Body.Bytes(), &entries))
+	s.Len(entries, 1)
+
+	res = s.request(http.MethodGet, "/costs?format=csv", "")
+	s.Equal(http.StatusOK, res.Code)
+	s.Equal("text/csv", res.Header().Get(echo.HeaderContentType))
+	s.Len(strings.Split(strings.TrimSpace(res.Body.String()), "\n"), 2)
+
+	res = s.request(http.MethodGet, "/costs/summary?window=1h", "")
+	s.Equal(http.StatusOK, res.Code)
+	var summaries []*ledger.Summary
+	s.Nil(json.Unmarshal(res.Body.Bytes(), &summaries))
+	s.Len(summaries, 1)
+	s.Equal(1, summaries[0].Proofs)
+
+	s.Equal(http.StatusBadRequest, s.request(http.MethodGet, "/costs?format=xml", "").Code)
+	s.Equal(http.StatusBadRequest, s.request(http.MethodGet, "/costs?from=abc", "").Code)
+	s.Equal(http.StatusBadRequest, s.request(http.MethodGet, "/costs/summary?window=abc", "").Code)
+}
+
+func (s *ProverServerTestSuite) TestGetL2BlockInfo() {
+	s.srv.blockInfoFetcher = new(stubBlockInfoFetcher)
+	s.srv.ontakeForkHeight = 10
+
+	for blockID, proposedIn := range map[uint64]uint64{1: 1, 9: 1, 10: 2, 11: 2} {
+		blockInfo, err := s.srv.getL2BlockInfo(context.Background(), new(big.Int).SetUint64(blockID))
+		s.Nil(err)
+		s.Equal(blockID, blockInfo.BlockId)
+		s.Equal(proposedIn, blockInfo.ProposedIn)
+	}
+}
+
+func (s *ProverServerTestSuite) TestAdminRoutesDisabled() {
+	s.srv = &ProverServer{echo: echo.New(), sharedState: state.New()}
+	s.srv.configureRoutes()
+
+	s.Equal(http.StatusNotFound, s.request(http.MethodPost, "/admin/pause", testAdminToken).Code)
+}
+
+// request sends a test request to the server with the given bearer token.
+func (s *ProverServerTestSuite) request(method string, target string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	res := httptest.NewRecorder()
+	s.srv.ServeHTTP(res, req)
+
+	return res
+}
+
+// stubBlockInfoFetcher marks the fetched block information with the protocol fork in `ProposedIn`.
+type stubBlockInfoFetcher struct{}
+
+func (f *stubBlockInfoFetcher) GetL2BlockInfo(_ context.Context, id *big.Int) (bindings.TaikoDataBlockV2, error) {
+	return bindings.TaikoDataBlockV2{BlockId: id.Uint64(), ProposedIn: 1}, nil
+}
+
+func (f *stubBlockInfoFetcher) GetL2BlockInfoV2(_ context.Context, id *big.Int) (bindings.TaikoDataBlockV2, error) {
+	return bindings.TaikoDataBlockV2{BlockId: id.Uint64(), ProposedIn: 2}, nil
+}
+
+func TestProverServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProverServerTestSuite))
+}
@@ -12,6 +12,7 @@ import (
 type SharedState struct {
 	lastHandledBlockID atomic.Uint64
 	l1Current          atomic.Value
+	provingPaused      atomic.Bool
 	tiers              []*rpc.TierProviderTierWithID
 }
 
@@ -52,3 +53,13 @@ func (s *SharedState) GetTiers() []*rpc.TierProviderTierWithID {
 func (s *SharedState) SetTiers(tiers []*rpc.TierProviderTierWithID) {
 	s.tiers = tiers
 }
+
+// IsProvingPaused returns whether proving newly proposed blocks is paused.
+func (s *SharedState) IsProvingPaused() bool {
+	return s.provingPaused.Load()
+}
+
+// SetProvingPaused pauses or resumes proving newly proposed blocks.
+func (s *SharedState) SetProvingPaused(paused bool) {
+	s.provingPaused.Store(paused)
+}
@@ -39,6 +39,14 @@ func (s *ProverSharedStateTestSuite) TestTiers() {
 	s.Equal(1, len(s.state.GetTiers()))
 }
 
+func (s *ProverSharedStateTestSuite) TestProvingPaused() {
+	s.False(s.state.IsProvingPaused())
+	s.state.SetProvingPaused(true)
+	s.True(s.state.IsProvingPaused())
+	s.state.SetProvingPaused(false)
+	s.False(s.state.IsProvingPaused())
+}
+
 func TestProverSharedStateTestSuite(t *testing.T) {
 	suite.Run(t, new(ProverSharedStateTestSuite))
 }
@@ -0,0 +1,74 @@
+package verifier
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// Config contains the configurations to initialize a Taiko chain derivation verifier.
+type Config struct {
+	*rpc.ClientConfig
+	ReferenceL2Endpoint string
+	AlertWebhook        *url.URL
+	RetryInterval       time.Duration
+	BlobSources         *rpc.BlobDataSourceConfig
+	TxListCodecForks    []*config.TxListCodecFork
+}
+
+// NewConfigFromCliContext creates a new config instance from
+// the command line inputs.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	jwtSecret, err := jwt.ParseSecretFromFile(c.String(flags.JWTSecret.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret file: %w", err)
+	}
+
+	var alertWebhook *url.URL
+	if c.IsSet(flags.VerifierAlertWebhook.Name) {
+		if alertWebhook, err = url.Parse(c.String(flags.VerifierAlertWebhook.Name)); err != nil {
+			return nil, fmt.Errorf("invalid alert webhook: %w", err)
+		}
+	}
+
+	blobSources, err := driver.NewBlobSourcesConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	txListCodecForks, err := config.ParseTxListCodecForks(c.StringSlice(flags.TxListCodecForks.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		ClientConfig: &rpc.ClientConfig{
+			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
+			L1BeaconEndpoint:  c.String(flags.L1BeaconEndpoint.Name),
+			L2Endpoint:        c.String(flags.L2WSEndpoint.Name),
+			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:  c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:         string(jwtSecret),
+			Timeout:           c.Duration(flags.RPCTimeout.Name),
+			L1QuorumEndpoints: c.StringSlice(flags.L1QuorumEndpoints.Name),
+			L1QuorumThreshold: c.Uint64(flags.L1QuorumThreshold.Name),
+			L1QuorumObserver:  metrics.L1QuorumObserver,
+		},
+		ReferenceL2Endpoint: c.String(flags.VerifierReferenceL2Endpoint.Name),
+		AlertWebhook:        alertWebhook,
+		RetryInterval:       c.Duration(flags.BackOffRetryInterval.Name),
+		BlobSources:         blobSources,
+		TxListCodecForks:    txListCodecForks,
+	}, nil
+}
@@ -0,0 +1,433 @@
+package verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/blob"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+const (
+	// Maximum number of L1 blocks to filter the proved transitions in one request.
+	transitionsFilterBatchSize = 1000
+
+	divergenceKindReference = "reference"
+	divergenceKindProof     = "proof"
+)
+
+// Divergence is a L2 block whose re-derived result differs from the reference L2 node
+// or from a proved transition, it is also the JSON body of the alerts.
+type Divergence struct {
+	Kind            string          `json:"kind"`
+	BlockID         uint64          `json:"blockId"`
+	LocalBlockHash  common.Hash     `json:"localBlockHash"`
+	LocalStateRoot  common.Hash     `json:"localStateRoot"`
+	RemoteBlockHash common.Hash     `json:"remoteBlockHash"`
+	RemoteStateRoot common.Hash     `json:"remoteStateRoot"`
+	Prover          *common.Address `json:"prover,omitempty"`
+	Tier            uint16          `json:"tier,omitempty"`
+	L1Height        uint64          `json:"l1Height,omitempty"`
+}
+
+// provedTransition is a transition proved on L1, from either a `TransitionProved` or
+// a `TransitionProvedV2` event.
+type provedTransition struct {
+	BlockID  *big.Int
+	Tran     bindings.TaikoDataTransition
+	Prover   common.Address
+	Tier     uint16
+	L1Height uint64
+}
+
+// Verifier independently re-derives the L2 blocks from the `BlockProposed` events on L1 into
+// its own L2 execution engine, and compares the results against a reference L2 node and
+// against the transitions proved on L1, alerting on any divergence.
+type Verifier struct {
+	*Config
+	rpc        *rpc.Client
+	reference  *rpc.EthClient
+	state      *state.State
+	blobSyncer *blob.Syncer
+
+	// Verification progress
+	lastComparedID      uint64
+	lastCheckedL1Height uint64
+
+	l1HeadCh  chan *types.Header
+	l1HeadSub event.Subscription
+
+	ctx context.Context
+	wg  sync.WaitGroup
+}
+
+// InitFromCli initializes the given verifier instance based on the command line flags.
+func (v *Verifier) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return v.InitFromConfig(ctx, cfg)
+}
+
+// InitFromConfig initializes the verifier instance based on the given configurations.
+func (v *Verifier) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
+	v.l1HeadCh = make(chan *types.Header, 1024)
+	v.ctx = ctx
+	v.Config = cfg
+
+	if v.rpc, err = rpc.NewClient(v.ctx, cfg.ClientConfig); err != nil {
+		return err
+	}
+
+	if v.reference, err = rpc.NewEthClient(v.ctx, cfg.ReferenceL2Endpoint, cfg.Timeout); err != nil {
+		return fmt.Errorf("failed to connect to reference L2 node: %w", err)
+	}
+	if v.reference.ChainID.Cmp(v.rpc.L2.ChainID) != 0 {
+		return fmt.Errorf("reference L2 node chain ID mismatch: %d != %d", v.reference.ChainID, v.rpc.L2.ChainID)
+	}
+
+	if v.state, err = state.New(v.ctx, v.rpc); err != nil {
+		return err
+	}
+
+	// The verifier always inserts the blocks one by one, so the beacon sync is never triggered.
+	if v.blobSyncer, err = blob.NewSyncer(
+		v.ctx,
+		v.rpc,
+		v.state,
+		beaconsync.NewSyncProgressTracker(v.rpc.L2, cfg.Timeout),
+		0,
+		cfg.BlobSources,
+		cfg.TxListCodecForks,
+		nil,
+	); err != nil {
+		return err
+	}
+
+	head, err := v.rpc.L2.HeaderByNumber(v.ctx, nil)
+	if err != nil {
+		return err
+	}
+	v.lastComparedID = head.Number.Uint64()
+	v.lastCheckedL1Height = v.state.GetL1Current().Number.Uint64()
+
+	v.l1HeadSub = v.state.SubL1HeadsFeed(v.l1HeadCh)
+
+	return nil
+}
+
+// Start starts the verifier instance.
+func (v *Verifier) Start() error {
+	go v.eventLoop()
+
+	return nil
+}
+
+// Close closes the verifier instance.
+func (v *Verifier) Close(_ context.Context) {
+	v.l1HeadSub.Unsubscribe()
+	v.state.Close()
+	v.wg.Wait()
+}
+
+// eventLoop starts the main loop of the verifier.
+func (v *Verifier) eventLoop() {
+	v.wg.Add(1)
+	defer v.wg.Done()
+
+	syncNotify := make(chan struct{}, 1)
+	// reqSync requests performing a verification, won't block
+	// if we are already verifying.
+	reqSync := func() {
+		select {
+		case syncNotify <- struct{}{}:
+		default:
+		}
+	}
+
+	// doSyncWithBackoff performs a verification with a backoff strategy.
+	doSyncWithBackoff := func() {
+		if err := backoff.Retry(
+			v.doSync,
+			backoff.WithContext(backoff.NewConstantBackOff(v.RetryInterval), v.ctx),
+		); err != nil {
+			log.Error("Verify L2 block chain error", "error", err)
+		}
+	}
+
+	// Call doSync() right away to catch up with the latest known L1 head.
+	doSyncWithBackoff()
+
+	for {
+		select {
+		case <-v.ctx.Done():
+			return
+		case <-syncNotify:
+			doSyncWithBackoff()
+		case <-v.l1HeadCh:
+			reqSync()
+		}
+	}
+}
+
+// doSync re-derives the L2 blocks proposed from the local L1 sync cursor to the L1 head,
+// and then compares them against the reference L2 node and the proved transitions.
+func (v *Verifier) doSync() error {
+	// Check whether the application is closing.
+	if v.ctx.Err() != nil {
+		log.Warn("Verifier context error", "error", v.ctx.Err())
+		return nil
+	}
+
+	if err := v.blobSyncer.ProcessL1Blocks(v.ctx); err != nil {
+		log.Error("Process new L1 blocks error", "error", err)
+		return err
+	}
+
+	if err := v.compareWithReference(v.ctx); err != nil {
+		log.Error("Compare with reference L2 node error", "error", err)
+		return err
+	}
+
+	if err := v.checkProvedTransitions(v.ctx); err != nil {
+		log.Error("Check proved transitions error", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// compareWithReference compares the newly re-derived L2 blocks against the reference L2 node.
+func (v *Verifier) compareWithReference(ctx context.Context) error {
+	head, err := v.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// The local chain has been reorged, compare the new blocks again.
+	if head.Number.Uint64() < v.lastComparedID {
+		v.lastComparedID = head.Number.Uint64()
+	}
+
+	for id := v.lastComparedID + 1; id <= head.Number.Uint64(); id++ {
+		local, err := v.rpc.L2.HeaderByNumber(ctx, new(big.Int).SetUint64(id))
+		if err != nil {
+			return err
+		}
+
+		reference, err := v.reference.HeaderByNumber(ctx, new(big.Int).SetUint64(id))
+		if err != nil {
+			// The reference L2 node has not synced this block yet, compare it next time.
+			if errors.Is(err, ethereum.NotFound) {
+				log.Debug("Block not found in reference L2 node", "blockID", id)
+				return nil
+			}
+			return err
+		}
+
+		if divergence := compareWithReference(local, reference); divergence != nil {
+			v.alert(ctx, divergence)
+		}
+
+		v.lastComparedID = id
+		metrics.VerifierLastComparedIDGauge.Set(float64(id))
+	}
+
+	return nil
+}
+
+// checkProvedTransitions checks the transitions proved in the L1 blocks which have been
+// processed since last check against the re-derived L2 blocks.
+func (v *Verifier) checkProvedTransitions(ctx context.Context) error {
+	l1Current := v.state.GetL1Current().Number.Uint64()
+
+	// The L1 sync cursor has been reset, check the new L1 blocks again.
+	if l1Current < v.lastCheckedL1Height {
+		v.lastCheckedL1Height = l1Current
+	}
+
+	for v.lastCheckedL1Height < l1Current {
+		end := min(v.lastCheckedL1Height+transitionsFilterBatchSize, l1Current)
+
+		transitions, err := v.filterProvedTransitions(ctx, v.lastCheckedL1Height+1, end)
+		if err != nil {
+			return err
+		}
+
+		for _, transition := range transitions {
+			header, err := v.rpc.L2.HeaderByNumber(ctx, transition.BlockID)
+			if err != nil {
+				if errors.Is(err, ethereum.NotFound) {
+					log.Warn("Proved block not derived yet", "blockID", transition.BlockID)
+					continue
+				}
+				return err
+			}
+
+			if divergence := compareWithTransition(header, transition); divergence != nil {
+				v.alert(ctx, divergence)
+			}
+		}
+
+		v.lastCheckedL1Height = end
+		metrics.VerifierLastCheckedL1Gauge.Set(float64(end))
+	}
+
+	return nil
+}
+
+// filterProvedTransitions returns all transitions proved in the given L1 blocks range.
+func (v *Verifier) filterProvedTransitions(
+	ctx context.Context,
+	start uint64,
+	end uint64,
+) ([]*provedTransition, error) {
+	var (
+		opts        = &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+		transitions []*provedTransition
+	)
+
+	iter, err := v.rpc.TaikoL1.FilterTransitionProved(opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		transitions = append(transitions, &provedTransition{
+			BlockID:  iter.Event.BlockId,
+			Tran:     iter.Event.Tran,
+			Prover:   iter.Event.Prover,
+			Tier:     iter.Event.Tier,
+			L1Height: iter.Event.Raw.BlockNumber,
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	iterV2, err := v.rpc.TaikoL1.FilterTransitionProvedV2(opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterV2.Close()
+
+	for iterV2.Next() {
+		transitions = append(transitions, &provedTransition{
+			BlockID:  iterV2.Event.BlockId,
+			Tran:     iterV2.Event.Tran,
+			Prover:   iterV2.Event.Prover,
+			Tier:     iterV2.Event.Tier,
+			L1Height: iterV2.Event.Raw.BlockNumber,
+		})
+	}
+
+	return transitions, iterV2.Error()
+}
+
+// alert reports the given divergence, and posts it to the alert webhook if set.
+func (v *Verifier) alert(ctx context.Context, divergence *Divergence) {
+	log.Error(
+		"L2 block divergence detected",
+		"kind", divergence.Kind,
+		"blockID", divergence.BlockID,
+		"localBlockHash", divergence.LocalBlockHash,
+		"localStateRoot", divergence.LocalStateRoot,
+		"remoteBlockHash", divergence.RemoteBlockHash,
+		"remoteStateRoot", divergence.RemoteStateRoot,
+	)
+	metrics.VerifierDivergenceCounter.WithLabelValues(divergence.Kind).Inc()
+
+	if v.AlertWebhook == nil {
+		return
+	}
+
+	ctxWithTimeout, cancel := rpc.CtxWithTimeoutOrDefault(ctx, v.Timeout)
+	defer cancel()
+
+	resp, err := resty.New().R().
+		SetContext(ctxWithTimeout).
+		SetHeader("Content-Type", "application/json").
+		SetBody(divergence).
+		Post(v.AlertWebhook.String())
+	if err != nil {
+		log.Error("Failed to post divergence alert", "error", err)
+		return
+	}
+	if !resp.IsSuccess() {
+		log.Error("Failed to post divergence alert", "statusCode", resp.StatusCode())
+	}
+}
+
+// Name returns the application name.
+func (v *Verifier) Name() string {
+	return "verifier"
+}
+
+// compareWithReference returns the divergence between the given re-derived L2 block and
+// the same block in the reference L2 node, or nil if they are identical.
+func compareWithReference(local *types.Header, reference *types.Header) *Divergence {
+	if local.Hash() == reference.Hash() && local.Root == reference.Root {
+		return nil
+	}
+
+	return &Divergence{
+		Kind:            divergenceKindReference,
+		BlockID:         local.Number.Uint64(),
+		LocalBlockHash:  local.Hash(),
+		LocalStateRoot:  local.Root,
+		RemoteBlockHash: reference.Hash(),
+		RemoteStateRoot: reference.Root,
+	}
+}
+
+// compareWithTransition returns the divergence between the given re-derived L2 block and
+// the given proved transition, or nil if the transition proves the block. The transitions
+// proved for other parent blocks are ignored, since the parent blocks have already been checked.
+func compareWithTransition(local *types.Header, transition *provedTransition) *Divergence {
+	if local.ParentHash != transition.Tran.ParentHash {
+		log.Debug(
+			"Ignore the transition proved for another parent block",
+			"blockID", transition.BlockID,
+			"parentHash", common.Hash(transition.Tran.ParentHash),
+		)
+		return nil
+	}
+
+	// The state root is only set in the transitions of the state syncing blocks.
+	var stateRoot common.Hash = transition.Tran.StateRoot
+	if local.Hash() == transition.Tran.BlockHash && (stateRoot == (common.Hash{}) || local.Root == stateRoot) {
+		return nil
+	}
+
+	return &Divergence{
+		Kind:            divergenceKindProof,
+		BlockID:         local.Number.Uint64(),
+		LocalBlockHash:  local.Hash(),
+		LocalStateRoot:  local.Root,
+		RemoteBlockHash: transition.Tran.BlockHash,
+		RemoteStateRoot: stateRoot,
+		Prover:          &transition.Prover,
+		Tier:            transition.Tier,
+		L1Height:        transition.L1Height,
+	}
+}
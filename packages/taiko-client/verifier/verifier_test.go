@@ -0,0 +1,92 @@
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+func TestCompareWithReference(t *testing.T) {
+	local := testHeader(common.Hash{0x01})
+	require.Nil(t, compareWithReference(local, testHeader(common.Hash{0x01})))
+
+	divergence := compareWithReference(local, testHeader(common.Hash{0x02}))
+	require.NotNil(t, divergence)
+	require.Equal(t, divergenceKindReference, divergence.Kind)
+	require.Equal(t, uint64(1), divergence.BlockID)
+	require.Equal(t, local.Hash(), divergence.LocalBlockHash)
+	require.Equal(t, common.Hash{0x02}, divergence.RemoteStateRoot)
+}
+
+func TestCompareWithTransition(t *testing.T) {
+	local := testHeader(common.Hash{0x01})
+	transition := &provedTransition{
+		BlockID: local.Number,
+		Tran: bindings.TaikoDataTransition{
+			ParentHash: local.ParentHash,
+			BlockHash:  local.Hash(),
+		},
+		Tier: 100,
+	}
+
+	// Non state syncing block.
+	require.Nil(t, compareWithTransition(local, transition))
+
+	// State syncing block.
+	transition.Tran.StateRoot = local.Root
+	require.Nil(t, compareWithTransition(local, transition))
+
+	transition.Tran.StateRoot = common.Hash{0x02}
+	divergence := compareWithTransition(local, transition)
+	require.NotNil(t, divergence)
+	require.Equal(t, divergenceKindProof, divergence.Kind)
+	require.Equal(t, uint16(100), divergence.Tier)
+
+	transition.Tran.StateRoot = common.Hash{}
+	transition.Tran.BlockHash = common.Hash{0x03}
+	require.NotNil(t, compareWithTransition(local, transition))
+
+	// Proved for another parent block.
+	transition.Tran.ParentHash = common.Hash{0x04}
+	require.Nil(t, compareWithTransition(local, transition))
+}
+
+func TestAlertWebhook(t *testing.T) {
+	received := make(chan *Divergence, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var divergence Divergence
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&divergence))
+		received <- &divergence
+	}))
+	defer srv.Close()
+
+	webhook, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	v := &Verifier{Config: &Config{ClientConfig: &rpc.ClientConfig{Timeout: time.Minute}, AlertWebhook: webhook}}
+	divergence := compareWithReference(testHeader(common.Hash{0x01}), testHeader(common.Hash{0x02}))
+	v.alert(context.Background(), divergence)
+
+	require.Equal(t, divergence, <-received)
+}
+
+// testHeader creates a L2 block header with the given state root.
+func testHeader(root common.Hash) *types.Header {
+	return &types.Header{
+		ParentHash: common.Hash{0xff},
+		Number:     common.Big1,
+		Difficulty: common.Big0,
+		Root:       root,
+	}
+}